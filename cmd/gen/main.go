@@ -74,6 +74,14 @@ func main() {
 		&models.FoxSymbol{},
 		&models.FoxOrder{},
 		&models.FoxExchange{},
+		&models.FoxNews{},
+		&models.FoxRiskRule{},
+		&models.FoxKillSwitch{},
+		&models.FoxAlgoSlice{},
+		&models.FoxOrderHistory{},
+		&models.FoxDcaPlan{},
+		&models.FoxEnginePause{},
+		&models.FoxNewsFeed{},
 	); err != nil {
 		log.Fatalf("failed to auto migrate: %w", err)
 	}
@@ -31,18 +31,23 @@ func NewCLI() (*CLI, error) {
 
 	// 注册命令
 	cmdMap := map[string]command.Command{
-		"help":   &cliCmds.HelpCommand{},
-		"show":   &cliCmds.ShowCommand{},
-		"use":    &cliCmds.UseCommand{},
-		"create": &cliCmds.CreateCommand{},
-		"update": &cliCmds.UpdateCommand{},
-		"set":    &cliCmds.SetCommand{},
-		"open":   &cliCmds.OpenCommand{},
-		"close":  &cliCmds.CloseCommand{},
-		"cancel": &cliCmds.CancelCommand{},
-		"delete": &cliCmds.DeleteCommand{},
-		"exit":   &cliCmds.ExitCommand{},
-		"quit":   &cliCmds.ExitCommand{},
+		"help":       &cliCmds.HelpCommand{},
+		"show":       &cliCmds.ShowCommand{},
+		"use":        &cliCmds.UseCommand{},
+		"create":     &cliCmds.CreateCommand{},
+		"update":     &cliCmds.UpdateCommand{},
+		"set":        &cliCmds.SetCommand{},
+		"open":       &cliCmds.OpenCommand{},
+		"close":      &cliCmds.CloseCommand{},
+		"dca":        &cliCmds.DcaCommand{},
+		"cancel":     &cliCmds.CancelCommand{},
+		"delete":     &cliCmds.DeleteCommand{},
+		"panic":      &cliCmds.PanicCommand{},
+		"killswitch": &cliCmds.PanicCommand{},
+		"pause":      &cliCmds.PauseCommand{},
+		"resume":     &cliCmds.ResumeCommand{},
+		"exit":       &cliCmds.ExitCommand{},
+		"quit":       &cliCmds.ExitCommand{},
 	}
 
 	return &CLI{
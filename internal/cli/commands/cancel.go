@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/lemconn/foxflow/internal/cli/command"
@@ -13,8 +14,10 @@ import (
 type CancelCommand struct{}
 
 func (c *CancelCommand) GetName() string        { return "cancel" }
-func (c *CancelCommand) GetDescription() string { return "取消订单" }
-func (c *CancelCommand) GetUsage() string       { return "cancel order <symbol>:<side>:<posSide>:<amount>" }
+func (c *CancelCommand) GetDescription() string { return "取消订单或定投计划" }
+func (c *CancelCommand) GetUsage() string {
+	return "cancel order <symbol>:<side>:<posSide>:<amount>\n  cancel dca <id>"
+}
 
 func (c *CancelCommand) Execute(ctx command.Context, args []string) error {
 	if !ctx.IsReady() {
@@ -23,8 +26,11 @@ func (c *CancelCommand) Execute(ctx command.Context, args []string) error {
 	if len(args) < 2 {
 		return fmt.Errorf("usage: %s", c.GetUsage())
 	}
+	if args[0] == "dca" {
+		return c.cancelDcaPlan(ctx, args[1])
+	}
 	if args[0] != "order" {
-		return fmt.Errorf("only support cancel order")
+		return fmt.Errorf("only support cancel order or cancel dca")
 	}
 
 	// 解析订单标识：symbol:direction:amount
@@ -46,6 +52,9 @@ func (c *CancelCommand) Execute(ctx command.Context, args []string) error {
 	if strings.HasSuffix(amountStr, "U") {
 		amountStr = strings.TrimSuffix(amountStr, "U")
 		amountType = "USDT"
+	} else if strings.HasSuffix(amountStr, "%") {
+		amountStr = strings.TrimSuffix(amountStr, "%")
+		amountType = "percent"
 	}
 
 	amountDecimal, err := decimal.NewFromString(amountStr)
@@ -74,3 +83,24 @@ func (c *CancelCommand) Execute(ctx command.Context, args []string) error {
 	fmt.Println(utils.RenderSuccess(message))
 	return nil
 }
+
+// cancelDcaPlan 取消定投计划，已创建的订单不受影响
+func (c *CancelCommand) cancelDcaPlan(ctx command.Context, arg string) error {
+	planID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || planID <= 0 {
+		return fmt.Errorf("invalid dca plan id: %s", arg)
+	}
+
+	grpcClient := ctx.GetGRPCClient()
+	if grpcClient == nil {
+		return fmt.Errorf("gRPC 客户端初始化异常")
+	}
+
+	message, err := grpcClient.CancelDcaPlan(ctx.GetAccountInstance().Id, planID)
+	if err != nil {
+		return fmt.Errorf("取消定投计划失败: %v", err)
+	}
+
+	fmt.Println(utils.RenderSuccess(message))
+	return nil
+}
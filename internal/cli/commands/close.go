@@ -5,8 +5,9 @@ import (
 	"strings"
 
 	"github.com/lemconn/foxflow/internal/cli/command"
-	"github.com/lemconn/foxflow/internal/engine/syntax"
+	"github.com/lemconn/foxflow/internal/grpc"
 	"github.com/lemconn/foxflow/internal/utils"
+	"github.com/shopspring/decimal"
 )
 
 // CloseCommand 退出命令
@@ -15,7 +16,7 @@ type CloseCommand struct{}
 func (c *CloseCommand) GetName() string        { return "close" }
 func (c *CloseCommand) GetDescription() string { return "平仓" }
 func (c *CloseCommand) GetUsage() string {
-	return "close <symbol> <direction> <margin> [with] [strategy]"
+	return "close <symbol> <direction> <margin> [amount|percent%] [limit=<expr>] [post_only|ioc|fok] [reduce_only] [chase=true [max_slippage=<percent>%]] [with] [strategy]"
 }
 
 func (c *CloseCommand) Execute(ctx command.Context, args []string) error {
@@ -24,7 +25,7 @@ func (c *CloseCommand) Execute(ctx command.Context, args []string) error {
 	}
 
 	if len(args) < 3 {
-		return fmt.Errorf("参数缺失，请补全参数，例：close <symbol> <direction> <margin> [with] [strategy]")
+		return fmt.Errorf("参数缺失，请补全参数，例：close <symbol> <direction> <margin> [amount|percent%%] [with] [strategy]")
 	}
 
 	symbolName := strings.ToUpper(args[0])
@@ -38,16 +39,55 @@ func (c *CloseCommand) Execute(ctx command.Context, args []string) error {
 		return fmt.Errorf("margin 参数错误，只能为 isolated 或 cross")
 	}
 
+	// 平仓数量：50%（仓位百分比）、100U（USDT）、0.01（标的数量），未指定时平掉全部仓位
+	rest := args[3:]
+	amount, amountType := "", ""
+	if len(rest) > 0 && strings.ToLower(rest[0]) != "with" {
+		if _, _, isOption := parseOrderOption(rest[0]); !isOption {
+			var err error
+			amount, amountType, err = parseCloseAmount(rest[0])
+			if err != nil {
+				return err
+			}
+			rest = rest[1:]
+		}
+	}
+
+	// 订单选项（limit=...、post_only、ioc、fok、reduce_only）位于 with 之前或策略末尾
 	strategy := ""
-	if len(args) >= 5 {
-		strategy = args[4]
-		engineClient := syntax.NewEngine()
-		node, err := engineClient.Parse(strategy)
-		if err != nil {
-			return fmt.Errorf("failed to parse strategy syntax: %w", err)
+	before, after, hasWith := cutWith(strings.Join(rest, " "))
+	options, err := parseOrderOptions(before)
+	if err != nil {
+		return fmt.Errorf("%w, usage: %s", err, c.GetUsage())
+	}
+	if hasWith {
+		var strategyOptions map[string]string
+		strategy, strategyOptions = splitOrderOptions(after)
+		for key, value := range strategyOptions {
+			if _, exists := options[key]; exists {
+				return fmt.Errorf("订单选项 %s 重复设置", key)
+			}
+			options[key] = value
 		}
-		if err := engineClient.GetEvaluator().Validate(node); err != nil {
-			return fmt.Errorf("failed to validate AST: %w", err)
+	}
+	for _, key := range []string{"expire", "expire_at", "algo", "duration", "slices", "visible"} {
+		if _, ok := options[key]; ok {
+			return fmt.Errorf("平仓订单不支持 %s", key)
+		}
+	}
+
+	orderType, reduceOnly, err := parseOrderFlags(options)
+	if err != nil {
+		return err
+	}
+	chase, maxSlippage, err := parseOrderChase(options, orderType, options["limit"])
+	if err != nil {
+		return err
+	}
+
+	if strategy != "" {
+		if err := validateStrategy(strategy); err != nil {
+			return err
 		}
 	}
 
@@ -62,7 +102,16 @@ func (c *CloseCommand) Execute(ctx command.Context, args []string) error {
 		symbolName,
 		posSide,
 		margin,
+		amount,
+		amountType,
 		strategy,
+		grpc.CloseOrderOptions{
+			OrderType:   orderType,
+			PriceExpr:   options["limit"],
+			ReduceOnly:  reduceOnly,
+			Chase:       chase,
+			MaxSlippage: maxSlippage,
+		},
 	)
 	if err != nil {
 		return fmt.Errorf("提交平仓订单失败: %v", err)
@@ -71,3 +120,29 @@ func (c *CloseCommand) Execute(ctx command.Context, args []string) error {
 	fmt.Println(utils.RenderSuccess(message))
 	return nil
 }
+
+// parseCloseAmount 解析平仓数量，返回数量及数量类型（空、USDT、percent）
+func parseCloseAmount(arg string) (string, string, error) {
+	amount := strings.ToUpper(arg)
+	amountType := ""
+	switch {
+	case strings.HasSuffix(amount, "%"):
+		amount = strings.TrimSuffix(amount, "%")
+		amountType = "percent"
+	case strings.HasSuffix(amount, "U"):
+		amount = strings.TrimSuffix(amount, "U")
+		amountType = "USDT"
+	}
+
+	amountDecimal, err := decimal.NewFromString(amount)
+	if err != nil {
+		return "", "", fmt.Errorf("amount 参数错误，例：50%%、100U、0.01: %s", arg)
+	}
+	if !amountDecimal.IsPositive() {
+		return "", "", fmt.Errorf("amount 必须大于 0: %s", arg)
+	}
+	if amountType == "percent" && amountDecimal.GreaterThan(decimal.NewFromInt(100)) {
+		return "", "", fmt.Errorf("平仓百分比不能大于 100%%: %s", arg)
+	}
+	return amountDecimal.String(), amountType, nil
+}
@@ -0,0 +1,95 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lemconn/foxflow/internal/cli/command"
+	"github.com/lemconn/foxflow/internal/utils"
+	"github.com/shopspring/decimal"
+)
+
+// DcaCommand 定投命令
+type DcaCommand struct{}
+
+func (c *DcaCommand) GetName() string { return "dca" }
+func (c *DcaCommand) GetDescription() string {
+	return "创建定投计划（按 cron 表达式定期开仓）"
+}
+func (c *DcaCommand) GetUsage() string {
+	return `dca <symbol> <direction> <margin> <amount> every="<cron>" [with <guard>]`
+}
+
+func (c *DcaCommand) Execute(ctx command.Context, args []string) error {
+	if !ctx.IsReady() {
+		return fmt.Errorf("请先选择交易所和用户")
+	}
+
+	if len(args) < 5 {
+		return fmt.Errorf(`参数缺失，请补全参数，例：dca BTC-USDT-SWAP long cross 50U every="0 9 * * MON"`)
+	}
+
+	symbolName := strings.ToUpper(args[0])
+	posSide := strings.ToLower(args[1])
+	margin := strings.ToLower(args[2])
+
+	if posSide != "long" && posSide != "short" {
+		return fmt.Errorf("direction 参数错误，只能为 long 或 short")
+	}
+	if margin != "isolated" && margin != "cross" {
+		return fmt.Errorf("margin 参数错误，只能为 isolated 或 cross")
+	}
+
+	// 定投数量：50U（USDT）或 0.01（标的数量）
+	amount := strings.ToUpper(args[3])
+	amountType := ""
+	if strings.HasSuffix(amount, "U") {
+		amount = strings.TrimSuffix(amount, "U")
+		amountType = "USDT"
+	}
+	amountDecimal, err := decimal.NewFromString(amount)
+	if err != nil || !amountDecimal.IsPositive() {
+		return fmt.Errorf("amount 参数错误: %s，例：50U、0.01", args[3])
+	}
+
+	key, schedule, ok := strings.Cut(args[4], "=")
+	if !ok || strings.ToLower(key) != "every" || strings.TrimSpace(schedule) == "" {
+		return fmt.Errorf(`缺少定投周期，例：every="0 9 * * MON"、every=@daily`)
+	}
+
+	// with 之后为执行条件，不满足时跳过本次定投
+	guard := ""
+	rest := args[5:]
+	if len(rest) > 0 {
+		if strings.ToLower(rest[0]) != "with" || len(rest) < 2 {
+			return fmt.Errorf("unknown argument: %s, usage: %s", strings.Join(rest, " "), c.GetUsage())
+		}
+		guard = strings.Join(rest[1:], " ")
+		if err := validateStrategy(guard); err != nil {
+			return err
+		}
+	}
+
+	grpcClient := ctx.GetGRPCClient()
+	if grpcClient == nil {
+		return fmt.Errorf("gRPC 客户端初始化异常")
+	}
+
+	message, err := grpcClient.CreateDcaPlan(
+		ctx.GetAccountInstance().Id,
+		ctx.GetExchangeName(),
+		symbolName,
+		posSide,
+		margin,
+		amountDecimal.String(),
+		amountType,
+		strings.TrimSpace(schedule),
+		guard,
+	)
+	if err != nil {
+		return fmt.Errorf("创建定投计划失败: %v", err)
+	}
+
+	fmt.Println(utils.RenderSuccess(message))
+	return nil
+}
@@ -16,14 +16,18 @@ func getHelpCommandSuggestions() []struct {
 		Description string
 	}{
 		{Text: "help", Description: "显示帮助信息 - 查看所有命令说明"},
-		{Text: "show", Description: "查看数据列表 - 支持子命令：exchange(交易所)、account(账户)、balance(资产)、position(持仓)、symbol(交易对)、strategy(策略)、order(订单)、news(新闻)"},
+		{Text: "show", Description: "查看数据列表 - 支持子命令：exchange(交易所)、account(账户)、balance(资产)、position(持仓)、symbol(交易对)、strategy(策略)、order(订单)、dca(定投计划)、news(新闻)"},
 		{Text: "use", Description: "激活上下文 - 支持子命令：exchange(激活交易所)、account(激活账户)"},
 		{Text: "create", Description: "创建资源 - 支持子命令：account(账户)"},
 		{Text: "update", Description: "更新配置 - 支持子命令：leverage(杠杆)"},
 		{Text: "open", Description: "开仓/下单 - 执行交易开仓操作"},
 		{Text: "close", Description: "平仓 - 执行交易平仓操作"},
+		{Text: "dca", Description: "定投 - 按 cron 表达式定期开仓，可用 with 指定执行条件"},
 		{Text: "cancel", Description: "取消订单 - 支持子命令：ss(策略订单)"},
 		{Text: "delete", Description: "删除资源 - 支持子命令：users(用户)、symbols(交易对)"},
+		{Text: "panic", Description: "紧急停止 - 取消策略订单、撤销挂单并平仓，支持参数：all(所有账户)、rearm(解除)"},
+		{Text: "pause", Description: "暂停 - 支持子命令：order(策略订单)、engine(策略引擎)"},
+		{Text: "resume", Description: "恢复 - 支持子命令：order(策略订单)、engine(策略引擎)"},
 		{Text: "exit", Description: "退出系统"},
 		{Text: "quit", Description: "退出系统"},
 	}
@@ -2,10 +2,15 @@ package commands
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/lemconn/foxflow/internal/cli/command"
 	"github.com/lemconn/foxflow/internal/engine/syntax"
+	"github.com/lemconn/foxflow/internal/grpc"
 	"github.com/lemconn/foxflow/internal/utils"
 	"github.com/shopspring/decimal"
 )
@@ -16,7 +21,7 @@ type OpenCommand struct{}
 func (c *OpenCommand) GetName() string        { return "open" }
 func (c *OpenCommand) GetDescription() string { return "开仓/下单" }
 func (c *OpenCommand) GetUsage() string {
-	return "open <symbol> <direction> <margin> <amount> [with] [strategy]"
+	return "open <symbol> <direction> <margin> <amount> [limit=<expr>] [post_only|ioc|fok] [algo=twap duration=<duration> slices=<n>|algo=iceberg visible=<percent>%] [chase=true [max_slippage=<percent>%]] [with] [strategy] [expire=<duration>|expire_at=<time>] [then close with <strategy>]..."
 }
 
 func (c *OpenCommand) Execute(ctx command.Context, args []string) error {
@@ -54,16 +59,73 @@ func (c *OpenCommand) Execute(ctx command.Context, args []string) error {
 		return fmt.Errorf("amount decimal error: %w", err)
 	}
 
+	// 开仓成交后激活的平仓策略（then close with ...），多个平仓策略互为 OCO
+	head, closeStrategies := splitCloseStrategies(strings.Join(args[4:], " "))
+	for i, closeStrategy := range closeStrategies {
+		if closeStrategy == "" {
+			return fmt.Errorf("第 %d 个平仓策略不能为空", i+1)
+		}
+		if _, closeOptions := splitOrderOptions(closeStrategy); len(closeOptions) > 0 {
+			return fmt.Errorf("订单选项需位于 then close with 之前")
+		}
+		if err := validateStrategy(closeStrategy); err != nil {
+			return fmt.Errorf("第 %d 个平仓策略无效: %w", i+1, err)
+		}
+	}
+
+	// 订单选项（limit=...、expire=24h、expire_at=...）位于 with 之前或策略末尾
 	strategy := ""
-	if len(args) >= 6 {
-		strategy = args[5]
-		engineClient := syntax.NewEngine()
-		node, err := engineClient.Parse(strategy)
-		if err != nil {
-			return fmt.Errorf("failed to parse strategy syntax: %w", err)
+	before, after, hasWith := cutWith(head)
+	options, err := parseOrderOptions(before)
+	if err != nil {
+		return fmt.Errorf("%w, usage: %s", err, c.GetUsage())
+	}
+	if hasWith {
+		var strategyOptions map[string]string
+		strategy, strategyOptions = splitOrderOptions(after)
+		for key, value := range strategyOptions {
+			if _, exists := options[key]; exists {
+				return fmt.Errorf("订单选项 %s 重复设置", key)
+			}
+			options[key] = value
 		}
-		if err := engineClient.GetEvaluator().Validate(node); err != nil {
-			return fmt.Errorf("failed to validate AST: %w", err)
+	}
+
+	expireAt, err := parseOrderExpire(options, time.Now())
+	if err != nil {
+		return err
+	}
+
+	// 限价表达式在策略触发时求值，按交易对价格精度取整后提交限价单
+	priceExpr := options["limit"]
+	orderType, reduceOnly, err := parseOrderFlags(options)
+	if err != nil {
+		return err
+	}
+	if reduceOnly {
+		return fmt.Errorf("reduce_only 仅适用于平仓订单")
+	}
+
+	orderOptions := grpc.OpenOrderOptions{
+		OrderType:       orderType,
+		PriceExpr:       priceExpr,
+		ExpireAt:        expireAt,
+		CloseStrategies: closeStrategies,
+	}
+	if err := parseOrderAlgo(options, &orderOptions); err != nil {
+		return err
+	}
+	orderOptions.Chase, orderOptions.MaxSlippage, err = parseOrderChase(options, orderType, priceExpr)
+	if err != nil {
+		return err
+	}
+	if orderOptions.Chase && orderOptions.Algo != "" {
+		return fmt.Errorf("算法订单不支持追价")
+	}
+
+	if strategy != "" {
+		if err := validateStrategy(strategy); err != nil {
+			return err
 		}
 	}
 
@@ -81,6 +143,7 @@ func (c *OpenCommand) Execute(ctx command.Context, args []string) error {
 		amountDecimal.String(),
 		amountType,
 		strategy,
+		orderOptions,
 	)
 	if err != nil {
 		return fmt.Errorf("提交订单失败: %v", err)
@@ -89,3 +152,270 @@ func (c *OpenCommand) Execute(ctx command.Context, args []string) error {
 	fmt.Println(utils.RenderSuccess(message))
 	return nil
 }
+
+// closeStrategyPattern 开仓策略与平仓策略的分隔符
+var closeStrategyPattern = regexp.MustCompile(`(?i)\s+then\s+close\s+with\s+`)
+
+// splitCloseStrategies 拆分 then close with 之后的平仓策略，返回开仓部分及平仓策略列表
+func splitCloseStrategies(input string) (string, []string) {
+	parts := closeStrategyPattern.Split(" "+strings.TrimSpace(input)+" ", -1)
+	head := strings.TrimSpace(parts[0])
+	closeStrategies := make([]string, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		closeStrategies = append(closeStrategies, strings.TrimSpace(part))
+	}
+	return head, closeStrategies
+}
+
+// validateStrategy 解析并校验策略表达式
+func validateStrategy(strategy string) error {
+	engineClient := syntax.NewEngine()
+	node, err := engineClient.Parse(strategy)
+	if err != nil {
+		return fmt.Errorf("failed to parse strategy syntax: %w", err)
+	}
+	if err := engineClient.GetEvaluator().Validate(node); err != nil {
+		return fmt.Errorf("failed to validate AST: %w", err)
+	}
+	return nil
+}
+
+// orderOptionKeys open 命令支持的订单选项
+var orderOptionKeys = []string{"limit", "expire", "expire_at", "algo", "duration", "slices", "visible", "chase", "max_slippage"}
+
+// orderFlagKeys 无需取值的订单选项：订单类型（post_only、ioc、fok 需指定 limit）及只减仓
+var orderFlagKeys = []string{"post_only", "ioc", "fok", "reduce_only"}
+
+// parseOrderOption 解析 key=value 格式的订单选项及 post_only 等标志
+func parseOrderOption(arg string) (string, string, bool) {
+	if flag := strings.ToLower(arg); slices.Contains(orderFlagKeys, flag) {
+		return flag, "true", true
+	}
+
+	key, value, ok := strings.Cut(arg, "=")
+	key = strings.ToLower(key)
+	if !ok || value == "" || !slices.Contains(orderOptionKeys, key) {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+// parseOrderFlags 根据订单选项确定订单类型及是否只减仓，未指定订单类型时返回空
+func parseOrderFlags(options map[string]string) (string, bool, error) {
+	orderType := ""
+	for _, flag := range []string{"post_only", "ioc", "fok"} {
+		if _, ok := options[flag]; !ok {
+			continue
+		}
+		if orderType != "" {
+			return "", false, fmt.Errorf("%s 与 %s 不能同时设置", orderType, flag)
+		}
+		orderType = flag
+	}
+
+	priceExpr := options["limit"]
+	if orderType != "" && priceExpr == "" {
+		return "", false, fmt.Errorf("%s 订单需指定 limit=<价格表达式>", orderType)
+	}
+	if priceExpr != "" {
+		if err := validateStrategy(priceExpr); err != nil {
+			return "", false, fmt.Errorf("limit 表达式无效: %w", err)
+		}
+	}
+
+	_, reduceOnly := options["reduce_only"]
+	return orderType, reduceOnly, nil
+}
+
+// withPattern with 关键字（策略条件的开始）
+var withPattern = regexp.MustCompile(`(?i)(^|\s)with(\s|$)`)
+
+// cutWith 以第一个 with 关键字拆分，返回 with 之前的订单选项及之后的策略
+func cutWith(input string) (string, string, bool) {
+	loc := withPattern.FindStringIndex(input)
+	if loc == nil {
+		return input, "", false
+	}
+	return input[:loc[0]], input[loc[1]:], true
+}
+
+// parseOrderOptions 解析以空格分隔的订单选项，limit 表达式可包含空格（如 limit=market.okx.BTC.price * 0.998）
+func parseOrderOptions(input string) (map[string]string, error) {
+	options := make(map[string]string)
+	current := ""
+	for _, field := range strings.Fields(input) {
+		if key, value, ok := parseOrderOption(field); ok {
+			if _, exists := options[key]; exists {
+				return nil, fmt.Errorf("订单选项 %s 重复设置", key)
+			}
+			options[key] = value
+			current = key
+			continue
+		}
+		if current != "limit" {
+			return nil, fmt.Errorf("unknown argument: %s", field)
+		}
+		options[current] += " " + field
+	}
+	return options, nil
+}
+
+// splitOrderOptions 从策略末尾拆分订单选项，返回策略表达式及选项
+func splitOrderOptions(strategy string) (string, map[string]string) {
+	options := make(map[string]string)
+	strategy = strings.TrimSpace(strategy)
+	for strategy != "" {
+		index := strings.LastIndexAny(strategy, " \t")
+		key, value, ok := parseOrderOption(strategy[index+1:])
+		if !ok {
+			break
+		}
+		if _, exists := options[key]; !exists {
+			options[key] = value
+		}
+		strategy = strings.TrimSpace(strategy[:max(index, 0)])
+	}
+	return strategy, options
+}
+
+// expireAtLayouts expire_at 支持的时间格式，未指定时区时使用本地时区
+var expireAtLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// parseOrderExpire 解析订单过期时间（Unix 秒），未设置时返回 0
+// expire 为相对时长（如 30m、24h、7d），expire_at 为绝对时间（如 2025-07-01T00:00Z）
+func parseOrderExpire(options map[string]string, now time.Time) (int64, error) {
+	expire, hasExpire := options["expire"]
+	expireAtValue, hasExpireAt := options["expire_at"]
+	if hasExpire && hasExpireAt {
+		return 0, fmt.Errorf("expire 和 expire_at 不能同时设置")
+	}
+
+	if hasExpire {
+		duration, err := parseOptionDuration(expire)
+		if err != nil {
+			return 0, fmt.Errorf("expire 格式错误: %s，例：30m、24h、7d", expire)
+		}
+		if duration <= 0 {
+			return 0, fmt.Errorf("expire 必须大于 0")
+		}
+		return now.Add(duration).Unix(), nil
+	}
+
+	if hasExpireAt {
+		for _, layout := range expireAtLayouts {
+			expireAt, err := time.ParseInLocation(layout, expireAtValue, time.Local)
+			if err != nil {
+				continue
+			}
+			if !expireAt.After(now) {
+				return 0, fmt.Errorf("expire_at 必须晚于当前时间: %s", expireAtValue)
+			}
+			return expireAt.Unix(), nil
+		}
+		return 0, fmt.Errorf("expire_at 格式错误: %s，例：2025-07-01T00:00Z、2025-07-01T08:00", expireAtValue)
+	}
+
+	return 0, nil
+}
+
+// parseOptionDuration 解析时长选项，除 time.ParseDuration 支持的格式外还支持天（如 7d）
+func parseOptionDuration(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		count, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(count) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// parseOrderAlgo 解析执行算法选项：algo=twap 需指定 duration、slices，algo=iceberg 需指定 visible 及 limit
+func parseOrderAlgo(options map[string]string, orderOptions *grpc.OpenOrderOptions) error {
+	algo, hasAlgo := options["algo"]
+	algo = strings.ToLower(algo)
+	if !hasAlgo {
+		for _, key := range []string{"duration", "slices", "visible"} {
+			if _, ok := options[key]; ok {
+				return fmt.Errorf("%s 仅适用于算法订单（algo=twap 或 algo=iceberg）", key)
+			}
+		}
+		return nil
+	}
+
+	switch algo {
+	case "twap":
+		durationValue, hasDuration := options["duration"]
+		slicesValue, hasSlices := options["slices"]
+		if !hasDuration || !hasSlices {
+			return fmt.Errorf("TWAP 算法订单需指定 duration 和 slices，例：algo=twap duration=30m slices=10")
+		}
+		duration, err := parseOptionDuration(durationValue)
+		if err != nil || duration < time.Second {
+			return fmt.Errorf("duration 格式错误: %s，例：30m、2h", durationValue)
+		}
+		sliceCount, err := strconv.ParseInt(slicesValue, 10, 64)
+		if err != nil {
+			return fmt.Errorf("slices 必须为整数: %s", slicesValue)
+		}
+		orderOptions.AlgoDuration = int64(duration / time.Second)
+		orderOptions.AlgoSlices = sliceCount
+	case "iceberg":
+		visibleValue, ok := options["visible"]
+		if !ok {
+			return fmt.Errorf("冰山单需指定 visible，例：algo=iceberg visible=5%%")
+		}
+		visible, err := decimal.NewFromString(strings.TrimSuffix(visibleValue, "%"))
+		if err != nil {
+			return fmt.Errorf("visible 格式错误: %s，例：5%%", visibleValue)
+		}
+		if orderOptions.PriceExpr == "" {
+			return fmt.Errorf("冰山单需指定 limit=<价格表达式>")
+		}
+		orderOptions.AlgoVisible = visible.String()
+	default:
+		return fmt.Errorf("algo 只能为 twap 或 iceberg")
+	}
+
+	orderOptions.Algo = algo
+	return nil
+}
+
+// parseOrderChase 解析追价选项：chase=true 需指定 limit（ioc、fok 不支持），max_slippage 为相对首次委托价的百分比
+func parseOrderChase(options map[string]string, orderType, priceExpr string) (bool, string, error) {
+	chaseValue, hasChase := options["chase"]
+	slippageValue, hasSlippage := options["max_slippage"]
+
+	chase := false
+	if hasChase {
+		var err error
+		chase, err = strconv.ParseBool(chaseValue)
+		if err != nil {
+			return false, "", fmt.Errorf("chase 只能为 true 或 false")
+		}
+	}
+	if !chase {
+		if hasSlippage {
+			return false, "", fmt.Errorf("max_slippage 仅适用于追价订单（chase=true）")
+		}
+		return false, "", nil
+	}
+
+	if priceExpr == "" || orderType == "ioc" || orderType == "fok" {
+		return false, "", fmt.Errorf("追价仅支持限价挂单，需指定 limit=<价格表达式>")
+	}
+	if !hasSlippage {
+		return true, "", nil
+	}
+	slippage, err := decimal.NewFromString(strings.TrimSuffix(slippageValue, "%"))
+	if err != nil || !slippage.IsPositive() {
+		return false, "", fmt.Errorf("max_slippage 格式错误: %s，例：0.2%%", slippageValue)
+	}
+	return true, slippage.String(), nil
+}
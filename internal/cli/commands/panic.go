@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lemconn/foxflow/internal/cli/command"
+	"github.com/lemconn/foxflow/internal/utils"
+)
+
+// PanicCommand 紧急停止命令
+type PanicCommand struct{}
+
+func (c *PanicCommand) GetName() string { return "panic" }
+func (c *PanicCommand) GetDescription() string {
+	return "紧急停止：取消等待中的策略订单、撤销挂单并平掉所有仓位，引擎暂停处理订单直到解除"
+}
+func (c *PanicCommand) GetUsage() string {
+	return "panic [all]\n  panic rearm [all]\n  all：作用于所有账户（默认当前账户），rearm：解除紧急停止"
+}
+
+func (c *PanicCommand) Execute(ctx command.Context, args []string) error {
+	rearm := false
+	allAccounts := false
+	for _, arg := range args {
+		switch strings.ToLower(arg) {
+		case "rearm":
+			rearm = true
+		case "all":
+			allAccounts = true
+		default:
+			return fmt.Errorf("unknown argument: %s, usage: %s", arg, c.GetUsage())
+		}
+	}
+
+	var accountID int64
+	if !allAccounts {
+		if !ctx.IsReady() {
+			return fmt.Errorf("请先选择交易所和用户，或使用 all 作用于所有账户")
+		}
+		account := ctx.GetAccountInstance()
+		if account == nil {
+			return fmt.Errorf("请先选择交易账户")
+		}
+		accountID = account.Id
+	}
+
+	grpcClient := ctx.GetGRPCClient()
+	if grpcClient == nil {
+		return fmt.Errorf("gRPC 客户端初始化异常")
+	}
+
+	result, err := grpcClient.KillSwitch(accountID, allAccounts, rearm)
+	if err != nil {
+		if rearm {
+			return fmt.Errorf("解除紧急停止失败: %v", err)
+		}
+		return fmt.Errorf("紧急停止失败: %v", err)
+	}
+
+	scope := "所有账户"
+	if !allAccounts {
+		scope = fmt.Sprintf("[%s]用户", ctx.GetAccountName())
+	}
+	fmt.Println(utils.RenderSuccess(fmt.Sprintf("%s%s", scope, result.Message)))
+	if rearm {
+		return nil
+	}
+
+	fmt.Printf("取消策略订单: %d\n", result.CancelledOrders)
+	fmt.Printf("撤销交易所挂单: %d\n", result.CancelledExchangeOrders)
+	fmt.Printf("平仓: %d\n", result.ClosedPositions)
+	for _, message := range result.Errors {
+		fmt.Println(utils.RenderWarning(message))
+	}
+	if len(result.Errors) > 0 {
+		fmt.Println(utils.RenderWarning("部分操作执行失败，请使用 show position 检查剩余仓位"))
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/lemconn/foxflow/internal/cli/command"
+	"github.com/lemconn/foxflow/internal/utils"
+)
+
+// PauseCommand 暂停命令
+type PauseCommand struct{}
+
+func (c *PauseCommand) GetName() string { return "pause" }
+func (c *PauseCommand) GetDescription() string {
+	return "暂停策略订单或策略引擎（订单保留，恢复后继续处理）"
+}
+func (c *PauseCommand) GetUsage() string { return "pause order <id>\n  pause engine" }
+
+func (c *PauseCommand) Execute(ctx command.Context, args []string) error {
+	return executePause(ctx, args, false, c.GetUsage())
+}
+
+// ResumeCommand 恢复命令
+type ResumeCommand struct{}
+
+func (c *ResumeCommand) GetName() string        { return "resume" }
+func (c *ResumeCommand) GetDescription() string { return "恢复已暂停的策略订单或引擎" }
+func (c *ResumeCommand) GetUsage() string       { return "resume order <id>\n  resume engine" }
+
+func (c *ResumeCommand) Execute(ctx command.Context, args []string) error {
+	return executePause(ctx, args, true, c.GetUsage())
+}
+
+// executePause 执行暂停/恢复操作
+func executePause(ctx command.Context, args []string, resume bool, usage string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("usage: %s", usage)
+	}
+
+	grpcClient := ctx.GetGRPCClient()
+	if grpcClient == nil {
+		return fmt.Errorf("gRPC 客户端初始化异常")
+	}
+
+	action := "暂停"
+	if resume {
+		action = "恢复"
+	}
+
+	switch args[0] {
+	case "engine":
+		message, err := grpcClient.PauseEngine(resume)
+		if err != nil {
+			return fmt.Errorf("%s引擎失败: %v", action, err)
+		}
+		fmt.Println(utils.RenderSuccess(message))
+	case "order":
+		if !ctx.IsReady() {
+			return fmt.Errorf("请先选择交易所和用户")
+		}
+		if len(args) < 2 {
+			return fmt.Errorf("usage: %s", usage)
+		}
+
+		orderID, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil || orderID <= 0 {
+			return fmt.Errorf("invalid order id: %s", args[1])
+		}
+
+		message, err := grpcClient.PauseOrder(ctx.GetAccountInstance().Id, orderID, resume)
+		if err != nil {
+			return fmt.Errorf("%s订单失败: %v", action, err)
+		}
+		fmt.Println(utils.RenderSuccess(message))
+	default:
+		return fmt.Errorf("unknown subcommand: %s, usage: %s", args[0], usage)
+	}
+
+	return nil
+}
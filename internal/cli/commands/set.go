@@ -3,6 +3,7 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -14,10 +15,10 @@ type SetCommand struct{}
 
 func (c *SetCommand) GetName() string { return "update" }
 func (c *SetCommand) GetDescription() string {
-	return "设置默认保证金模式以及对应杠杆倍数/网络代理"
+	return "设置默认保证金模式以及对应杠杆倍数/网络代理/风控规则"
 }
 func (c *SetCommand) GetUsage() string {
-	return "set <type> [options]\n  types: config（设置默认保证金模式以及对应杠杆倍数）, proxy（设置网络代理）\n "
+	return "set <type> [options]\n  types: config（设置默认保证金模式以及对应杠杆倍数）, proxy（设置网络代理）, risk（设置风控规则）\n "
 }
 
 func (c *SetCommand) Execute(ctx command.Context, args []string) error {
@@ -30,6 +31,8 @@ func (c *SetCommand) Execute(ctx command.Context, args []string) error {
 		return c.handleConfigCommand(ctx, args[1:])
 	case "proxy":
 		return c.handleProxyCommand(ctx, args[1:])
+	case "risk":
+		return c.handleRiskCommand(ctx, args[1:])
 	default:
 		return fmt.Errorf("unknown set type: %s", args[0])
 	}
@@ -117,3 +120,71 @@ func (c *SetCommand) handleProxyCommand(ctx command.Context, args []string) erro
 
 	return nil
 }
+
+// riskSettingKeys set risk 支持的风控参数
+var riskSettingKeys = []string{
+	"max_order_notional",
+	"max_exposure",
+	"max_leverage",
+	"max_open_positions",
+	"max_daily_loss",
+	"allow_symbols",
+	"deny_symbols",
+}
+
+func (c *SetCommand) handleRiskCommand(ctx command.Context, args []string) error {
+	if !ctx.IsReady() {
+		return errors.New("请先选择交易所和用户")
+	}
+
+	account := ctx.GetAccountInstance()
+	if account == nil {
+		return fmt.Errorf("请先选择交易账户")
+	}
+
+	settings := make(map[string]string)
+	for _, arg := range args {
+		key, value, ok := strings.Cut(arg, "=")
+		if !ok || !slices.Contains(riskSettingKeys, key) {
+			return fmt.Errorf("未知的风控参数: %s，可选参数：%s", arg, strings.Join(riskSettingKeys, "=, ")+"=")
+		}
+		settings[key] = value
+	}
+
+	grpcClient := ctx.GetGRPCClient()
+	if grpcClient == nil {
+		return fmt.Errorf("gRPC 客户端初始化异常")
+	}
+
+	rule, err := grpcClient.UpdateRiskRule(account.Id, settings)
+	if err != nil {
+		return fmt.Errorf("更新风控规则失败: %v", err)
+	}
+
+	if len(settings) > 0 {
+		fmt.Println(utils.RenderSuccess(fmt.Sprintf("[%s]用户风控规则设置成功", ctx.GetAccountName())))
+	}
+
+	limit := func(value string) string {
+		if value == "" {
+			return "不限制"
+		}
+		return value
+	}
+	count := func(value int64) string {
+		if value == 0 {
+			return "不限制"
+		}
+		return strconv.FormatInt(value, 10)
+	}
+
+	fmt.Printf("单笔订单最大名义价值(max_order_notional): %s\n", limit(rule.MaxOrderNotional))
+	fmt.Printf("持仓总名义价值上限(max_exposure): %s\n", limit(rule.MaxExposure))
+	fmt.Printf("最大杠杆倍数(max_leverage): %s\n", count(rule.MaxLeverage))
+	fmt.Printf("最大持仓数量(max_open_positions): %s\n", count(rule.MaxOpenPositions))
+	fmt.Printf("当日最大已实现亏损(max_daily_loss): %s\n", limit(rule.MaxDailyLoss))
+	fmt.Printf("允许交易的标的(allow_symbols): %s\n", limit(rule.AllowSymbols))
+	fmt.Printf("禁止交易的标的(deny_symbols): %s\n", limit(rule.DenySymbols))
+
+	return nil
+}
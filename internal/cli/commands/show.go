@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/lemconn/foxflow/internal/cli/command"
 	cliRender "github.com/lemconn/foxflow/internal/cli/render"
@@ -23,7 +24,7 @@ func (c *ShowCommand) GetDescription() string {
 }
 
 func (c *ShowCommand) GetUsage() string {
-	return "show <type> [options]\n  types: exchange, account, balance, order, position, strategy, symbol, order, news\n  news: show news [count] - 显示最新新闻，count 为可选参数，默认为 10"
+	return "show <type> [options]\n  types: exchange, account, balance, order, position, strategy, symbol, order, dca, news\n  order: show order [id] - 显示订单列表，指定 id 时显示该订单的委托历史（提交、追价改单等）\n  news: show news [count] [keyword=...] [since=...] [source=...] - 显示最新新闻，count 为可选参数，默认为 10；指定 keyword 或 since 时检索历史新闻归档"
 }
 
 func (c *ShowCommand) Execute(ctx command.Context, args []string) error {
@@ -39,9 +40,14 @@ func (c *ShowCommand) Execute(ctx command.Context, args []string) error {
 	case "balance":
 		return c.handleBalanceCommand(ctx)
 	case "order":
+		if len(args) > 1 {
+			return c.handleOrderHistoryCommand(ctx, args[1])
+		}
 		return c.handleOrderCommand(ctx)
 	case "position":
 		return c.handlePositionCommand(ctx)
+	case "dca":
+		return c.handleDcaCommand(ctx)
 	case "strategy":
 		fmt.Println(cliRender.RenderStrategies())
 	case "symbol":
@@ -158,6 +164,59 @@ func (c *ShowCommand) handleOrderCommand(ctx command.Context) error {
 	return nil
 }
 
+func (c *ShowCommand) handleDcaCommand(ctx command.Context) error {
+	if !ctx.IsReady() {
+		return fmt.Errorf("请先选择交易所和用户")
+	}
+
+	grpcClient := ctx.GetGRPCClient()
+	if grpcClient == nil {
+		return fmt.Errorf("gRPC 客户端初始化异常")
+	}
+
+	plans, err := grpcClient.GetDcaPlans(ctx.GetAccountInstance().Id)
+	if err != nil {
+		return fmt.Errorf("获取定投计划失败: %w", err)
+	}
+
+	if len(plans) == 0 {
+		fmt.Println(utils.RenderWarning("暂无定投计划"))
+		return nil
+	}
+
+	fmt.Println(cliRender.RenderDcaPlans(plans))
+	return nil
+}
+
+func (c *ShowCommand) handleOrderHistoryCommand(ctx command.Context, arg string) error {
+	if !ctx.IsReady() {
+		return fmt.Errorf("请先选择交易所和用户")
+	}
+
+	orderID, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil || orderID <= 0 {
+		return fmt.Errorf("订单ID格式错误: %s", arg)
+	}
+
+	grpcClient := ctx.GetGRPCClient()
+	if grpcClient == nil {
+		return fmt.Errorf("gRPC 客户端初始化异常")
+	}
+
+	histories, err := grpcClient.GetOrderHistory(ctx.GetAccountInstance().Id, orderID)
+	if err != nil {
+		return fmt.Errorf("获取订单历史失败: %w", err)
+	}
+
+	if len(histories) == 0 {
+		fmt.Println(utils.RenderWarning("暂无订单历史"))
+		return nil
+	}
+
+	fmt.Println(cliRender.RenderOrderHistory(histories))
+	return nil
+}
+
 func (c *ShowCommand) handleBalanceCommand(ctx command.Context) error {
 	if !ctx.IsReady() {
 		return errors.New("请先选择交易所和用户")
@@ -223,25 +282,48 @@ func (c *ShowCommand) handleExchangeCommand(ctx command.Context) error {
 }
 
 // handleNewsCommand 处理新闻命令
+// 用法：show news [count] [keyword=关键词] [since=24h|2025-01-06|2025-01-06T08:00:00Z] [source=新闻源]
+// 指定 keyword 或 since 时检索引擎归档的历史新闻，否则获取新闻源的最新新闻
 func (c *ShowCommand) handleNewsCommand(ctx command.Context, args []string) error {
 	// 默认获取 10 条新闻
 	count := 10
-
-	// 如果提供了数量参数，解析它
-	if len(args) > 0 {
-		if parsedCount, err := strconv.Atoi(args[0]); err == nil && parsedCount > 0 {
+	source := ""
+	keyword := ""
+	var since time.Time
+
+	for _, arg := range args {
+		switch {
+		case strings.HasPrefix(arg, "keyword="):
+			keyword = strings.TrimSpace(strings.TrimPrefix(arg, "keyword="))
+		case strings.HasPrefix(arg, "since="):
+			parsed, err := parseNewsSince(strings.TrimPrefix(arg, "since="), time.Now())
+			if err != nil {
+				return err
+			}
+			since = parsed
+		case strings.HasPrefix(arg, "source="):
+			source = strings.TrimPrefix(arg, "source=")
+		default:
+			// 如果提供了数量参数，解析它
+			parsedCount, err := strconv.Atoi(arg)
+			if err != nil || parsedCount <= 0 {
+				return fmt.Errorf("无效的新闻数量参数: %s，请输入正整数", arg)
+			}
 			count = parsedCount
-		} else {
-			return fmt.Errorf("无效的新闻数量参数: %s，请输入正整数", args[0])
 		}
 	}
 
+	// 获取最新新闻时默认使用 blockbeats，检索归档时默认检索所有新闻源
+	if source == "" && keyword == "" && since.IsZero() {
+		source = "blockbeats"
+	}
+
 	grpcClient := ctx.GetGRPCClient()
 	if grpcClient == nil {
 		return fmt.Errorf("gRPC 客户端初始化异常")
 	}
 
-	newsList, err := grpcClient.GetNews(count, "blockbeats")
+	newsList, err := grpcClient.GetNews(count, source, keyword, since)
 	if err != nil {
 		return fmt.Errorf("获取新闻失败: %v", err)
 	}
@@ -256,3 +338,28 @@ func (c *ShowCommand) handleNewsCommand(ctx command.Context, args []string) erro
 
 	return nil
 }
+
+// parseNewsSince 解析新闻起始时间，支持相对时长（如 30m、24h、7d）、日期和 RFC3339 时间
+func parseNewsSince(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, fmt.Errorf("since 参数不能为空")
+	}
+
+	if strings.HasSuffix(value, "d") {
+		if days, err := strconv.ParseFloat(strings.TrimSuffix(value, "d"), 64); err == nil && days > 0 {
+			return now.Add(-time.Duration(days * float64(24*time.Hour))), nil
+		}
+	}
+	if duration, err := time.ParseDuration(value); err == nil && duration > 0 {
+		return now.Add(-duration), nil
+	}
+
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("无效的 since 参数: %s，支持 30m、24h、7d、2025-01-06 或 2025-01-06T08:00:00Z", value)
+}
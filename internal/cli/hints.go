@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/c-bata/go-prompt"
@@ -76,9 +77,61 @@ func handleSpecialCommandCompletions(ctx *Context, d prompt.Document, w string,
 		return result
 	}
 
+	// pause/resume order 的订单选择
+	if result := handlePauseOrderCompletion(ctx, d, w, fields, first, second); result != nil {
+		return result
+	}
+
+	// show news 命令的补全
+	if result := handleShowNewsCompletion(d, w, fields, first, second); result != nil {
+		return result
+	}
+
 	return nil
 }
 
+// handleShowNewsCompletion 处理 show news 命令的参数补全
+func handleShowNewsCompletion(d prompt.Document, w string, fields []string, first, second string) []prompt.Suggest {
+	if first != "show" || second != "news" || len(fields) < 2 {
+		return nil
+	}
+
+	// 过滤掉已输入的参数
+	used := make(map[string]bool)
+	for _, field := range fields[2:] {
+		if name, _, ok := strings.Cut(field, "="); ok {
+			used[name] = true
+		}
+	}
+	var available []prompt.Suggest
+	for _, arg := range getShowNewsArgHints() {
+		if !used[strings.TrimSuffix(arg.Text, "=")] {
+			available = append(available, arg)
+		}
+	}
+
+	if strings.HasSuffix(w, " ") {
+		return available
+	}
+
+	// 正在输入参数名时按前缀过滤，输入参数值时不提示
+	current := fields[len(fields)-1]
+	if len(fields) > 2 && !strings.Contains(current, "=") {
+		return prompt.FilterHasPrefix(available, d.GetWordBeforeCursor(), true)
+	}
+
+	return nil
+}
+
+// getShowNewsArgHints show news 命令的参数提示
+func getShowNewsArgHints() []prompt.Suggest {
+	return []prompt.Suggest{
+		{Text: "keyword=", Description: "[选填] 关键词，检索历史新闻归档"},
+		{Text: "since=", Description: "[选填] 起始时间，如 30m、24h、7d、2025-01-06"},
+		{Text: "source=", Description: "[选填] 新闻源，如 blockbeats"},
+	}
+}
+
 // handleUseCommandCompletion 处理 use 命令的动态补全
 func handleUseCommandCompletion(ctx *Context, d prompt.Document, w string, fields []string, first, second string) []prompt.Suggest {
 	if first != "use" {
@@ -323,6 +376,11 @@ func handleSetCommandCompletion(ctx *Context, d prompt.Document, w string, field
 		return result
 	}
 
+	// 处理 set risk
+	if result := handleSetRiskCompletion(d, w, fields, second); result != nil {
+		return result
+	}
+
 	return nil
 }
 
@@ -385,6 +443,52 @@ func handleSetProxyCompletion(ctx *Context, d prompt.Document, w string, fields
 	return nil
 }
 
+// handleSetRiskCompletion 处理 set risk 命令的补全
+func handleSetRiskCompletion(d prompt.Document, w string, fields []string, second string) []prompt.Suggest {
+	if second != "risk" || len(fields) < 2 {
+		return nil
+	}
+
+	// 过滤掉已输入的参数
+	used := make(map[string]bool)
+	for _, field := range fields[2:] {
+		if name, _, ok := strings.Cut(field, "="); ok {
+			used[name] = true
+		}
+	}
+	var available []prompt.Suggest
+	for _, arg := range getSetRiskArgHints() {
+		if !used[strings.TrimSuffix(arg.Text, "=")] {
+			available = append(available, arg)
+		}
+	}
+
+	if strings.HasSuffix(w, " ") {
+		return available
+	}
+
+	// 正在输入参数名时按前缀过滤，输入参数值时不提示
+	current := fields[len(fields)-1]
+	if len(fields) > 2 && !strings.Contains(current, "=") {
+		return prompt.FilterHasPrefix(available, d.GetWordBeforeCursor(), true)
+	}
+
+	return nil
+}
+
+// getSetRiskArgHints set risk 命令的参数提示
+func getSetRiskArgHints() []prompt.Suggest {
+	return []prompt.Suggest{
+		{Text: "max_order_notional=", Description: "[选填] 单笔订单最大名义价值（USDT），0 表示不限制"},
+		{Text: "max_exposure=", Description: "[选填] 持仓总名义价值上限（USDT），0 表示不限制"},
+		{Text: "max_leverage=", Description: "[选填] 最大杠杆倍数，0 表示不限制"},
+		{Text: "max_open_positions=", Description: "[选填] 最大持仓数量，0 表示不限制"},
+		{Text: "max_daily_loss=", Description: "[选填] 当日最大已实现亏损（USDT），0 表示不限制"},
+		{Text: "allow_symbols=", Description: "[选填] 允许交易的标的，逗号分隔，如 BTC,ETH，为空表示不限制"},
+		{Text: "deny_symbols=", Description: "[选填] 禁止交易的标的，逗号分隔，如 DOGE,PEPE"},
+	}
+}
+
 // handleArgumentHints 处理参数提示
 func handleArgumentHints(ctx *Context, d prompt.Document, w string, fields []string, first, second string) []prompt.Suggest {
 	if len(fields) < 2 {
@@ -713,15 +817,19 @@ func getCompleter(ctx *Context) prompt.Completer {
 func getTopLevelHelpSuggestions() []prompt.Suggest {
 	return []prompt.Suggest{
 		{Text: "help", Description: "显示帮助信息 - 查看所有命令说明"},
-		{Text: "show", Description: "查看数据列表 - 支持子命令：exchange(交易所)、account(账户)、balance(资产)、position(持仓)、symbol(交易对)、strategy(策略)、order(订单)、news(新闻)"},
+		{Text: "show", Description: "查看数据列表 - 支持子命令：exchange(交易所)、account(账户)、balance(资产)、position(持仓)、symbol(交易对)、strategy(策略)、order(订单)、dca(定投计划)、news(新闻)"},
 		{Text: "use", Description: "激活上下文 - 支持子命令：exchange(交易所)、account(交易账户)"},
 		{Text: "create", Description: "创建资源 - 支持子命令：account(交易账户)"},
 		{Text: "update", Description: "更新资源 - 支持子命令：symbol(交易对)、account(交易账户)"},
-		{Text: "set", Description: "设置配置 - 支持子命令：config(默认交易配置)、proxy(默认代理)"},
+		{Text: "set", Description: "设置配置 - 支持子命令：config(默认交易配置)、proxy(默认代理)、risk(风控规则)"},
 		{Text: "open", Description: "开仓/下单 - 执行交易开仓操作"},
 		{Text: "close", Description: "平仓 - 执行交易平仓操作"},
-		{Text: "cancel", Description: "取消订单 - 支持子命令：order(策略订单)"},
+		{Text: "dca", Description: "定投 - 按 cron 表达式定期开仓，可用 with 指定执行条件"},
+		{Text: "cancel", Description: "取消订单 - 支持子命令：order(策略订单)、dca(定投计划)"},
 		{Text: "delete", Description: "删除资源 - 支持子命令：account(交易账户)"},
+		{Text: "panic", Description: "紧急停止 - 取消策略订单、撤销挂单并平掉所有仓位（别名：killswitch）"},
+		{Text: "pause", Description: "暂停 - 支持子命令：order(策略订单)、engine(策略引擎)"},
+		{Text: "resume", Description: "恢复 - 支持子命令：order(策略订单)、engine(策略引擎)"},
 		{Text: "exit", Description: "退出系统"},
 		{Text: "quit", Description: "退出系统"},
 	}
@@ -736,7 +844,8 @@ func getShowSuggestions() []prompt.Suggest {
 		{Text: "position", Description: "查看持仓"},
 		{Text: "symbol", Description: "查看可用交易对"},
 		{Text: "strategy", Description: "查看策略模板"},
-		{Text: "order", Description: "查看订单列表"},
+		{Text: "order", Description: "查看订单列表，指定订单ID查看委托历史"},
+		{Text: "dca", Description: "查看定投计划"},
 		{Text: "news", Description: "查看金融新闻"},
 	}
 }
@@ -764,13 +873,31 @@ func getSubcommandSuggestions() map[string][]prompt.Suggest {
 		"set": {
 			{Text: "config", Description: "设置默认保证金模式的杠杆倍数"},
 			{Text: "proxy", Description: "更新默认代理配置"},
+			{Text: "risk", Description: "设置账户风控规则（不带参数时查看当前规则）"},
 		},
 		"cancel": {
 			{Text: "order", Description: "取消订单"},
+			{Text: "dca", Description: "取消定投计划"},
 		},
 		"delete": {
 			{Text: "account", Description: "删除交易账户"},
 		},
+		"panic": {
+			{Text: "all", Description: "作用于所有账户"},
+			{Text: "rearm", Description: "解除紧急停止，引擎恢复处理订单"},
+		},
+		"killswitch": {
+			{Text: "all", Description: "作用于所有账户"},
+			{Text: "rearm", Description: "解除紧急停止，引擎恢复处理订单"},
+		},
+		"pause": {
+			{Text: "order", Description: "暂停等待中的策略订单"},
+			{Text: "engine", Description: "暂停策略引擎"},
+		},
+		"resume": {
+			{Text: "order", Description: "恢复已暂停的策略订单"},
+			{Text: "engine", Description: "恢复策略引擎"},
+		},
 	}
 }
 
@@ -970,11 +1097,12 @@ func handleOpenCommandCompletion(ctx *Context, d prompt.Document, w string, fiel
 		return nil
 	}
 
-	// 输入amount后，可以选择with策略（选填）
+	// 输入amount后，可以选择with策略或订单选项（选填）
 	if len(fields) == 5 && strings.HasSuffix(w, " ") {
-		return []prompt.Suggest{
+		return append([]prompt.Suggest{
 			{Text: "with", Description: "[选填] 添加策略条件"},
-		}
+			{Text: "then", Description: "[选填] then close with <策略>，开仓成交后激活平仓策略"},
+		}, getOrderOptionSuggestions()...)
 	}
 
 	// 输入with后，显示策略提示
@@ -998,6 +1126,25 @@ func handleOpenCommandCompletion(ctx *Context, d prompt.Document, w string, fiel
 	return nil
 }
 
+// getOrderOptionSuggestions 获取 open 命令的订单选项提示
+func getOrderOptionSuggestions() []prompt.Suggest {
+	return []prompt.Suggest{
+		{Text: "limit=", Description: "[选填] 限价表达式，如 market.okx.BTC.price * 0.998"},
+		{Text: "post_only", Description: "[选填] 只做 maker（需指定 limit）"},
+		{Text: "ioc", Description: "[选填] 立即成交并取消剩余（需指定 limit）"},
+		{Text: "fok", Description: "[选填] 全部成交或立即取消（需指定 limit）"},
+		{Text: "expire=", Description: "[选填] 订单有效时长，如 30m、24h、7d"},
+		{Text: "expire_at=", Description: "[选填] 订单过期时间，如 2025-07-01T00:00Z"},
+		{Text: "algo=twap", Description: "[选填] TWAP 拆分执行（需指定 duration、slices）"},
+		{Text: "algo=iceberg", Description: "[选填] 冰山单逐笔挂出（需指定 limit、visible）"},
+		{Text: "duration=", Description: "[选填] TWAP 执行时长，如 30m、2h"},
+		{Text: "slices=", Description: "[选填] TWAP 拆分笔数，如 10"},
+		{Text: "visible=", Description: "[选填] 冰山单每笔可见比例，如 5%"},
+		{Text: "chase=true", Description: "[选填] 未成交时按买一/卖一价撤单重下（需指定 limit）"},
+		{Text: "max_slippage=", Description: "[选填] 追价最大滑点，如 0.2%"},
+	}
+}
+
 // handleCloseCommandCompletion 处理 close 命令的补全
 func handleCloseCommandCompletion(ctx *Context, d prompt.Document, w string, fields []string, first string) []prompt.Suggest {
 	if first != "close" {
@@ -1058,6 +1205,28 @@ func handleCloseCommandCompletion(ctx *Context, d prompt.Document, w string, fie
 		return filtered
 	}
 
+	// 选择保证金模式后，可选平仓数量或策略
+	if len(fields) == 4 && strings.HasSuffix(w, " ") {
+		return []prompt.Suggest{
+			{Text: "with", Description: "[选填] 添加策略条件"},
+			{Text: "50%", Description: "[选填] 按仓位百分比部分平仓"},
+			{Text: "100U", Description: "[选填] 按 USDT 金额部分平仓"},
+			{Text: "limit=", Description: "[选填] 限价平仓表达式"},
+			{Text: "chase=true", Description: "[选填] 限价平仓未成交时追买一/卖一价"},
+			{Text: "reduce_only", Description: "[选填] 限价全部平仓也只减仓，不会反向开仓"},
+		}
+	}
+
+	// 输入平仓数量后，可选限价或策略
+	if len(fields) == 5 && strings.HasSuffix(w, " ") && fields[4] != "with" {
+		return []prompt.Suggest{
+			{Text: "with", Description: "[选填] 添加策略条件"},
+			{Text: "limit=", Description: "[选填] 限价平仓表达式"},
+			{Text: "chase=true", Description: "[选填] 限价平仓未成交时追买一/卖一价"},
+			{Text: "reduce_only", Description: "[选填] 限价全部平仓也只减仓，不会反向开仓"},
+		}
+	}
+
 	return nil
 }
 
@@ -1098,7 +1267,7 @@ func getCancelOrderList(ctx *Context) []prompt.Suggest {
 		return []prompt.Suggest{}
 	}
 
-	accountOrderList, err := grpcClient.GetOrders(ctx.GetAccountInstance().Id, []string{"waiting"})
+	accountOrderList, err := grpcClient.GetOrders(ctx.GetAccountInstance().Id, []string{"pending", "waiting", "paused", "executing"})
 	if err != nil {
 		return []prompt.Suggest{}
 	}
@@ -1111,9 +1280,12 @@ func getCancelOrderList(ctx *Context) []prompt.Suggest {
 	for _, order := range accountOrderList {
 
 		var amount string
-		if order.SizeType == "USDT" {
+		switch order.SizeType {
+		case "USDT":
 			amount = fmt.Sprintf("%sU", order.Size)
-		} else {
+		case "percent":
+			amount = fmt.Sprintf("%s%%", order.Size)
+		default:
 			amount = order.Size
 		}
 
@@ -1137,6 +1309,63 @@ func getCancelOrderList(ctx *Context) []prompt.Suggest {
 	return suggestions
 }
 
+// handlePauseOrderCompletion 处理 pause/resume order 的订单 ID 补全
+func handlePauseOrderCompletion(ctx *Context, d prompt.Document, w string, fields []string, first, second string) []prompt.Suggest {
+	if (first != "pause" && first != "resume") || second != "order" {
+		return nil
+	}
+
+	// pause 可选择等待中的订单，resume 可选择已暂停的订单
+	status := "waiting"
+	if first == "resume" {
+		status = "paused"
+	}
+
+	if len(fields) == 2 && strings.HasSuffix(w, " ") {
+		return getPauseOrderList(ctx, status)
+	}
+
+	if len(fields) == 3 && !strings.HasSuffix(w, " ") {
+		prefix := d.GetWordBeforeCursor()
+		var filtered []prompt.Suggest
+		for _, order := range getPauseOrderList(ctx, status) {
+			if strings.HasPrefix(order.Text, prefix) {
+				filtered = append(filtered, order)
+			}
+		}
+		return filtered
+	}
+
+	return nil
+}
+
+// getPauseOrderList 获取指定状态的策略订单 ID 列表
+func getPauseOrderList(ctx *Context, status string) []prompt.Suggest {
+	grpcClient := ctx.GetGRPCClient()
+	if grpcClient == nil || ctx.GetAccountInstance() == nil {
+		return []prompt.Suggest{}
+	}
+
+	orders, err := grpcClient.GetOrders(ctx.GetAccountInstance().Id, []string{status})
+	if err != nil {
+		return []prompt.Suggest{}
+	}
+
+	var suggestions []prompt.Suggest
+	for _, order := range orders {
+		description := fmt.Sprintf("%s:%s:%s:%s", order.Symbol, order.Side, order.PosSide, order.Size)
+		if order.Strategy != "" {
+			description = fmt.Sprintf("%s 策略: %s", description, order.Strategy)
+		}
+		suggestions = append(suggestions, prompt.Suggest{
+			Text:        strconv.FormatInt(order.ID, 10),
+			Description: description,
+		})
+	}
+
+	return suggestions
+}
+
 // getOpenSymbolList 获取open命令的symbol列表（暂时使用mock数据）
 func getOpenSymbolList(ctx *Context) []prompt.Suggest {
 	// 检查是否有 gRPC 客户端
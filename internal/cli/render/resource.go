@@ -2,6 +2,7 @@ package render
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/jedib0t/go-pretty/v6/table"
@@ -260,7 +261,7 @@ func RenderStrategyOrders(orders []*model.FoxOrder) string {
 func RenderOrders(orders []*grpc.ShowOrderItem) string {
 	pt := utils.NewPrettyTable()
 	pt.SetTitle("订单列表")
-	pt.SetHeaders([]interface{}{"ID", "交易对", "方向", "仓位", "数量/金额", "价格", "状态", "下单时间", "策略", "异常结果"})
+	pt.SetHeaders([]interface{}{"ID", "交易所订单ID", "父订单ID", "交易对", "方向", "仓位", "数量/金额", "价格", "状态", "下单时间", "剩余时间", "策略", "异常结果"})
 
 	for _, order := range orders {
 		side := ""
@@ -279,6 +280,14 @@ func RenderOrders(orders []*grpc.ShowOrderItem) string {
 
 		status := "等待中"
 		switch order.Status {
+		case "pending":
+			status = "待激活"
+		case "executing":
+			status = fmt.Sprintf("执行中 %s/%s 张", order.AlgoFilled, order.AlgoTotal)
+		case "paused":
+			status = "已暂停"
+		case "expired":
+			status = "已过期"
 		case "opened":
 			status = "开仓成功"
 		case "closed":
@@ -288,14 +297,31 @@ func RenderOrders(orders []*grpc.ShowOrderItem) string {
 		case "failed":
 			status = "失败"
 		}
+		switch order.Chase {
+		case "active":
+			status += "（追价中）"
+		case "stopped":
+			status += "（已停止追价）"
+		}
 
 		var amount string
 		switch order.SizeType {
 		case "USDT":
 			amount = fmt.Sprintf("%sU", order.Size)
+		case "percent":
+			amount = fmt.Sprintf("%s%%", order.Size)
 		default:
 			amount = order.Size
 		}
+		if order.Type == "close" && (amount == "" || amount == "0") {
+			amount = "全部仓位"
+		}
+		switch order.Algo {
+		case "twap":
+			amount += fmt.Sprintf("（TWAP %s×%d）", time.Duration(order.AlgoDuration)*time.Second, order.AlgoSlices)
+		case "iceberg":
+			amount += fmt.Sprintf("（冰山 %s%%）", order.AlgoVisible)
+		}
 
 		price := "-"
 		if order.Price != "" {
@@ -304,6 +330,13 @@ func RenderOrders(orders []*grpc.ShowOrderItem) string {
 				price = order.Price
 			}
 		}
+		// 限价单触发前显示限价表达式
+		if price == "-" && order.PriceExpr != "" {
+			price = order.PriceExpr
+		}
+		if order.Chase != "" && order.MaxSlippage != "" {
+			price += fmt.Sprintf("（追价 ±%s%%）", order.MaxSlippage)
+		}
 
 		strategy := "-"
 		if len(order.Strategy) > 0 {
@@ -315,8 +348,20 @@ func RenderOrders(orders []*grpc.ShowOrderItem) string {
 			msg = order.Msg
 		}
 
+		orderID := "-"
+		if order.OrderID != "" {
+			orderID = order.OrderID
+		}
+
+		parentID := "-"
+		if order.ParentID > 0 {
+			parentID = strconv.FormatInt(order.ParentID, 10)
+		}
+
 		pt.AddRow([]interface{}{
-			order.OrderID,
+			order.ID,
+			orderID,
+			parentID,
 			order.Symbol,
 			side,
 			posSide,
@@ -324,6 +369,7 @@ func RenderOrders(orders []*grpc.ShowOrderItem) string {
 			price,
 			status,
 			time.Unix(order.CreatedAt, 0).Format("2006-01-02 15:04:05"),
+			formatOrderTTL(order),
 			strategy,
 			msg,
 		})
@@ -332,6 +378,105 @@ func RenderOrders(orders []*grpc.ShowOrderItem) string {
 	return pt.Render()
 }
 
+// RenderOrderHistory 渲染订单的委托变更历史
+func RenderOrderHistory(histories []*grpc.ShowOrderHistoryItem) string {
+	pt := utils.NewPrettyTable()
+	pt.SetTitle("订单委托历史")
+	pt.SetHeaders([]interface{}{"ID", "时间", "变更", "交易所订单ID", "价格", "数量", "说明"})
+
+	for _, history := range histories {
+		action := history.Action
+		switch history.Action {
+		case "submit":
+			action = "提交"
+		case "amend":
+			action = "追价改单"
+		case "stop":
+			action = "停止追价"
+		case "done":
+			action = "追价结束"
+		case "failed":
+			action = "改单失败"
+		}
+
+		price, size, msg := "-", "-", "-"
+		if history.Price != "" {
+			price = history.Price
+		}
+		if history.Size != "" {
+			size = history.Size
+		}
+		if history.Msg != "" {
+			msg = history.Msg
+		}
+
+		pt.AddRow([]interface{}{
+			history.ID,
+			time.Unix(history.CreatedAt, 0).Format("2006-01-02 15:04:05"),
+			action,
+			history.ClientOrderID,
+			price,
+			size,
+			msg,
+		})
+	}
+
+	return pt.Render()
+}
+
+// RenderDcaPlans 渲染定投计划列表
+func RenderDcaPlans(plans []*grpc.ShowDcaPlanItem) string {
+	pt := utils.NewPrettyTable()
+	pt.SetTitle("定投计划")
+	pt.SetHeaders([]interface{}{"ID", "交易对", "仓位", "每次数量", "周期", "执行条件", "状态", "下次执行", "已下单/跳过", "最近订单ID", "最近结果"})
+
+	for _, plan := range plans {
+		posSide := plan.PosSide
+		if plan.PosSide == "long" {
+			posSide = fmt.Sprintf("%s(多头)", plan.PosSide)
+		} else if plan.PosSide == "short" {
+			posSide = fmt.Sprintf("%s(空头)", plan.PosSide)
+		}
+
+		amount := plan.Amount
+		if plan.AmountType == "USDT" {
+			amount = fmt.Sprintf("%sU", plan.Amount)
+		}
+
+		guard, msg, lastOrderID := "-", "-", "-"
+		if plan.Guard != "" {
+			guard = plan.Guard
+		}
+		if plan.Msg != "" {
+			msg = plan.Msg
+		}
+		if plan.LastOrderID > 0 {
+			lastOrderID = strconv.FormatInt(plan.LastOrderID, 10)
+		}
+
+		status, nextRun := "进行中", time.Unix(plan.NextRunAt, 0).Format("2006-01-02 15:04")
+		if plan.Status == "cancelled" {
+			status, nextRun = "已取消", "-"
+		}
+
+		pt.AddRow([]interface{}{
+			plan.ID,
+			plan.Symbol,
+			fmt.Sprintf("%s %s", posSide, plan.MarginType),
+			amount,
+			plan.Schedule,
+			guard,
+			status,
+			nextRun,
+			fmt.Sprintf("%d/%d", plan.Runs, plan.Skips),
+			lastOrderID,
+			msg,
+		})
+	}
+
+	return pt.Render()
+}
+
 // RenderNews 渲染新闻列表
 func RenderNews(newsList []news.NewsItem) string {
 	if len(newsList) == 0 {
@@ -379,3 +524,30 @@ func truncateString(s string, maxLen int) string {
 	}
 	return s[:maxLen] + "..."
 }
+
+// formatOrderTTL 格式化订单剩余有效时间，未设置过期时间或订单已结束时显示 -
+func formatOrderTTL(order *grpc.ShowOrderItem) string {
+	if order.Status == "expired" {
+		return "已过期"
+	}
+	if order.ExpireAt <= 0 {
+		return "-"
+	}
+
+	// 市价单、ioc、fok 提交后即结束，挂单（limit、post_only）提交后在成交前仍会过期
+	switch order.Status {
+	case "waiting", "paused":
+	case "opened":
+		if order.OrderType != "limit" && order.OrderType != "post_only" {
+			return "-"
+		}
+	default:
+		return "-"
+	}
+
+	remaining := time.Until(time.Unix(order.ExpireAt, 0))
+	if remaining <= 0 {
+		return "即将过期"
+	}
+	return remaining.Truncate(time.Second).String()
+}
@@ -1,7 +1,10 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"strings"
+	"time"
 )
 
 const Version = "v0.2.0"
@@ -26,10 +29,22 @@ type SymbolInfo struct {
 // ExchangeSymbolList 各个交易所交易对数据（内存存储）
 var ExchangeSymbolList map[string][]SymbolInfo
 
+// NewsFeed RSS/Atom 新闻源配置
+type NewsFeed struct {
+	Name string // DSL 中的数据源名
+	URL  string // 订阅地址
+}
+
 type Config struct {
-	Version string
-	DBFile  string
-	WorkDir string
+	Version          string
+	DBFile           string
+	WorkDir          string
+	NewsPollInterval time.Duration // 新闻轮询间隔，为 0 时使用默认值
+	NewsFeeds        []NewsFeed    // RSS/Atom 新闻源
+
+	NewsSentimentLexicon string // 自定义情绪词典文件（JSON），与内置词典合并
+
+	MaintenanceWindows []MaintenanceWindow // 维护时间窗口，窗口内引擎自动暂停
 }
 
 var GlobalConfig *Config
@@ -47,5 +62,147 @@ func LoadConfig() error {
 		WorkDir: workDir,
 	}
 
+	// 新闻轮询间隔，如 NEWS_POLL_INTERVAL=1m
+	if value := os.Getenv("NEWS_POLL_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			return fmt.Errorf("invalid NEWS_POLL_INTERVAL %q", value)
+		}
+		GlobalConfig.NewsPollInterval = interval
+	}
+
+	// RSS/Atom 新闻源，如 NEWS_FEEDS=coindesk=https://www.coindesk.com/arc/outboundfeeds/rss/,cointelegraph=https://cointelegraph.com/rss
+	if value := os.Getenv("NEWS_FEEDS"); value != "" {
+		feeds, err := ParseNewsFeeds(value)
+		if err != nil {
+			return err
+		}
+		GlobalConfig.NewsFeeds = feeds
+	}
+
+	// 自定义情绪词典，如 NEWS_SENTIMENT_LEXICON=./lexicon.json
+	GlobalConfig.NewsSentimentLexicon = os.Getenv("NEWS_SENTIMENT_LEXICON")
+
+	// 维护时间窗口（本地时间），如 MAINTENANCE_WINDOWS=daily 23:55-00:05,sat 02:00-04:00
+	if value := os.Getenv("MAINTENANCE_WINDOWS"); value != "" {
+		windows, err := ParseMaintenanceWindows(value)
+		if err != nil {
+			return err
+		}
+		GlobalConfig.MaintenanceWindows = windows
+	}
+
 	return nil
 }
+
+// ParseNewsFeeds 解析 "name=url,name=url" 格式的新闻源配置
+func ParseNewsFeeds(value string) ([]NewsFeed, error) {
+	var feeds []NewsFeed
+	seen := make(map[string]struct{})
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, url, ok := strings.Cut(entry, "=")
+		name, url = strings.TrimSpace(name), strings.TrimSpace(url)
+		if !ok || name == "" || url == "" {
+			return nil, fmt.Errorf("invalid news feed %q, expected name=url", entry)
+		}
+		if _, dup := seen[name]; dup {
+			return nil, fmt.Errorf("duplicate news feed name %q", name)
+		}
+		seen[name] = struct{}{}
+
+		feeds = append(feeds, NewsFeed{Name: name, URL: url})
+	}
+	return feeds, nil
+}
+
+// MaintenanceWindow 维护时间窗口，结束时间早于开始时间表示跨越零点
+type MaintenanceWindow struct {
+	Daily   bool         // 每天生效
+	Weekday time.Weekday // 每周生效的星期（Daily 为 false 时使用）
+	Start   int          // 开始时间（当天第几分钟）
+	End     int          // 结束时间（当天第几分钟）
+}
+
+// Contains 判断时间是否处于维护窗口内（按 t 所在时区计算）
+func (w MaintenanceWindow) Contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	if w.Start < w.End {
+		return w.onDay(t.Weekday()) && minute >= w.Start && minute < w.End
+	}
+
+	// 跨越零点：开始当天的 Start 之后，或次日的 End 之前
+	return (w.onDay(t.Weekday()) && minute >= w.Start) ||
+		(w.onDay((t.Weekday()+6)%7) && minute < w.End)
+}
+
+func (w MaintenanceWindow) onDay(weekday time.Weekday) bool {
+	return w.Daily || w.Weekday == weekday
+}
+
+var maintenanceWeekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ParseMaintenanceWindows 解析 "daily HH:MM-HH:MM,sat HH:MM-HH:MM" 格式的维护时间窗口
+func ParseMaintenanceWindows(value string) ([]MaintenanceWindow, error) {
+	var windows []MaintenanceWindow
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		fields := strings.Fields(entry)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("invalid maintenance window %q, expected <daily|mon..sun> HH:MM-HH:MM", entry)
+		}
+
+		var window MaintenanceWindow
+		day := strings.ToLower(fields[0])
+		if day == "daily" {
+			window.Daily = true
+		} else if weekday, ok := maintenanceWeekdays[day]; ok {
+			window.Weekday = weekday
+		} else {
+			return nil, fmt.Errorf("invalid maintenance window day %q", fields[0])
+		}
+
+		start, end, ok := strings.Cut(fields[1], "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid maintenance window time %q, expected HH:MM-HH:MM", fields[1])
+		}
+		var err error
+		if window.Start, err = parseClock(start); err != nil {
+			return nil, err
+		}
+		if window.End, err = parseClock(end); err != nil {
+			return nil, err
+		}
+		if window.Start == window.End {
+			return nil, fmt.Errorf("invalid maintenance window %q, start equals end", entry)
+		}
+
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+// parseClock 解析 HH:MM 格式的时间，返回当天第几分钟
+func parseClock(value string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid maintenance window time %q, expected HH:MM", value)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
@@ -3,6 +3,7 @@ package database
 import (
 	"errors"
 	"fmt"
+	"log"
 	"os"
 	"path/filepath"
 	"strings"
@@ -22,6 +23,9 @@ import (
 var _query *query.Query
 var db *gorm.DB
 
+// newsFTSEnabled 新闻全文检索（FTS5）是否可用
+var newsFTSEnabled bool
+
 // InitDB Initialize database connection
 func InitDB() error {
 	if config.GlobalConfig == nil {
@@ -78,17 +82,37 @@ func InitDB() error {
 // migrateTables 使用 GORM AutoMigrate 创建和迁移表结构
 func migrateTables() error {
 
-	// 这里需要根据系统版本进行迁移数据库
-	if err := db.AutoMigrate(
+	tables := []interface{}{
 		&models.FoxConfig{},
 		&models.FoxAccount{},
 		&models.FoxSymbol{},
 		&models.FoxOrder{},
 		&models.FoxExchange{},
-	); err != nil {
+		&models.FoxNews{},
+		&models.FoxRiskRule{},
+		&models.FoxKillSwitch{},
+		&models.FoxAlgoSlice{},
+		&models.FoxOrderHistory{},
+		&models.FoxDcaPlan{},
+		&models.FoxEnginePause{},
+		&models.FoxNewsFeed{},
+	}
+
+	// 这里需要根据系统版本进行迁移数据库
+	if err := db.AutoMigrate(tables...); err != nil {
 		return fmt.Errorf("failed to auto migrate: %w", err)
 	}
 
+	// AutoMigrate 不会更新已存在的 CHECK 约束（如订单状态新增取值）
+	if err := migrateCheckConstraints(tables...); err != nil {
+		return fmt.Errorf("failed to migrate check constraints: %w", err)
+	}
+
+	// 新闻全文检索索引（需要 SQLite 编译启用 FTS5，不可用时回退到 LIKE 检索）
+	if err := migrateNewsFTS(); err != nil {
+		log.Printf("新闻全文检索不可用，将使用 LIKE 检索: %v", err)
+	}
+
 	// 插入默认数据
 	if err := insertDefaultData(); err != nil {
 		return fmt.Errorf("failed to insert default data: %w", err)
@@ -97,6 +121,122 @@ func migrateTables() error {
 	return nil
 }
 
+// migrateCheckConstraints SQLite 不支持修改 CHECK 约束，约束与模型定义不一致时重建表并复制数据
+func migrateCheckConstraints(values ...interface{}) error {
+	for _, value := range values {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(value); err != nil {
+			return err
+		}
+
+		var ddl string
+		if err := db.Raw("SELECT sql FROM sqlite_master WHERE type = 'table' AND name = ?", stmt.Schema.Table).Scan(&ddl).Error; err != nil {
+			return err
+		}
+
+		outdated := false
+		for _, check := range stmt.Schema.ParseCheckConstraints() {
+			if !strings.Contains(ddl, check.Constraint) {
+				outdated = true
+				break
+			}
+		}
+		if !outdated {
+			continue
+		}
+
+		if err := rebuildTable(value, stmt.Schema.Table); err != nil {
+			return fmt.Errorf("failed to rebuild table %s: %w", stmt.Schema.Table, err)
+		}
+		log.Printf("数据表 %s 约束已更新", stmt.Schema.Table)
+	}
+
+	return nil
+}
+
+// rebuildTable 按模型定义重新创建表，保留原有数据（调用前表结构已通过 AutoMigrate 补齐字段）
+func rebuildTable(value interface{}, table string) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		backup := table + "_backup"
+		if err := tx.Exec(fmt.Sprintf("ALTER TABLE `%s` RENAME TO `%s`", table, backup)).Error; err != nil {
+			return err
+		}
+
+		// 索引名称全局唯一，先删除原表索引再按模型重新创建
+		var indexes []string
+		if err := tx.Raw("SELECT name FROM sqlite_master WHERE type = 'index' AND tbl_name = ? AND sql IS NOT NULL", backup).Scan(&indexes).Error; err != nil {
+			return err
+		}
+		for _, index := range indexes {
+			if err := tx.Exec(fmt.Sprintf("DROP INDEX `%s`", index)).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Migrator().CreateTable(value); err != nil {
+			return err
+		}
+
+		columnTypes, err := tx.Migrator().ColumnTypes(value)
+		if err != nil {
+			return err
+		}
+		columns := make([]string, 0, len(columnTypes))
+		for _, columnType := range columnTypes {
+			columns = append(columns, "`"+columnType.Name()+"`")
+		}
+		columnList := strings.Join(columns, ", ")
+
+		if err := tx.Exec(fmt.Sprintf("INSERT INTO `%s` (%s) SELECT %s FROM `%s`", table, columnList, columnList, backup)).Error; err != nil {
+			return err
+		}
+		return tx.Exec(fmt.Sprintf("DROP TABLE `%s`", backup)).Error
+	})
+}
+
+// migrateNewsFTS 创建新闻全文检索虚拟表及同步触发器
+// 使用 trigram 分词以支持中文等无空格分隔文本的子串检索
+func migrateNewsFTS() error {
+	var exists int64
+	if err := db.Raw("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'fox_news_fts'").Scan(&exists).Error; err != nil {
+		return err
+	}
+
+	statements := []string{
+		`CREATE VIRTUAL TABLE IF NOT EXISTS fox_news_fts USING fts5(title, content, content='fox_news', content_rowid='id', tokenize='trigram')`,
+		`CREATE TRIGGER IF NOT EXISTS fox_news_fts_insert AFTER INSERT ON fox_news BEGIN
+			INSERT INTO fox_news_fts(rowid, title, content) VALUES (new.id, new.title, new.content);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS fox_news_fts_delete AFTER DELETE ON fox_news BEGIN
+			INSERT INTO fox_news_fts(fox_news_fts, rowid, title, content) VALUES ('delete', old.id, old.title, old.content);
+		END`,
+		`CREATE TRIGGER IF NOT EXISTS fox_news_fts_update AFTER UPDATE ON fox_news BEGIN
+			INSERT INTO fox_news_fts(fox_news_fts, rowid, title, content) VALUES ('delete', old.id, old.title, old.content);
+			INSERT INTO fox_news_fts(rowid, title, content) VALUES (new.id, new.title, new.content);
+		END`,
+	}
+	for _, statement := range statements {
+		if err := db.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	// 首次创建索引时为已归档的新闻建立索引
+	if exists == 0 {
+		if err := db.Exec("INSERT INTO fox_news_fts(fox_news_fts) VALUES ('rebuild')").Error; err != nil {
+			return err
+		}
+	}
+
+	newsFTSEnabled = true
+	return nil
+}
+
+// NewsFTSEnabled 新闻全文检索是否可用
+func NewsFTSEnabled() bool {
+	return newsFTSEnabled
+}
+
 // insertDefaultData 插入默认数据
 func insertDefaultData() error {
 	// 插入默认交易所数据
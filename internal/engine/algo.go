@@ -0,0 +1,442 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/lemconn/foxflow/internal/pkg/dao/query"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// maxIcebergSlices 冰山单最多拆分的笔数（可见比例过小时拒绝执行）
+const maxIcebergSlices = 1000
+
+// startAlgo 策略触发后按执行算法拆分订单，创建子订单并将订单置为 executing
+func (e *Engine) startAlgo(exchangeInstance exchange.Exchange, order *model.FoxOrder, contracts string) error {
+	total, err := decimal.NewFromString(contracts)
+	if err != nil {
+		return fmt.Errorf("failed to parse order contracts: %w", err)
+	}
+
+	symbol, err := exchangeInstance.GetSymbols(e.ctx, order.Symbol)
+	if err != nil {
+		return fmt.Errorf("failed to get symbol info: %w", err)
+	}
+
+	now := e.now()
+	algoSlices, err := planAlgoSlices(order, total, symbol.MinSize, now)
+	if err != nil {
+		order.Msg = err.Error()
+		order.Status = "failed"
+		if err := database.Adapter().FoxOrder.Save(order); err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+		log.Printf("算法订单拆分失败: ID=%d, Error=%s", order.ID, order.Msg)
+		return err
+	}
+
+	order.Status = "executing"
+	order.AlgoTotal = sumSliceSize(algoSlices).String()
+	order.AlgoFilled = "0"
+	err = database.Adapter().Transaction(func(tx *query.Query) error {
+		if err := tx.FoxOrder.Save(order); err != nil {
+			return err
+		}
+		return tx.FoxAlgoSlice.Create(algoSlices...)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create algo slices: %w", err)
+	}
+	log.Printf("算法订单开始执行: ID=%d, Algo=%s, Total=%s, Slices=%d", order.ID, order.Algo, order.AlgoTotal, len(algoSlices))
+
+	return e.executeAlgo(exchangeInstance, order, now)
+}
+
+// planAlgoSlices 拆分算法订单：TWAP 按时长均匀拆分，冰山单按可见比例拆分；数量按最小下单数量向下取整，余数计入最后一笔
+func planAlgoSlices(order *model.FoxOrder, total decimal.Decimal, minSize string, now time.Time) ([]*model.FoxAlgoSlice, error) {
+	lot, err := decimal.NewFromString(minSize)
+	if err != nil || !lot.IsPositive() {
+		lot = decimal.Zero
+	}
+	roundLot := func(size decimal.Decimal) decimal.Decimal {
+		if lot.IsZero() {
+			return size
+		}
+		return size.Div(lot).Floor().Mul(lot)
+	}
+
+	total = roundLot(total)
+	if !total.IsPositive() {
+		return nil, fmt.Errorf("下单数量小于最小下单数量: %s", minSize)
+	}
+
+	var size decimal.Decimal
+	var count int64
+	switch order.Algo {
+	case "twap":
+		count = order.AlgoSlices
+		if count <= 0 {
+			return nil, fmt.Errorf("TWAP 拆分笔数无效: %d", count)
+		}
+		size = roundLot(total.Div(decimal.NewFromInt(count)))
+		if !size.IsPositive() {
+			return nil, fmt.Errorf("总数量 %s 张不足以拆分为 %d 笔（最小下单数量 %s）", total.String(), count, minSize)
+		}
+	case "iceberg":
+		visible, err := decimal.NewFromString(order.AlgoVisible)
+		if err != nil || !visible.IsPositive() {
+			return nil, fmt.Errorf("冰山单可见比例无效: %s", order.AlgoVisible)
+		}
+		size = roundLot(total.Mul(visible).Div(decimal.NewFromInt(100)))
+		if !size.IsPositive() {
+			size = lot
+		}
+		count = total.Div(size).Ceil().IntPart()
+		if count > maxIcebergSlices {
+			return nil, fmt.Errorf("冰山单可见比例过小，需拆分为 %d 笔（最多 %d 笔）", count, maxIcebergSlices)
+		}
+	default:
+		return nil, fmt.Errorf("不支持的执行算法: %s", order.Algo)
+	}
+
+	algoSlices := make([]*model.FoxAlgoSlice, 0, count)
+	remaining := total
+	for i := int64(0); i < count && remaining.IsPositive(); i++ {
+		sliceSize := decimal.Min(size, remaining)
+		if i == count-1 {
+			sliceSize = remaining
+		}
+		remaining = remaining.Sub(sliceSize)
+
+		// TWAP 子订单按时间均匀提交，冰山单子订单在上一笔成交后提交
+		var scheduledAt int64
+		if order.Algo == "twap" {
+			scheduledAt = now.Unix() + order.AlgoDuration*i/count
+		}
+		algoSlices = append(algoSlices, &model.FoxAlgoSlice{
+			OrderID:       order.ID,
+			ClientOrderID: fmt.Sprintf("%sA%d", order.OrderID, i+1),
+			Seq:           i + 1,
+			Size:          sliceSize.String(),
+			Status:        "scheduled",
+			ScheduledAt:   scheduledAt,
+		})
+	}
+	return algoSlices, nil
+}
+
+// sumSliceSize 汇总子订单委托张数
+func sumSliceSize(algoSlices []*model.FoxAlgoSlice) decimal.Decimal {
+	total := decimal.Zero
+	for _, slice := range algoSlices {
+		size, _ := decimal.NewFromString(slice.Size)
+		total = total.Add(size)
+	}
+	return total
+}
+
+// processAlgoOrders 按账户执行算法订单，并撤销已取消算法订单仍挂在交易所的子订单
+func (e *Engine) processAlgoOrders(now time.Time) error {
+	orders, err := e.activeAlgoOrders()
+	if err != nil {
+		return err
+	}
+
+	accountOrders := make(map[int64][]*model.FoxOrder)
+	for _, order := range orders {
+		if e.Halted(order.AccountID) {
+			continue
+		}
+		accountOrders[order.AccountID] = append(accountOrders[order.AccountID], order)
+	}
+
+	for accountID, orderList := range accountOrders {
+		account, err := database.Adapter().FoxAccount.Where(database.Adapter().FoxAccount.ID.Eq(accountID)).First()
+		if err != nil {
+			log.Printf("获取账户 %d 失败: %v", accountID, err)
+			continue
+		}
+
+		exchangeInstance, err := e.exchangeMgr.GetExchange(account.Exchange)
+		if err != nil {
+			log.Printf("获取交易所 %s 失败: %v", account.Exchange, err)
+			continue
+		}
+		if err := exchangeInstance.Connect(e.ctx, account); err != nil {
+			log.Printf("连接账户 %d 到交易所失败: %v", accountID, err)
+			continue
+		}
+
+		for _, order := range orderList {
+			if order.Status == "executing" {
+				err = e.executeAlgo(exchangeInstance, order, now)
+			} else {
+				err = e.withdrawAlgoSlices(exchangeInstance, order)
+			}
+			if err != nil {
+				log.Printf("处理算法订单 %d 时出错: %v", order.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// activeAlgoOrders 获取执行中的算法订单，以及已结束但仍有子订单挂在交易所的算法订单
+func (e *Engine) activeAlgoOrders() ([]*model.FoxOrder, error) {
+	q := database.Adapter().FoxOrder
+	sq := database.Adapter().FoxAlgoSlice
+	orders, err := q.Where(q.Algo.Neq("")).Where(
+		q.Where(q.Status.Eq("executing")).
+			Or(q.Columns(q.ID).In(sq.Select(sq.OrderID).Where(sq.Status.Eq("submitted")))),
+	).Find()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to get algo orders: %w", err)
+	}
+	return orders, nil
+}
+
+// executeAlgo 推进算法订单：确认已挂出子订单的成交情况，提交到期的子订单，全部完成后结束订单
+func (e *Engine) executeAlgo(exchangeInstance exchange.Exchange, order *model.FoxOrder, now time.Time) error {
+	sq := database.Adapter().FoxAlgoSlice
+	algoSlices, err := sq.Where(sq.OrderID.Eq(order.ID)).Order(sq.Seq).Find()
+	if err != nil {
+		return fmt.Errorf("failed to get algo slices: %w", err)
+	}
+
+	filled, _ := decimal.NewFromString(order.AlgoFilled)
+	defer func() {
+		if filled.String() != order.AlgoFilled {
+			order.AlgoFilled = filled.String()
+			q := database.Adapter().FoxOrder
+			if _, err := q.Where(q.ID.Eq(order.ID)).UpdateSimple(q.AlgoFilled.Value(order.AlgoFilled)); err != nil {
+				log.Printf("更新算法订单 %d 成交进度失败: %v", order.ID, err)
+			}
+		}
+	}()
+
+	for _, slice := range algoSlices {
+		switch slice.Status {
+		case "submitted":
+			// 冰山单子订单仍在交易所挂单时等待成交
+			exchangeOrder, err := e.getSliceOrder(exchangeInstance, order, slice)
+			if err != nil || sliceLive(exchangeOrder) {
+				return err
+			}
+			if err := settleSlice(slice, exchangeOrder, "交易所订单已撤销"); err != nil {
+				return err
+			}
+			filled = filled.Add(decimal.RequireFromString(slice.Filled))
+
+			// 子订单在交易所被撤销（手动撤单、post_only 被拒绝等）时终止算法订单，按实际成交处理
+			if slice.Status != "filled" {
+				return e.finishAlgo(order, filled, fmt.Sprintf("第 %d 笔子订单已被交易所撤销", slice.Seq))
+			}
+
+		case "scheduled":
+			if slice.ScheduledAt > now.Unix() {
+				return nil
+			}
+			executing, err := e.algoExecuting(order)
+			if err != nil || !executing {
+				return err
+			}
+
+			if err := e.submitSlice(exchangeInstance, order, slice); err != nil {
+				return e.finishAlgo(order, filled, fmt.Sprintf("第 %d 笔子订单提交失败: %v", slice.Seq, err))
+			}
+			if slice.Status == "submitted" {
+				return nil
+			}
+			filled = filled.Add(decimal.RequireFromString(slice.Size))
+		}
+	}
+
+	return e.finishAlgo(order, filled, "")
+}
+
+// submitSlice 提交子订单：TWAP 以市价单提交视为立即成交，冰山单以限价单挂出
+func (e *Engine) submitSlice(exchangeInstance exchange.Exchange, order *model.FoxOrder, slice *model.FoxAlgoSlice) error {
+	if e.Halted(order.AccountID) {
+		return fmt.Errorf("engine halted by kill switch")
+	}
+
+	orderType := "market"
+	if order.Algo == "iceberg" {
+		orderType = order.OrderType
+	}
+	_, err := exchangeInstance.CreateOrder(e.ctx, &exchange.Order{
+		OrderID:    slice.ClientOrderID,
+		Symbol:     order.Symbol,
+		Side:       order.Side,
+		PosSide:    order.PosSide,
+		MarginType: order.MarginType,
+		Price:      order.Price,
+		Size:       slice.Size,
+		Type:       orderType,
+	})
+
+	sq := database.Adapter().FoxAlgoSlice
+	if err != nil {
+		slice.Status = "failed"
+		slice.Msg = err.Error()
+		if err := sq.Save(slice); err != nil {
+			return fmt.Errorf("failed to update algo slice: %w", err)
+		}
+		log.Printf("算法子订单提交失败: ID=%d, Seq=%d, Error=%s", order.ID, slice.Seq, slice.Msg)
+		return err
+	}
+
+	if orderType == "market" {
+		slice.Status = "filled"
+		slice.Filled = slice.Size
+	} else {
+		slice.Status = "submitted"
+	}
+	if err := sq.Save(slice); err != nil {
+		return fmt.Errorf("failed to update algo slice: %w", err)
+	}
+	log.Printf("算法子订单已提交: ID=%d, Seq=%d, Size=%s", order.ID, slice.Seq, slice.Size)
+	return nil
+}
+
+// finishAlgo 结束执行中的算法订单，取消未提交的子订单；有成交时置为 opened 并激活平仓订单，否则置为 failed
+func (e *Engine) finishAlgo(order *model.FoxOrder, filled decimal.Decimal, reason string) error {
+	sq := database.Adapter().FoxAlgoSlice
+	if _, err := sq.Where(sq.OrderID.Eq(order.ID), sq.Status.Eq("scheduled")).UpdateSimple(
+		sq.Status.Value("cancelled"),
+		sq.Msg.Value("算法订单已终止"),
+	); err != nil {
+		return fmt.Errorf("failed to cancel algo slices: %w", err)
+	}
+
+	status := "opened"
+	msg := fmt.Sprintf("算法订单执行完成：成交 %s/%s 张", filled.String(), order.AlgoTotal)
+	if reason != "" {
+		msg = fmt.Sprintf("算法订单已终止（成交 %s/%s 张）：%s", filled.String(), order.AlgoTotal, reason)
+		if !filled.IsPositive() {
+			status = "failed"
+		}
+	}
+
+	// 只更新仍在执行中的订单，避免覆盖同时发生的取消
+	q := database.Adapter().FoxOrder
+	info, err := q.Where(q.ID.Eq(order.ID), q.Status.Eq("executing")).UpdateSimple(
+		q.Status.Value(status),
+		q.Msg.Value(msg),
+		q.AlgoFilled.Value(filled.String()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update order: %w", err)
+	}
+	if info.RowsAffected == 0 {
+		return nil
+	}
+	order.Status, order.Msg, order.AlgoFilled = status, msg, filled.String()
+	log.Printf("算法订单结束: ID=%d, Status=%s, %s", order.ID, status, msg)
+
+	if status == "opened" {
+		if _, err := e.armChildOrders(order); err != nil {
+			log.Printf("激活订单 %d 的平仓订单时出错: %v", order.ID, err)
+		}
+	}
+	return nil
+}
+
+// withdrawAlgoSlices 撤销已取消算法订单仍挂在交易所的子订单，并记录最终成交数量
+func (e *Engine) withdrawAlgoSlices(exchangeInstance exchange.Exchange, order *model.FoxOrder) error {
+	sq := database.Adapter().FoxAlgoSlice
+	algoSlices, err := sq.Where(sq.OrderID.Eq(order.ID), sq.Status.Eq("submitted")).Find()
+	if err != nil {
+		return fmt.Errorf("failed to get algo slices: %w", err)
+	}
+
+	filled, _ := decimal.NewFromString(order.AlgoFilled)
+	for _, slice := range algoSlices {
+		exchangeOrder, err := e.getSliceOrder(exchangeInstance, order, slice)
+		if err != nil {
+			return err
+		}
+
+		msg := "交易所订单已撤销"
+		if sliceLive(exchangeOrder) {
+			if err := exchangeInstance.CancelOrder(e.ctx, exchangeOrder); err != nil {
+				return fmt.Errorf("failed to cancel algo slice: %w", err)
+			}
+			// 撤单前可能还有成交，撤单后重新查询最终成交数量
+			if exchangeOrder, err = e.getSliceOrder(exchangeInstance, order, slice); err != nil {
+				return err
+			}
+			msg = "算法订单已取消，已撤销交易所挂单"
+		}
+		if err := settleSlice(slice, exchangeOrder, msg); err != nil {
+			return err
+		}
+		filled = filled.Add(decimal.RequireFromString(slice.Filled))
+	}
+
+	order.AlgoFilled = filled.String()
+	order.Msg = fmt.Sprintf("算法订单已取消（已成交 %s/%s 张）", order.AlgoFilled, order.AlgoTotal)
+	q := database.Adapter().FoxOrder
+	if _, err := q.Where(q.ID.Eq(order.ID)).UpdateSimple(
+		q.AlgoFilled.Value(order.AlgoFilled),
+		q.Msg.Value(order.Msg),
+	); err != nil {
+		return fmt.Errorf("failed to update order: %w", err)
+	}
+
+	log.Printf("已撤销算法订单的子订单: ID=%d, Count=%d", order.ID, len(algoSlices))
+	return nil
+}
+
+// getSliceOrder 按客户自定义订单ID查询子订单在交易所的状态
+func (e *Engine) getSliceOrder(exchangeInstance exchange.Exchange, order *model.FoxOrder, slice *model.FoxAlgoSlice) (*exchange.Order, error) {
+	exchangeOrder, err := exchangeInstance.GetOrder(e.ctx, order.Symbol, slice.ClientOrderID)
+	if errors.Is(err, exchange.ErrOrderNotFound) {
+		// 交易所仅短期保留未成交即撤销的订单，查询不到视为撤销且无成交
+		return &exchange.Order{OrderID: slice.ClientOrderID, Symbol: order.Symbol, Status: exchange.OrderStatusCanceled}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get algo slice: %w", err)
+	}
+	return exchangeOrder, nil
+}
+
+// sliceLive 判断子订单是否仍在交易所挂单中
+func sliceLive(exchangeOrder *exchange.Order) bool {
+	return exchangeOrder.Status == exchange.OrderStatusLive || exchangeOrder.Status == exchange.OrderStatusPartiallyFilled
+}
+
+// settleSlice 按交易所订单的最终状态记录子订单成交数量：完全成交置为 filled，否则置为 cancelled
+func settleSlice(slice *model.FoxAlgoSlice, exchangeOrder *exchange.Order, msg string) error {
+	slice.Filled = decimal.NewFromFloat(exchangeOrder.Filled).String()
+	if exchangeOrder.Status == exchange.OrderStatusFilled {
+		slice.Status = "filled"
+		if exchangeOrder.Filled <= 0 {
+			slice.Filled = slice.Size
+		}
+	} else {
+		slice.Status = "cancelled"
+		slice.Msg = fmt.Sprintf("%s（成交 %s/%s 张）", msg, slice.Filled, slice.Size)
+	}
+
+	if err := database.Adapter().FoxAlgoSlice.Save(slice); err != nil {
+		return fmt.Errorf("failed to update algo slice: %w", err)
+	}
+	return nil
+}
+
+// algoExecuting 提交子订单前确认订单仍在执行中（可能已被取消）
+func (e *Engine) algoExecuting(order *model.FoxOrder) (bool, error) {
+	current, err := database.Adapter().FoxOrder.Where(database.Adapter().FoxOrder.ID.Eq(order.ID)).First()
+	if err != nil {
+		return false, fmt.Errorf("failed to get order: %w", err)
+	}
+	return current.Status == "executing", nil
+}
@@ -0,0 +1,335 @@
+package engine
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/shopspring/decimal"
+)
+
+// mockAlgoExchange 模拟交易所，记录子订单提交与撤销，orders 为限价子订单在交易所的状态
+type mockAlgoExchange struct {
+	exchange.Exchange
+	created   []exchange.Order
+	orders    map[string]*exchange.Order
+	cancelled []exchange.Order
+}
+
+func (m *mockAlgoExchange) GetSymbols(ctx context.Context, symbol string) (*exchange.Symbol, error) {
+	return &exchange.Symbol{Name: symbol, MinSize: "1"}, nil
+}
+
+func (m *mockAlgoExchange) CreateOrder(ctx context.Context, order *exchange.Order) (*exchange.Order, error) {
+	m.created = append(m.created, *order)
+	if order.Type != "market" {
+		if m.orders == nil {
+			m.orders = make(map[string]*exchange.Order)
+		}
+		live := *order
+		live.Status = exchange.OrderStatusLive
+		m.orders[order.OrderID] = &live
+	}
+	return order, nil
+}
+
+func (m *mockAlgoExchange) GetOrder(ctx context.Context, symbol string, clientOrderID string) (*exchange.Order, error) {
+	order, ok := m.orders[clientOrderID]
+	if !ok {
+		return nil, exchange.ErrOrderNotFound
+	}
+	result := *order
+	return &result, nil
+}
+
+func (m *mockAlgoExchange) CancelOrder(ctx context.Context, order *exchange.Order) error {
+	m.cancelled = append(m.cancelled, *order)
+	m.orders[order.OrderID].Status = exchange.OrderStatusCanceled
+	return nil
+}
+
+// fill 模拟子订单完全成交
+func (m *mockAlgoExchange) fill(clientOrderID string) {
+	order := m.orders[clientOrderID]
+	order.Status = exchange.OrderStatusFilled
+	order.Filled, _ = strconv.ParseFloat(order.Size, 64)
+}
+
+func getTestAlgoSlices(t *testing.T, orderID int64) []*model.FoxAlgoSlice {
+	t.Helper()
+
+	sq := database.Adapter().FoxAlgoSlice
+	algoSlices, err := sq.Where(sq.OrderID.Eq(orderID)).Order(sq.Seq).Find()
+	if err != nil {
+		t.Fatalf("Failed to get algo slices: %v", err)
+	}
+	return algoSlices
+}
+
+func TestPlanAlgoSlices(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name      string
+		order     *model.FoxOrder
+		total     string
+		minSize   string
+		wantSizes []string
+		wantAt    []int64
+		wantErr   bool
+	}{
+		{
+			name:      "TWAP 余数计入最后一笔",
+			order:     &model.FoxOrder{OrderID: "FOX1", Algo: "twap", AlgoDuration: 30, AlgoSlices: 3},
+			total:     "10",
+			minSize:   "1",
+			wantSizes: []string{"3", "3", "4"},
+			wantAt:    []int64{0, 10, 20},
+		},
+		{
+			name:      "TWAP 按最小下单数量取整",
+			order:     &model.FoxOrder{OrderID: "FOX1", Algo: "twap", AlgoDuration: 60, AlgoSlices: 2},
+			total:     "1.05",
+			minSize:   "0.1",
+			wantSizes: []string{"0.5", "0.5"},
+			wantAt:    []int64{0, 30},
+		},
+		{
+			name:    "TWAP 数量不足以拆分",
+			order:   &model.FoxOrder{OrderID: "FOX1", Algo: "twap", AlgoDuration: 60, AlgoSlices: 5},
+			total:   "3",
+			minSize: "1",
+			wantErr: true,
+		},
+		{
+			name:      "冰山单",
+			order:     &model.FoxOrder{OrderID: "FOX1", Algo: "iceberg", AlgoVisible: "25"},
+			total:     "10",
+			minSize:   "1",
+			wantSizes: []string{"2", "2", "2", "2", "2"},
+			wantAt:    []int64{0, 0, 0, 0, 0},
+		},
+		{
+			name:      "冰山单可见数量不足最小下单数量",
+			order:     &model.FoxOrder{OrderID: "FOX1", Algo: "iceberg", AlgoVisible: "5"},
+			total:     "3",
+			minSize:   "1",
+			wantSizes: []string{"1", "1", "1"},
+			wantAt:    []int64{0, 0, 0},
+		},
+		{
+			name:    "冰山单拆分笔数过多",
+			order:   &model.FoxOrder{OrderID: "FOX1", Algo: "iceberg", AlgoVisible: "0.01"},
+			total:   "5000",
+			minSize: "1",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := planAlgoSlices(tt.order, decimal.RequireFromString(tt.total), tt.minSize, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("planAlgoSlices() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(got) != len(tt.wantSizes) {
+				t.Fatalf("planAlgoSlices() returned %d slices, want %d", len(got), len(tt.wantSizes))
+			}
+			for i, slice := range got {
+				if !decimal.RequireFromString(slice.Size).Equal(decimal.RequireFromString(tt.wantSizes[i])) {
+					t.Errorf("slice %d size = %s, want %s", i+1, slice.Size, tt.wantSizes[i])
+				}
+				wantAt := tt.wantAt[i]
+				if tt.order.Algo == "twap" {
+					wantAt += now.Unix()
+				}
+				if slice.ScheduledAt != wantAt {
+					t.Errorf("slice %d scheduled_at = %d, want %d", i+1, slice.ScheduledAt, wantAt)
+				}
+				if slice.Seq != int64(i+1) || slice.ClientOrderID == tt.order.OrderID {
+					t.Errorf("slice %d = %+v, want unique client order id", i+1, slice)
+				}
+			}
+		})
+	}
+}
+
+func TestEngine_ExecuteTWAP(t *testing.T) {
+	initTestDB(t)
+
+	now := time.Unix(1700000000, 0)
+	e := &Engine{ctx: context.Background(), clock: func() time.Time { return now }}
+	mock := &mockAlgoExchange{}
+
+	parent := createTestOrder(t, &model.FoxOrder{OrderID: "FOX1", Status: "waiting", Algo: "twap", AlgoDuration: 60, AlgoSlices: 3})
+	child := createTestOrder(t, &model.FoxOrder{OrderID: "FOX2", Status: "pending", ParentID: parent.ID})
+
+	if err := e.startAlgo(mock, parent, "9"); err != nil {
+		t.Fatalf("startAlgo() error = %v", err)
+	}
+	if len(mock.created) != 1 || mock.created[0].Type != "market" || mock.created[0].Size != "3" {
+		t.Fatalf("首笔子订单 = %+v, want one market order of 3", mock.created)
+	}
+	got := getTestOrder(t, parent.ID)
+	if got.Status != "executing" || got.AlgoTotal != "9" || got.AlgoFilled != "3" {
+		t.Fatalf("order = %s %s/%s, want executing 3/9", got.Status, got.AlgoFilled, got.AlgoTotal)
+	}
+
+	// 未到计划时间不提交
+	if err := e.executeAlgo(mock, got, now.Add(10*time.Second)); err != nil {
+		t.Fatalf("executeAlgo() error = %v", err)
+	}
+	if len(mock.created) != 1 {
+		t.Fatalf("提交了 %d 笔子订单, want 1", len(mock.created))
+	}
+
+	if err := e.executeAlgo(mock, got, now.Add(time.Minute)); err != nil {
+		t.Fatalf("executeAlgo() error = %v", err)
+	}
+	if len(mock.created) != 3 {
+		t.Fatalf("提交了 %d 笔子订单, want 3", len(mock.created))
+	}
+	got = getTestOrder(t, parent.ID)
+	if got.Status != "opened" || got.AlgoFilled != "9" {
+		t.Errorf("order = %s %s/%s, want opened 9/9", got.Status, got.AlgoFilled, got.AlgoTotal)
+	}
+	if status := getTestOrder(t, child.ID).Status; status != "waiting" {
+		t.Errorf("平仓订单状态 = %s, want waiting", status)
+	}
+	for _, slice := range getTestAlgoSlices(t, parent.ID) {
+		if slice.Status != "filled" {
+			t.Errorf("slice %d status = %s, want filled", slice.Seq, slice.Status)
+		}
+	}
+}
+
+func TestEngine_ExecuteIceberg(t *testing.T) {
+	initTestDB(t)
+
+	now := time.Unix(1700000000, 0)
+	e := &Engine{ctx: context.Background(), clock: func() time.Time { return now }}
+	mock := &mockAlgoExchange{}
+
+	parent := createTestOrder(t, &model.FoxOrder{OrderID: "FOX1", Status: "waiting", OrderType: "limit", Price: "65000", Algo: "iceberg", AlgoVisible: "40"})
+	if err := e.startAlgo(mock, parent, "10"); err != nil {
+		t.Fatalf("startAlgo() error = %v", err)
+	}
+	if len(mock.created) != 1 || mock.created[0].Type != "limit" || mock.created[0].Price != "65000" || mock.created[0].Size != "4" {
+		t.Fatalf("首笔子订单 = %+v, want one limit order of 4", mock.created)
+	}
+
+	// 挂单未成交时不提交下一笔
+	if err := e.executeAlgo(mock, parent, now); err != nil {
+		t.Fatalf("executeAlgo() error = %v", err)
+	}
+	if len(mock.created) != 1 {
+		t.Fatalf("提交了 %d 笔子订单, want 1", len(mock.created))
+	}
+
+	// 挂单成交后补充下一笔
+	mock.fill(mock.created[0].OrderID)
+	if err := e.executeAlgo(mock, parent, now); err != nil {
+		t.Fatalf("executeAlgo() error = %v", err)
+	}
+	if len(mock.created) != 2 {
+		t.Fatalf("提交了 %d 笔子订单, want 2", len(mock.created))
+	}
+	got := getTestOrder(t, parent.ID)
+	if got.Status != "executing" || got.AlgoFilled != "4" {
+		t.Fatalf("order = %s %s/%s, want executing 4/10", got.Status, got.AlgoFilled, got.AlgoTotal)
+	}
+
+	// 取消后撤销交易所挂单，剩余子订单不再提交
+	mock.orders[mock.created[1].OrderID].Filled = 1
+	if _, err := database.Adapter().FoxOrder.Where(database.Adapter().FoxOrder.ID.Eq(parent.ID)).Update(database.Adapter().FoxOrder.Status, "cancelled"); err != nil {
+		t.Fatalf("Failed to cancel order: %v", err)
+	}
+	sq := database.Adapter().FoxAlgoSlice
+	if _, err := sq.Where(sq.OrderID.Eq(parent.ID), sq.Status.Eq("scheduled")).Update(sq.Status, "cancelled"); err != nil {
+		t.Fatalf("Failed to cancel slices: %v", err)
+	}
+
+	orders, err := e.activeAlgoOrders()
+	if err != nil || len(orders) != 1 {
+		t.Fatalf("activeAlgoOrders() = %d, %v, want 1 cancelled order with submitted slice", len(orders), err)
+	}
+	if err := e.withdrawAlgoSlices(mock, orders[0]); err != nil {
+		t.Fatalf("withdrawAlgoSlices() error = %v", err)
+	}
+	if len(mock.cancelled) != 1 || mock.cancelled[0].OrderID != mock.created[1].OrderID {
+		t.Errorf("撤销的挂单 = %+v, want second slice", mock.cancelled)
+	}
+	got = getTestOrder(t, parent.ID)
+	if got.Status != "cancelled" || got.AlgoFilled != "5" {
+		t.Errorf("order = %s %s/%s, want cancelled 5/10", got.Status, got.AlgoFilled, got.AlgoTotal)
+	}
+	if orders, _ := e.activeAlgoOrders(); len(orders) != 0 {
+		t.Errorf("activeAlgoOrders() = %d, want 0", len(orders))
+	}
+}
+
+func TestEngine_IcebergSliceCancelledOnExchange(t *testing.T) {
+	tests := []struct {
+		name       string
+		filled     float64
+		notFound   bool
+		wantStatus string
+		wantFilled string
+		wantChild  string
+	}{
+		{name: "撤销且未成交", wantStatus: "failed", wantFilled: "0", wantChild: "pending"},
+		{name: "交易所查询不到", notFound: true, wantStatus: "failed", wantFilled: "0", wantChild: "pending"},
+		{name: "部分成交后撤销", filled: 1, wantStatus: "opened", wantFilled: "1", wantChild: "waiting"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initTestDB(t)
+
+			now := time.Unix(1700000000, 0)
+			e := &Engine{ctx: context.Background(), clock: func() time.Time { return now }}
+			mock := &mockAlgoExchange{}
+
+			parent := createTestOrder(t, &model.FoxOrder{OrderID: "FOX1", Status: "waiting", OrderType: "post_only", Price: "65000", Algo: "iceberg", AlgoVisible: "40"})
+			child := createTestCloseOrder(t, parent.ID, "pending")
+			if err := e.startAlgo(mock, parent, "10"); err != nil {
+				t.Fatalf("startAlgo() error = %v", err)
+			}
+
+			// 子订单在交易所被撤销（手动撤单或 post_only 被拒绝）
+			first := mock.created[0].OrderID
+			mock.orders[first].Status = exchange.OrderStatusCanceled
+			mock.orders[first].Filled = tt.filled
+			if tt.notFound {
+				delete(mock.orders, first)
+			}
+			if err := e.executeAlgo(mock, parent, now); err != nil {
+				t.Fatalf("executeAlgo() error = %v", err)
+			}
+
+			if len(mock.created) != 1 {
+				t.Errorf("提交了 %d 笔子订单, want 1", len(mock.created))
+			}
+			got := getTestOrder(t, parent.ID)
+			if got.Status != tt.wantStatus || got.AlgoFilled != tt.wantFilled {
+				t.Errorf("order = %s %s/%s, want %s %s", got.Status, got.AlgoFilled, got.AlgoTotal, tt.wantStatus, tt.wantFilled)
+			}
+			for _, slice := range getTestAlgoSlices(t, parent.ID) {
+				if slice.Status != "cancelled" {
+					t.Errorf("slice %d status = %s, want cancelled", slice.Seq, slice.Status)
+				}
+			}
+			if status := getTestOrder(t, child.ID).Status; status != tt.wantChild {
+				t.Errorf("平仓订单状态 = %s, want %s", status, tt.wantChild)
+			}
+		})
+	}
+}
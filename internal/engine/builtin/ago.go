@@ -3,7 +3,6 @@ package builtin
 import (
 	"context"
 	"fmt"
-	"time"
 )
 
 // AgoBuiltin ago函数实现
@@ -45,7 +44,7 @@ func (f *AgoBuiltin) Execute(ctx context.Context, args []interface{}, evaluator
 	}
 
 	// 计算从指定时间到现在的秒数
-	now := time.Now()
+	now := Now(ctx)
 	duration := now.Sub(timestamp)
 	return duration.Seconds(), nil
 }
@@ -0,0 +1,51 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// AllBuiltin all函数实现
+type AllBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewAllBuiltin 创建all函数
+func NewAllBuiltin() *AllBuiltin {
+	signature := Signature{
+		Name:        "all",
+		Description: "列表中所有条件均成立时返回 true",
+		ReturnType:  "bool",
+		Args: []ArgInfo{
+			{
+				Name:        "conditions",
+				Type:        "array",
+				Required:    true,
+				Description: "条件列表，如：[cond1, cond2]",
+			},
+		},
+	}
+
+	return &AllBuiltin{
+		BaseBuiltin: NewBaseBuiltin("all", "列表中所有条件均成立时返回 true", signature),
+	}
+}
+
+// Execute 执行all函数
+func (f *AllBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	items, err := toList(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("argument to all %w", err)
+	}
+
+	matched, err := countTrue(items)
+	if err != nil {
+		return nil, fmt.Errorf("argument to all %w", err)
+	}
+
+	return matched == len(items), nil
+}
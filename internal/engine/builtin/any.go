@@ -0,0 +1,46 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// AnyBuiltin any函数实现
+type AnyBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewAnyBuiltin 创建any函数
+func NewAnyBuiltin() *AnyBuiltin {
+	signature := Signature{
+		Name:        "any",
+		Description: "列表中任意一个条件成立时返回 true",
+		ReturnType:  "bool",
+		Args: []ArgInfo{
+			{
+				Name:        "conditions",
+				Type:        "array",
+				Required:    true,
+				Description: "条件列表，如：[cond1, cond2]",
+			},
+		},
+	}
+
+	return &AnyBuiltin{
+		BaseBuiltin: NewBaseBuiltin("any", "列表中任意一个条件成立时返回 true", signature),
+	}
+}
+
+// Execute 执行any函数
+func (f *AnyBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	matched, err := countTrue(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("argument to any %w", err)
+	}
+
+	return matched > 0, nil
+}
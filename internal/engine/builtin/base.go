@@ -38,8 +38,18 @@ func NewBaseBuiltin(name, description string, signature Signature) *BaseBuiltin
 // ValidateArgs 验证参数数量和类型
 func (f *BaseBuiltin) ValidateArgs(args []interface{}) error {
 	expectedCount := len(f.signature.Args)
-	if len(args) != expectedCount {
-		return fmt.Errorf("function %s expects %d arguments, got %d", f.name, expectedCount, len(args))
+
+	// 末尾的可选参数允许省略
+	requiredCount := expectedCount
+	for requiredCount > 0 && !f.signature.Args[requiredCount-1].Required {
+		requiredCount--
+	}
+
+	if len(args) < requiredCount || len(args) > expectedCount {
+		if requiredCount == expectedCount {
+			return fmt.Errorf("function %s expects %d arguments, got %d", f.name, expectedCount, len(args))
+		}
+		return fmt.Errorf("function %s expects %d to %d arguments, got %d", f.name, requiredCount, expectedCount, len(args))
 	}
 
 	// 这里可以添加更详细的类型验证
@@ -0,0 +1,80 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// BetweenBuiltin between函数实现
+type BetweenBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewBetweenBuiltin 创建between函数
+func NewBetweenBuiltin() *BetweenBuiltin {
+	signature := Signature{
+		Name:        "between",
+		Description: "当前时刻位于每日时段 [start, end) 内时返回 true，end 早于 start 时表示跨越午夜",
+		ReturnType:  "bool",
+		Args: []ArgInfo{
+			{
+				Name:        "start",
+				Type:        "string",
+				Required:    true,
+				Description: "开始时刻，如：08:00",
+			},
+			{
+				Name:        "end",
+				Type:        "string",
+				Required:    true,
+				Description: "结束时刻，如：16:00",
+			},
+			{
+				Name:        "tz",
+				Type:        "string",
+				Required:    false,
+				Description: "时区，如：UTC, Asia/Shanghai，默认本地时区",
+			},
+		},
+	}
+
+	return &BetweenBuiltin{
+		BaseBuiltin: NewBaseBuiltin("between", "当前时刻位于每日时段 [start, end) 内时返回 true", signature),
+	}
+}
+
+// Execute 执行between函数
+func (f *BetweenBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	start, err := parseClock(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid between start: %w", err)
+	}
+
+	end, err := parseClock(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid between end: %w", err)
+	}
+
+	var tz interface{}
+	if len(args) > 2 {
+		tz = args[2]
+	}
+	loc, err := toLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid between tz: %w", err)
+	}
+
+	now := Now(ctx).In(loc)
+	offset := time.Duration(now.Hour())*time.Hour + time.Duration(now.Minute())*time.Minute + time.Duration(now.Second())*time.Second
+
+	if start <= end {
+		return offset >= start && offset < end, nil
+	}
+	// 跨越午夜的时段，如 22:00 - 02:00
+	return offset >= start || offset < end, nil
+}
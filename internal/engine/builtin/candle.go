@@ -0,0 +1,153 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+)
+
+// candle 数值化的K线
+type candle struct {
+	Open  float64
+	High  float64
+	Low   float64
+	Close float64
+}
+
+// body 实体长度
+func (c candle) body() float64 {
+	return math.Abs(c.Close - c.Open)
+}
+
+// span 最高价与最低价之间的振幅
+func (c candle) span() float64 {
+	return c.High - c.Low
+}
+
+// upperShadow 上影线长度
+func (c candle) upperShadow() float64 {
+	return c.High - math.Max(c.Open, c.Close)
+}
+
+// lowerShadow 下影线长度
+func (c candle) lowerShadow() float64 {
+	return math.Min(c.Open, c.Close) - c.Low
+}
+
+// bullish 是否为阳线
+func (c candle) bullish() bool {
+	return c.Close > c.Open
+}
+
+// bearish 是否为阴线
+func (c candle) bearish() bool {
+	return c.Close < c.Open
+}
+
+// patternArgs K线形态函数的公共参数
+func patternArgs() []ArgInfo {
+	return []ArgInfo{
+		{
+			Name:        "candles",
+			Type:        "candles",
+			Required:    true,
+			Description: "K线数据，格式：kline.EXCHANGE.SYMBOL.candles",
+		},
+		{
+			Name:        "interval",
+			Type:        "string",
+			Required:    true,
+			Description: "时间间隔，如：15m, 1h, 1d",
+		},
+		{
+			Name:        "limit",
+			Type:        "number",
+			Required:    true,
+			Description: "数据点数量，需覆盖形态所需的K线",
+		},
+		{
+			Name:        "n",
+			Type:        "number",
+			Required:    false,
+			Description: "检查倒数第 n 根已收盘K线，默认 0 表示最近一根已收盘K线",
+		},
+	}
+}
+
+// toCandles 将 KlineProvider 返回的K线数据转换为数值化的K线
+func toCandles(v interface{}) ([]candle, []exchange.KlineData, error) {
+	var raw []exchange.KlineData
+	switch val := v.(type) {
+	case []exchange.KlineData:
+		raw = val
+	case []interface{}:
+		raw = make([]exchange.KlineData, len(val))
+		for i, item := range val {
+			kline, ok := item.(exchange.KlineData)
+			if !ok {
+				return nil, nil, fmt.Errorf("must be candles, got %T at index %d", item, i)
+			}
+			raw[i] = kline
+		}
+	default:
+		return nil, nil, fmt.Errorf("must be candles, got %T", v)
+	}
+
+	candles := make([]candle, len(raw))
+	for i, kline := range raw {
+		var err error
+		if candles[i].Open, err = toFloat64(kline.Open); err != nil {
+			return nil, nil, fmt.Errorf("invalid open price at index %d: %w", i, err)
+		}
+		if candles[i].High, err = toFloat64(kline.High); err != nil {
+			return nil, nil, fmt.Errorf("invalid high price at index %d: %w", i, err)
+		}
+		if candles[i].Low, err = toFloat64(kline.Low); err != nil {
+			return nil, nil, fmt.Errorf("invalid low price at index %d: %w", i, err)
+		}
+		if candles[i].Close, err = toFloat64(kline.Close); err != nil {
+			return nil, nil, fmt.Errorf("invalid close price at index %d: %w", i, err)
+		}
+	}
+
+	return candles, raw, nil
+}
+
+// patternWindow 获取以目标K线结尾、长度为 size 的K线窗口
+// 交易所返回的最后一根K线通常尚未收盘：若其开盘时间加上周期晚于当前时间（或周期无法解析），则跳过该K线
+func patternWindow(ctx context.Context, name string, args []interface{}, size int) ([]candle, error) {
+	candles, raw, err := toCandles(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to %s %w", name, err)
+	}
+
+	end := len(candles)
+	if end > 0 {
+		closed := false
+		if interval, err := toDuration(args[1]); err == nil {
+			closed = !raw[end-1].Timestamp.Add(interval).After(Now(ctx))
+		}
+		if !closed {
+			end--
+		}
+	}
+
+	if len(args) > 3 && args[3] != nil {
+		n, err := toFloat64(args[3])
+		if err != nil {
+			return nil, fmt.Errorf("%s n must be a number: %w", name, err)
+		}
+		if n < 0 {
+			return nil, fmt.Errorf("%s n must not be negative, got %v", name, n)
+		}
+		end -= int(n)
+	}
+
+	if end < size {
+		return nil, fmt.Errorf("%s requires %d closed candles, got %d", name, size, end)
+	}
+
+	return candles[end-size : end], nil
+}
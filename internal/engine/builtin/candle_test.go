@@ -0,0 +1,124 @@
+package builtin
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+)
+
+// loadCandleFixtures 读取K线形态测试数据
+func loadCandleFixtures(t *testing.T) map[string][]exchange.KlineData {
+	t.Helper()
+
+	data, err := os.ReadFile("testdata/candles.json")
+	if err != nil {
+		t.Fatalf("读取K线测试数据失败: %v", err)
+	}
+
+	var fixtures map[string][]exchange.KlineData
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		t.Fatalf("解析K线测试数据失败: %v", err)
+	}
+
+	return fixtures
+}
+
+// toCandleArgs 模拟 KlineProvider 的 candles 字段返回值
+func toCandleArgs(klines []exchange.KlineData) []interface{} {
+	result := make([]interface{}, len(klines))
+	for i, kline := range klines {
+		result[i] = kline
+	}
+	return result
+}
+
+func TestCandlePatterns(t *testing.T) {
+	fixtures := loadCandleFixtures(t)
+	// 所有测试数据的最后一根K线在 02:00 前后开盘，03:00 时均已收盘
+	ctx := WithNow(context.Background(), time.Date(2025, 1, 6, 3, 0, 0, 0, time.UTC))
+
+	patterns := map[string]Builtin{
+		"engulfing":            NewEngulfingBuiltin(),
+		"hammer":               NewHammerBuiltin(),
+		"doji":                 NewDojiBuiltin(),
+		"three_white_soldiers": NewThreeWhiteSoldiersBuiltin(),
+		"inside_bar":           NewInsideBarBuiltin(),
+	}
+
+	testCases := []struct {
+		fixture  string
+		pattern  string
+		extra    []interface{}
+		expected bool
+	}{
+		{"bullish_engulfing", "engulfing", nil, true},
+		{"bullish_engulfing", "engulfing", []interface{}{0.0, "bull"}, true},
+		{"bullish_engulfing", "engulfing", []interface{}{0.0, "bear"}, false},
+		{"bearish_engulfing", "engulfing", []interface{}{0.0, "bear"}, true},
+		{"bullish_engulfing", "hammer", nil, false},
+		{"bullish_engulfing", "inside_bar", nil, false},
+		{"hammer", "hammer", nil, true},
+		{"hammer", "doji", nil, false},
+		{"hammer", "engulfing", nil, false},
+		{"doji", "doji", nil, true},
+		{"doji", "hammer", nil, false},
+		{"three_white_soldiers", "three_white_soldiers", nil, true},
+		{"three_white_soldiers", "engulfing", nil, false},
+		{"inside_bar", "inside_bar", nil, true},
+		{"inside_bar", "engulfing", nil, false},
+	}
+
+	for _, tc := range testCases {
+		args := append([]interface{}{toCandleArgs(fixtures[tc.fixture]), "1h", 10.0}, tc.extra...)
+		result, err := patterns[tc.pattern].Execute(ctx, args, nil)
+		if err != nil {
+			t.Errorf("%s(%s) 执行失败: %v", tc.pattern, tc.fixture, err)
+			continue
+		}
+		if result != tc.expected {
+			t.Errorf("%s(%s) 期望 %v，实际 %v", tc.pattern, tc.fixture, tc.expected, result)
+		}
+	}
+}
+
+func TestCandlePatternTarget(t *testing.T) {
+	fixtures := loadCandleFixtures(t)
+	hammer := NewHammerBuiltin()
+	insideBar := NewInsideBarBuiltin()
+
+	// 未收盘的K线不参与形态判断
+	live := append(fixtures["inside_bar"], exchange.KlineData{
+		Open: "103", High: "130", Low: "90", Close: "125", Volume: 1,
+		Timestamp: time.Date(2025, 1, 6, 2, 0, 0, 0, time.UTC),
+	})
+	ctx := WithNow(context.Background(), time.Date(2025, 1, 6, 2, 30, 0, 0, time.UTC))
+	result, err := insideBar.Execute(ctx, []interface{}{toCandleArgs(live), "1h", 10.0}, nil)
+	if err != nil || result != true {
+		t.Errorf("期望跳过未收盘K线后识别出 inside_bar，实际 %v, %v", result, err)
+	}
+
+	// 通过 n 检查更早的K线
+	closed := append(fixtures["hammer"], exchange.KlineData{
+		Open: "101", High: "108", Low: "100", Close: "107", Volume: 1,
+		Timestamp: time.Date(2025, 1, 6, 2, 0, 0, 0, time.UTC),
+	})
+	ctx = WithNow(context.Background(), time.Date(2025, 1, 6, 3, 0, 0, 0, time.UTC))
+	for n, expected := range []bool{false, true} {
+		result, err := hammer.Execute(ctx, []interface{}{toCandleArgs(closed), "1h", 10.0, float64(n)}, nil)
+		if err != nil {
+			t.Fatalf("hammer n=%d 执行失败: %v", n, err)
+		}
+		if result != expected {
+			t.Errorf("hammer n=%d 期望 %v，实际 %v", n, expected, result)
+		}
+	}
+
+	// K线数量不足时报错
+	if _, err := NewThreeWhiteSoldiersBuiltin().Execute(ctx, []interface{}{toCandleArgs(fixtures["three_white_soldiers"]), "1h", 10.0, 1.0}, nil); err == nil {
+		t.Error("期望K线数量不足时报错")
+	}
+}
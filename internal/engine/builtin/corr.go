@@ -0,0 +1,94 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"math"
+)
+
+// CorrBuiltin corr函数实现
+type CorrBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewCorrBuiltin 创建corr函数
+func NewCorrBuiltin() *CorrBuiltin {
+	signature := Signature{
+		Name:        "corr",
+		Description: "计算两个数据序列的皮尔逊相关系数",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "path_a",
+				Type:        "string",
+				Required:    true,
+				Description: "第一个数据路径，格式：kline.SYMBOL.field",
+			},
+			{
+				Name:        "path_b",
+				Type:        "string",
+				Required:    true,
+				Description: "第二个数据路径，格式：kline.SYMBOL.field",
+			},
+			{
+				Name:        "interval",
+				Type:        "string",
+				Required:    true,
+				Description: "时间间隔，如：15m, 1h, 1d",
+			},
+			{
+				Name:        "limit",
+				Type:        "number",
+				Required:    true,
+				Description: "数据点数量",
+			},
+		},
+	}
+
+	return &CorrBuiltin{
+		BaseBuiltin: NewBaseBuiltin("corr", "计算两个数据序列的皮尔逊相关系数", signature),
+	}
+}
+
+// Execute 执行corr函数
+func (f *CorrBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	a, err := toSeries(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to corr must be a data array: %w", err)
+	}
+
+	b, err := toSeries(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("second argument to corr must be a data array: %w", err)
+	}
+
+	// 长度不一致时按最新的数据对齐
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	if n < 2 {
+		return nil, fmt.Errorf("corr requires at least 2 data points, got %d", n)
+	}
+	a = a[len(a)-n:]
+	b = b[len(b)-n:]
+
+	meanA, meanB := mean(a), mean(b)
+	var cov, varA, varB float64
+	for i := 0; i < n; i++ {
+		da, db := a[i]-meanA, b[i]-meanB
+		cov += da * db
+		varA += da * da
+		varB += db * db
+	}
+
+	if varA == 0 || varB == 0 {
+		return nil, fmt.Errorf("corr is undefined for a constant series")
+	}
+
+	return cov / math.Sqrt(varA*varB), nil
+}
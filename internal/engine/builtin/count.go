@@ -0,0 +1,70 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// CountBuiltin count函数实现
+type CountBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewCountBuiltin 创建count函数
+func NewCountBuiltin() *CountBuiltin {
+	signature := Signature{
+		Name:        "count",
+		Description: "统计列表中成立的条件数量；传入关键词列表时统计文本中出现的关键词数量",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "items",
+				Type:        "any",
+				Required:    true,
+				Description: "条件列表，或需要匹配关键词的文本",
+			},
+			{
+				Name:        "keywords",
+				Type:        "array",
+				Required:    false,
+				Description: "关键词列表，如：[\"ETF\", \"SEC\"]",
+			},
+		},
+	}
+
+	return &CountBuiltin{
+		BaseBuiltin: NewBaseBuiltin("count", "统计列表中成立的条件数量或文本中出现的关键词数量", signature),
+	}
+}
+
+// Execute 执行count函数
+func (f *CountBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	// count(conditions)
+	if len(args) < 2 || args[1] == nil {
+		matched, err := countTrue(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("first argument to count %w", err)
+		}
+		return float64(matched), nil
+	}
+
+	// count(text, keywords)
+	keywords, err := toStringArray(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("second argument to count must be a keyword list: %w", err)
+	}
+
+	text := toString(args[0])
+	matched := 0
+	for _, keyword := range keywords {
+		if contains(text, keyword) {
+			matched++
+		}
+	}
+
+	return float64(matched), nil
+}
@@ -0,0 +1,146 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+)
+
+// DepthBuiltin depth函数实现
+type DepthBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewDepthBuiltin 创建depth函数
+func NewDepthBuiltin() *DepthBuiltin {
+	signature := Signature{
+		Name:        "depth",
+		Description: "计算距中间价指定百分比范围内的买盘或卖盘挂单总量",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "book",
+				Type:        "orderbook",
+				Required:    true,
+				Description: "订单簿，格式：orderbook.EXCHANGE.SYMBOL.book",
+			},
+			{
+				Name:        "side",
+				Type:        "string",
+				Required:    true,
+				Description: "盘口方向：bid 或 ask",
+			},
+			{
+				Name:        "pct",
+				Type:        "number",
+				Required:    true,
+				Description: "距中间价的百分比范围，如 0.5 表示 0.5%",
+			},
+		},
+	}
+
+	return &DepthBuiltin{
+		BaseBuiltin: NewBaseBuiltin("depth", "计算距中间价指定百分比范围内的买盘或卖盘挂单总量", signature),
+	}
+}
+
+// Execute 执行depth函数
+func (f *DepthBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	book, err := toOrderBook(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to depth %w", err)
+	}
+
+	side := strings.ToLower(toString(args[1]))
+	if side != "bid" && side != "ask" {
+		return nil, fmt.Errorf("depth side must be 'bid' or 'ask', got: %s", side)
+	}
+
+	pct, err := toFloat64(args[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid depth pct: %w", err)
+	}
+
+	return orderBookDepth(book, side, pct)
+}
+
+// toOrderBook 转换为订单簿
+func toOrderBook(v interface{}) (*exchange.OrderBook, error) {
+	switch val := v.(type) {
+	case *exchange.OrderBook:
+		if val == nil {
+			return nil, fmt.Errorf("must be an order book, got nil")
+		}
+		return val, nil
+	case exchange.OrderBook:
+		return &val, nil
+	default:
+		return nil, fmt.Errorf("must be an order book, got %T", v)
+	}
+}
+
+// bestPrices 获取买一价和卖一价
+func bestPrices(book *exchange.OrderBook) (float64, float64, error) {
+	if len(book.Bids) == 0 || len(book.Asks) == 0 {
+		return 0, 0, fmt.Errorf("order book for %s is empty", book.Symbol)
+	}
+
+	bestBid, err := toFloat64(book.Bids[0].Price)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid bid price: %w", err)
+	}
+
+	bestAsk, err := toFloat64(book.Asks[0].Price)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid ask price: %w", err)
+	}
+
+	return bestBid, bestAsk, nil
+}
+
+// orderBookDepth 计算距中间价 pct% 范围内指定方向的挂单总量
+func orderBookDepth(book *exchange.OrderBook, side string, pct float64) (float64, error) {
+	if pct < 0 {
+		return 0, fmt.Errorf("depth pct must not be negative, got: %v", pct)
+	}
+
+	bestBid, bestAsk, err := bestPrices(book)
+	if err != nil {
+		return 0, err
+	}
+	mid := (bestBid + bestAsk) / 2
+
+	levels := book.Asks
+	limit := mid * (1 + pct/100)
+	if side == "bid" {
+		levels = book.Bids
+		limit = mid * (1 - pct/100)
+	}
+
+	total := 0.0
+	for _, level := range levels {
+		price, err := toFloat64(level.Price)
+		if err != nil {
+			return 0, fmt.Errorf("invalid price in order book: %w", err)
+		}
+
+		// 档位已按价格排序，超出范围即可停止
+		if (side == "bid" && price < limit) || (side == "ask" && price > limit) {
+			break
+		}
+
+		size, err := toFloat64(level.Size)
+		if err != nil {
+			return 0, fmt.Errorf("invalid size in order book: %w", err)
+		}
+		total += size
+	}
+
+	return total, nil
+}
@@ -0,0 +1,42 @@
+package builtin
+
+import (
+	"context"
+)
+
+// dojiBodyRatio 十字星实体占振幅的最大比例
+const dojiBodyRatio = 0.1
+
+// DojiBuiltin doji函数实现
+type DojiBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewDojiBuiltin 创建doji函数
+func NewDojiBuiltin() *DojiBuiltin {
+	signature := Signature{
+		Name:        "doji",
+		Description: "目标K线为十字星（实体不超过振幅的 10%）时返回 true",
+		ReturnType:  "bool",
+		Args:        patternArgs(),
+	}
+
+	return &DojiBuiltin{
+		BaseBuiltin: NewBaseBuiltin("doji", "目标K线为十字星时返回 true", signature),
+	}
+}
+
+// Execute 执行doji函数
+func (f *DojiBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	window, err := patternWindow(ctx, "doji", args, 1)
+	if err != nil {
+		return nil, err
+	}
+	c := window[0]
+
+	return c.span() > 0 && c.body() <= dojiBodyRatio*c.span(), nil
+}
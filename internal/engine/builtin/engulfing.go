@@ -0,0 +1,63 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// EngulfingBuiltin engulfing函数实现
+type EngulfingBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewEngulfingBuiltin 创建engulfing函数
+func NewEngulfingBuiltin() *EngulfingBuiltin {
+	signature := Signature{
+		Name:        "engulfing",
+		Description: "目标K线的实体完全吞没前一根反向K线的实体时返回 true",
+		ReturnType:  "bool",
+		Args: append(patternArgs(), ArgInfo{
+			Name:        "side",
+			Type:        "string",
+			Required:    false,
+			Description: "形态方向：bull（看涨吞没）或 bear（看跌吞没），默认两者皆可",
+		}),
+	}
+
+	return &EngulfingBuiltin{
+		BaseBuiltin: NewBaseBuiltin("engulfing", "目标K线的实体完全吞没前一根反向K线的实体时返回 true", signature),
+	}
+}
+
+// Execute 执行engulfing函数
+func (f *EngulfingBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	side := ""
+	if len(args) > 4 && args[4] != nil {
+		side = toString(args[4])
+		if side != "bull" && side != "bear" {
+			return nil, fmt.Errorf("engulfing side must be bull or bear, got %s", side)
+		}
+	}
+
+	window, err := patternWindow(ctx, "engulfing", args, 2)
+	if err != nil {
+		return nil, err
+	}
+	prev, cur := window[0], window[1]
+
+	bull := prev.bearish() && cur.bullish() && cur.Open <= prev.Close && cur.Close >= prev.Open
+	bear := prev.bullish() && cur.bearish() && cur.Open >= prev.Close && cur.Close <= prev.Open
+
+	switch side {
+	case "bull":
+		return bull, nil
+	case "bear":
+		return bear, nil
+	default:
+		return bull || bear, nil
+	}
+}
@@ -0,0 +1,40 @@
+package builtin
+
+import (
+	"context"
+)
+
+// HammerBuiltin hammer函数实现
+type HammerBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewHammerBuiltin 创建hammer函数
+func NewHammerBuiltin() *HammerBuiltin {
+	signature := Signature{
+		Name:        "hammer",
+		Description: "目标K线为锤子线（下影线不短于实体两倍且上影线不长于实体）时返回 true",
+		ReturnType:  "bool",
+		Args:        patternArgs(),
+	}
+
+	return &HammerBuiltin{
+		BaseBuiltin: NewBaseBuiltin("hammer", "目标K线为锤子线时返回 true", signature),
+	}
+}
+
+// Execute 执行hammer函数
+func (f *HammerBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	window, err := patternWindow(ctx, "hammer", args, 1)
+	if err != nil {
+		return nil, err
+	}
+	c := window[0]
+
+	body := c.body()
+	return body > 0 && c.lowerShadow() >= 2*body && c.upperShadow() <= body, nil
+}
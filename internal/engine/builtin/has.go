@@ -2,6 +2,7 @@ package builtin
 
 import (
 	"context"
+	"strings"
 )
 
 // HasBuiltin has函数实现
@@ -20,7 +21,7 @@ func NewHasBuiltin() *HasBuiltin {
 				Name:        "text",
 				Type:        "string",
 				Required:    true,
-				Description: "要检查的文本",
+				Description: "要检查的文本或列表",
 			},
 			{
 				Name:        "keyword",
@@ -42,12 +43,22 @@ func (f *HasBuiltin) Execute(ctx context.Context, args []interface{}, evaluator
 		return nil, err
 	}
 
-	// 第一个参数应该是字符串
-	text := toString(args[0])
-
 	// 第二个参数应该是字符串
 	keyword := toString(args[1])
 
+	// 第一个参数为列表（如 news.blockbeats.symbols）时判断是否包含相同的元素（忽略大小写）
+	if items, err := toStringArray(args[0]); err == nil {
+		for _, item := range items {
+			if strings.EqualFold(item, keyword) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	// 第一个参数应该是字符串
+	text := toString(args[0])
+
 	// 检查文本是否包含关键词
 	return contains(text, keyword), nil
 }
@@ -0,0 +1,109 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// HasAnyBuiltin has_any函数实现
+type HasAnyBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewHasAnyBuiltin 创建has_any函数
+func NewHasAnyBuiltin() *HasAnyBuiltin {
+	signature := Signature{
+		Name:        "has_any",
+		Description: "检查文本是否包含任意一个关键词（忽略大小写）",
+		ReturnType:  "bool",
+		Args: []ArgInfo{
+			{
+				Name:        "text",
+				Type:        "string",
+				Required:    true,
+				Description: "要检查的文本",
+			},
+			{
+				Name:        "keywords",
+				Type:        "array",
+				Required:    true,
+				Description: "关键词列表，如：[\"ETF\", \"SEC\"]",
+			},
+			{
+				Name:        "word",
+				Type:        "bool",
+				Required:    false,
+				Description: "为 true 时按完整单词匹配，如 ETF 不匹配 ETFs",
+			},
+		},
+	}
+
+	return &HasAnyBuiltin{
+		BaseBuiltin: NewBaseBuiltin("has_any", "检查文本是否包含任意一个关键词（忽略大小写）", signature),
+	}
+}
+
+// Execute 执行has_any函数
+func (f *HasAnyBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	keywords, err := toStringArray(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("second argument to has_any must be a keyword list: %w", err)
+	}
+
+	word := false
+	if len(args) > 2 && args[2] != nil {
+		if word, err = toBool(args[2]); err != nil {
+			return nil, fmt.Errorf("has_any word must be a bool: %w", err)
+		}
+	}
+
+	text := toString(args[0])
+	lowerText := strings.ToLower(text)
+	for _, keyword := range keywords {
+		if keyword == "" {
+			continue
+		}
+
+		if !word {
+			if strings.Contains(lowerText, strings.ToLower(keyword)) {
+				return true, nil
+			}
+			continue
+		}
+
+		re, err := compileRegex(ctx, wordPattern(keyword))
+		if err != nil {
+			return nil, err
+		}
+		if re.MatchString(text) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// wordPattern 生成按完整单词匹配关键词的正则表达式
+// \b 只识别 ASCII 单词字符，中文等非 ASCII 字符开头或结尾的关键词在对应一侧不加单词边界
+func wordPattern(keyword string) string {
+	pattern := regexp.QuoteMeta(keyword)
+	if first, _ := utf8.DecodeRuneInString(keyword); isASCIIWord(first) {
+		pattern = `\b` + pattern
+	}
+	if last, _ := utf8.DecodeLastRuneInString(keyword); isASCIIWord(last) {
+		pattern += `\b`
+	}
+	return `(?i)` + pattern
+}
+
+// isASCIIWord 判断字符是否为 \b 识别的 ASCII 单词字符
+func isASCIIWord(r rune) bool {
+	return r == '_' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
@@ -0,0 +1,107 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// holdState hold 函数的求值状态
+type holdState struct {
+	Since    time.Time `json:"since"`     // 条件开始连续成立的时间，零值表示当前不成立
+	LastSeen time.Time `json:"last_seen"` // 最近一次求值时间
+}
+
+// HoldBuiltin hold函数实现
+type HoldBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewHoldBuiltin 创建hold函数
+func NewHoldBuiltin() *HoldBuiltin {
+	signature := Signature{
+		Name:        "hold",
+		Description: "条件在指定时长内持续成立时返回 true",
+		ReturnType:  "bool",
+		Args: []ArgInfo{
+			{
+				Name:        "condition",
+				Type:        "bool",
+				Required:    true,
+				Description: "需要持续成立的条件",
+			},
+			{
+				Name:        "duration",
+				Type:        "duration",
+				Required:    true,
+				Description: "持续时长，如：30s, 3m, 1h",
+			},
+		},
+	}
+
+	return &HoldBuiltin{
+		BaseBuiltin: NewBaseBuiltin("hold", "条件在指定时长内持续成立时返回 true", signature),
+	}
+}
+
+// Execute 执行hold函数
+func (f *HoldBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	cond, err := toBool(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to hold must be a condition: %w", err)
+	}
+
+	duration, err := toDuration(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid hold duration: %w", err)
+	}
+
+	state, key, err := callState(ctx, f.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	var hs holdState
+	if _, err := state.Get(key, &hs); err != nil {
+		return nil, err
+	}
+
+	now := Now(ctx)
+
+	// 求值中断过久（如引擎停机）时无法确认条件是否持续成立，重新计时
+	if !hs.LastSeen.IsZero() && now.Sub(hs.LastSeen) > MaxGap(ctx) {
+		hs.Since = time.Time{}
+	}
+	hs.LastSeen = now
+
+	if !cond {
+		hs.Since = time.Time{}
+	} else if hs.Since.IsZero() {
+		hs.Since = now
+	}
+
+	if err := state.Set(key, hs); err != nil {
+		return nil, err
+	}
+
+	return cond && now.Sub(hs.Since) >= duration, nil
+}
+
+// callState 获取当前函数调用对应的求值状态和状态键
+func callState(ctx context.Context, name string) (*State, string, error) {
+	state, ok := StateFromContext(ctx)
+	if !ok {
+		return nil, "", fmt.Errorf("function %s requires strategy state, which is only available in the engine", name)
+	}
+
+	key := CallKeyFromContext(ctx)
+	if key == "" {
+		key = name
+	}
+
+	return state, key, nil
+}
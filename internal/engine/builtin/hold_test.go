@@ -0,0 +1,141 @@
+package builtin
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// evalAt 在指定时间以给定状态执行函数
+func evalAt(t *testing.T, fn Builtin, state *State, now time.Time, args ...interface{}) bool {
+	t.Helper()
+
+	ctx := WithNow(WithState(context.Background(), state), now)
+	result, err := fn.Execute(WithCallKey(ctx, "test"), args, nil)
+	if err != nil {
+		t.Fatalf("%s 执行失败: %v", fn.GetName(), err)
+	}
+
+	return result.(bool)
+}
+
+func TestHoldBuiltin(t *testing.T) {
+	hold := NewHoldBuiltin()
+	state := NewState()
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	steps := []struct {
+		offset   time.Duration
+		cond     bool
+		expected bool
+	}{
+		{0, true, false},
+		{time.Minute, true, false},
+		{2 * time.Minute, true, false},
+		{3 * time.Minute, true, true},
+		{3*time.Minute + 5*time.Second, false, false}, // 条件中断，重新计时
+		{3*time.Minute + 10*time.Second, true, false},
+		{6*time.Minute + 10*time.Second, true, false}, // 与上次求值间隔过久，重新计时
+	}
+
+	for i, step := range steps {
+		if got := evalAt(t, hold, state, start.Add(step.offset), step.cond, "3m"); got != step.expected {
+			t.Errorf("第 %d 步期望 %v，实际 %v", i, step.expected, got)
+		}
+	}
+}
+
+func TestHoldBuiltinPersistence(t *testing.T) {
+	hold := NewHoldBuiltin()
+	state := NewState()
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 4; i++ {
+		evalAt(t, hold, state, start.Add(time.Duration(i)*50*time.Second), true, 180.0)
+	}
+
+	if !state.Dirty() {
+		t.Fatal("求值后状态应被标记为已修改")
+	}
+
+	data, err := state.Marshal()
+	if err != nil {
+		t.Fatalf("序列化状态失败: %v", err)
+	}
+
+	// 模拟引擎重启后从持久化数据恢复
+	restored, err := LoadState(data)
+	if err != nil {
+		t.Fatalf("恢复状态失败: %v", err)
+	}
+
+	if !evalAt(t, hold, restored, start.Add(200*time.Second), true, 180.0) {
+		t.Error("恢复状态后条件已持续 200 秒，期望为 true")
+	}
+
+	if _, err := NewHoldBuiltin().Execute(context.Background(), []interface{}{true, "3m"}, nil); err == nil {
+		t.Error("没有策略状态时应返回错误")
+	}
+}
+
+func TestWithinBuiltin(t *testing.T) {
+	within := NewWithinBuiltin()
+	state := NewState()
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	steps := []struct {
+		offset   time.Duration
+		cond     bool
+		expected bool
+	}{
+		{0, false, false},
+		{time.Minute, true, true},
+		{5 * time.Minute, false, true},
+		{11 * time.Minute, false, true},
+		{11*time.Minute + time.Second, false, false},
+	}
+
+	for i, step := range steps {
+		if got := evalAt(t, within, state, start.Add(step.offset), step.cond, "10m"); got != step.expected {
+			t.Errorf("第 %d 步期望 %v，实际 %v", i, step.expected, got)
+		}
+	}
+}
+
+func TestHoldBuiltinCheckInterval(t *testing.T) {
+	hold := NewHoldBuiltin()
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// 检查间隔 90 秒且有少量延迟时，求值间隔超过 1 分钟但仍视为连续
+	state := NewState()
+	ctx := WithCallKey(WithCheckInterval(WithState(context.Background(), state), 90*time.Second), "test")
+	var got bool
+	for i := 0; i <= 2; i++ {
+		result, err := hold.Execute(WithNow(ctx, start.Add(time.Duration(i)*95*time.Second)), []interface{}{true, "3m"}, nil)
+		if err != nil {
+			t.Fatalf("hold 执行失败: %v", err)
+		}
+		got = result.(bool)
+	}
+	if !got {
+		t.Error("条件已持续 190 秒，期望为 true")
+	}
+
+	// 超过检查间隔的 2 倍时重新计时
+	result, err := hold.Execute(WithNow(ctx, start.Add(190*time.Second+181*time.Second)), []interface{}{true, "3m"}, nil)
+	if err != nil {
+		t.Fatalf("hold 执行失败: %v", err)
+	}
+	if result.(bool) {
+		t.Error("与上次求值间隔超过检查间隔的 2 倍，期望重新计时")
+	}
+
+	// 未设置检查间隔时，间隔超过 1 分钟即重新计时
+	state = NewState()
+	for i := 0; i <= 2; i++ {
+		got = evalAt(t, hold, state, start.Add(time.Duration(i)*95*time.Second), true, "3m")
+	}
+	if got {
+		t.Error("未设置检查间隔时求值间隔超过 1 分钟，期望重新计时")
+	}
+}
@@ -0,0 +1,50 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// HourBuiltin hour函数实现
+type HourBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewHourBuiltin 创建hour函数
+func NewHourBuiltin() *HourBuiltin {
+	signature := Signature{
+		Name:        "hour",
+		Description: "返回当前时间在指定时区的小时（0-23）",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "tz",
+				Type:        "string",
+				Required:    false,
+				Description: "时区，如：UTC, Asia/Shanghai，默认本地时区",
+			},
+		},
+	}
+
+	return &HourBuiltin{
+		BaseBuiltin: NewBaseBuiltin("hour", "返回当前时间在指定时区的小时（0-23）", signature),
+	}
+}
+
+// Execute 执行hour函数
+func (f *HourBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	var tz interface{}
+	if len(args) > 0 {
+		tz = args[0]
+	}
+	loc, err := toLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hour tz: %w", err)
+	}
+
+	return float64(Now(ctx).In(loc).Hour()), nil
+}
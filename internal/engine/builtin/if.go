@@ -0,0 +1,61 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// IfBuiltin if函数实现
+type IfBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewIfBuiltin 创建if函数
+func NewIfBuiltin() *IfBuiltin {
+	signature := Signature{
+		Name:        "if",
+		Description: "条件成立时返回第一个值，否则返回第二个值",
+		ReturnType:  "any",
+		Args: []ArgInfo{
+			{
+				Name:        "condition",
+				Type:        "bool",
+				Required:    true,
+				Description: "判断条件",
+			},
+			{
+				Name:        "then",
+				Type:        "any",
+				Required:    true,
+				Description: "条件成立时的返回值",
+			},
+			{
+				Name:        "else",
+				Type:        "any",
+				Required:    true,
+				Description: "条件不成立时的返回值",
+			},
+		},
+	}
+
+	return &IfBuiltin{
+		BaseBuiltin: NewBaseBuiltin("if", "条件成立时返回第一个值，否则返回第二个值", signature),
+	}
+}
+
+// Execute 执行if函数
+func (f *IfBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	cond, err := toBool(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to if must be a condition: %w", err)
+	}
+
+	if cond {
+		return args[1], nil
+	}
+	return args[2], nil
+}
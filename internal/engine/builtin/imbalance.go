@@ -0,0 +1,77 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// defaultImbalancePct imbalance 函数默认统计的百分比范围
+const defaultImbalancePct = 0.5
+
+// ImbalanceBuiltin imbalance函数实现
+type ImbalanceBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewImbalanceBuiltin 创建imbalance函数
+func NewImbalanceBuiltin() *ImbalanceBuiltin {
+	signature := Signature{
+		Name:        "imbalance",
+		Description: "计算订单簿买卖盘失衡度 (bid-ask)/(bid+ask)，取值 -1 到 1",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "book",
+				Type:        "orderbook",
+				Required:    true,
+				Description: "订单簿，格式：orderbook.EXCHANGE.SYMBOL.book",
+			},
+			{
+				Name:        "pct",
+				Type:        "number",
+				Required:    false,
+				Description: "距中间价的百分比范围，默认 0.5 表示 0.5%",
+			},
+		},
+	}
+
+	return &ImbalanceBuiltin{
+		BaseBuiltin: NewBaseBuiltin("imbalance", "计算订单簿买卖盘失衡度 (bid-ask)/(bid+ask)，取值 -1 到 1", signature),
+	}
+}
+
+// Execute 执行imbalance函数
+func (f *ImbalanceBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	book, err := toOrderBook(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to imbalance %w", err)
+	}
+
+	pct := defaultImbalancePct
+	if len(args) > 1 {
+		pct, err = toFloat64(args[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid imbalance pct: %w", err)
+		}
+	}
+
+	bidDepth, err := orderBookDepth(book, "bid", pct)
+	if err != nil {
+		return nil, err
+	}
+
+	askDepth, err := orderBookDepth(book, "ask", pct)
+	if err != nil {
+		return nil, err
+	}
+
+	if bidDepth+askDepth == 0 {
+		return 0.0, nil
+	}
+
+	return (bidDepth - askDepth) / (bidDepth + askDepth), nil
+}
@@ -0,0 +1,39 @@
+package builtin
+
+import (
+	"context"
+)
+
+// InsideBarBuiltin inside_bar函数实现
+type InsideBarBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewInsideBarBuiltin 创建inside_bar函数
+func NewInsideBarBuiltin() *InsideBarBuiltin {
+	signature := Signature{
+		Name:        "inside_bar",
+		Description: "目标K线的最高价和最低价均位于前一根K线范围内时返回 true",
+		ReturnType:  "bool",
+		Args:        patternArgs(),
+	}
+
+	return &InsideBarBuiltin{
+		BaseBuiltin: NewBaseBuiltin("inside_bar", "目标K线位于前一根K线范围内时返回 true", signature),
+	}
+}
+
+// Execute 执行inside_bar函数
+func (f *InsideBarBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	window, err := patternWindow(ctx, "inside_bar", args, 2)
+	if err != nil {
+		return nil, err
+	}
+	prev, cur := window[0], window[1]
+
+	return cur.High < prev.High && cur.Low > prev.Low, nil
+}
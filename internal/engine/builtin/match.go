@@ -0,0 +1,57 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// MatchBuiltin match函数实现
+type MatchBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewMatchBuiltin 创建match函数
+func NewMatchBuiltin() *MatchBuiltin {
+	signature := Signature{
+		Name:        "match",
+		Description: "检查文本是否匹配正则表达式，支持 (?i) 忽略大小写、\\b 单词边界等 RE2 语法",
+		ReturnType:  "bool",
+		Args: []ArgInfo{
+			{
+				Name:        "text",
+				Type:        "string",
+				Required:    true,
+				Description: "要匹配的文本",
+			},
+			{
+				Name:        "pattern",
+				Type:        "string",
+				Required:    true,
+				Description: "正则表达式，如：(?i)spot\\s+etf",
+			},
+		},
+	}
+
+	return &MatchBuiltin{
+		BaseBuiltin: NewBaseBuiltin("match", "检查文本是否匹配正则表达式", signature),
+	}
+}
+
+// Execute 执行match函数
+func (f *MatchBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	pattern, ok := args[1].(string)
+	if !ok {
+		return nil, fmt.Errorf("second argument to match must be a string pattern, got %T", args[1])
+	}
+
+	re, err := compileRegex(ctx, pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return re.MatchString(toString(args[0])), nil
+}
@@ -0,0 +1,81 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lemconn/foxflow/internal/news"
+)
+
+// NewsCountBuiltin news_count函数实现
+type NewsCountBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewNewsCountBuiltin 创建news_count函数
+func NewNewsCountBuiltin() *NewsCountBuiltin {
+	signature := Signature{
+		Name:        "news_count",
+		Description: "统计最近指定时长内标题或内容包含关键词（忽略大小写）的新闻数量",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "source",
+				Type:        "news",
+				Required:    true,
+				Description: "新闻源，格式：news.SOURCE",
+			},
+			{
+				Name:        "keyword",
+				Type:        "string",
+				Required:    true,
+				Description: "关键词，为空字符串时统计全部新闻",
+			},
+			{
+				Name:        "window",
+				Type:        "duration",
+				Required:    true,
+				Description: "统计时长，如：30m, 2h",
+			},
+		},
+	}
+
+	return &NewsCountBuiltin{
+		BaseBuiltin: NewBaseBuiltin("news_count", "统计最近指定时长内包含关键词的新闻数量", signature),
+	}
+}
+
+// Execute 执行news_count函数
+func (f *NewsCountBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	items, ok := args[0].([]news.NewsItem)
+	if !ok {
+		return nil, fmt.Errorf("first argument to news_count must be a news source like news.blockbeats, got %T", args[0])
+	}
+
+	keyword := strings.ToLower(toString(args[1]))
+
+	window, err := toDuration(args[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid news_count window: %w", err)
+	}
+
+	since := Now(ctx).Add(-window)
+	count := 0
+	for _, item := range items {
+		if item.PublishedAt.Before(since) {
+			continue
+		}
+		if keyword == "" ||
+			strings.Contains(strings.ToLower(item.Title), keyword) ||
+			strings.Contains(strings.ToLower(item.Content), keyword) {
+			count++
+		}
+	}
+
+	return float64(count), nil
+}
@@ -0,0 +1,33 @@
+package builtin
+
+import (
+	"context"
+)
+
+// NowBuiltin now函数实现
+type NowBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewNowBuiltin 创建now函数
+func NewNowBuiltin() *NowBuiltin {
+	signature := Signature{
+		Name:        "now",
+		Description: "返回引擎时钟的当前时间",
+		ReturnType:  "time",
+		Args:        []ArgInfo{},
+	}
+
+	return &NowBuiltin{
+		BaseBuiltin: NewBaseBuiltin("now", "返回引擎时钟的当前时间", signature),
+	}
+}
+
+// Execute 执行now函数
+func (f *NowBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	return Now(ctx), nil
+}
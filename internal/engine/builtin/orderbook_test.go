@@ -0,0 +1,106 @@
+package builtin
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+)
+
+// testOrderBook 中间价为 100 的测试订单簿
+func testOrderBook() *exchange.OrderBook {
+	return &exchange.OrderBook{
+		Symbol: "BTC-USDT-SWAP",
+		Bids: []exchange.OrderBookLevel{
+			{Price: "99.99", Size: "10"},
+			{Price: "99.8", Size: "20"},
+			{Price: "99.2", Size: "100"},
+		},
+		Asks: []exchange.OrderBookLevel{
+			{Price: "100.01", Size: "5"},
+			{Price: "100.3", Size: "5"},
+			{Price: "101", Size: "100"},
+		},
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSpreadBuiltin(t *testing.T) {
+	result, err := NewSpreadBuiltin().Execute(context.Background(), []interface{}{testOrderBook()}, nil)
+	if err != nil {
+		t.Fatalf("spread 执行失败: %v", err)
+	}
+
+	if !almostEqual(result.(float64), 2) {
+		t.Errorf("期望价差 2 bps，实际 %v", result)
+	}
+
+	if _, err := NewSpreadBuiltin().Execute(context.Background(), []interface{}{&exchange.OrderBook{}}, nil); err == nil {
+		t.Error("空订单簿应返回错误")
+	}
+
+	if _, err := NewSpreadBuiltin().Execute(context.Background(), []interface{}{"book"}, nil); err == nil {
+		t.Error("非订单簿参数应返回错误")
+	}
+}
+
+func TestDepthBuiltin(t *testing.T) {
+	testCases := []struct {
+		side     string
+		pct      float64
+		expected float64
+	}{
+		{"bid", 0.5, 30},
+		{"ask", 0.5, 10},
+		{"bid", 1, 130},
+		{"ask", 1, 110},
+		{"bid", 0, 0},
+	}
+
+	for _, tc := range testCases {
+		result, err := NewDepthBuiltin().Execute(context.Background(), []interface{}{testOrderBook(), tc.side, tc.pct}, nil)
+		if err != nil {
+			t.Errorf("depth(%s, %v) 执行失败: %v", tc.side, tc.pct, err)
+			continue
+		}
+		if !almostEqual(result.(float64), tc.expected) {
+			t.Errorf("depth(%s, %v) 期望 %v，实际 %v", tc.side, tc.pct, tc.expected, result)
+		}
+	}
+
+	if _, err := NewDepthBuiltin().Execute(context.Background(), []interface{}{testOrderBook(), "middle", 0.5}, nil); err == nil {
+		t.Error("非法的 side 应返回错误")
+	}
+
+	if _, err := NewDepthBuiltin().Execute(context.Background(), []interface{}{testOrderBook(), "bid"}, nil); err == nil {
+		t.Error("参数不足应返回错误")
+	}
+}
+
+func TestImbalanceBuiltin(t *testing.T) {
+	// 默认 0.5%：买盘 30，卖盘 10
+	result, err := NewImbalanceBuiltin().Execute(context.Background(), []interface{}{testOrderBook()}, nil)
+	if err != nil {
+		t.Fatalf("imbalance 执行失败: %v", err)
+	}
+	if !almostEqual(result.(float64), 0.5) {
+		t.Errorf("期望失衡度 0.5，实际 %v", result)
+	}
+
+	// 1%：买盘 130，卖盘 110
+	result, err = NewImbalanceBuiltin().Execute(context.Background(), []interface{}{testOrderBook(), 1.0}, nil)
+	if err != nil {
+		t.Fatalf("imbalance 执行失败: %v", err)
+	}
+	if !almostEqual(result.(float64), 20.0/240.0) {
+		t.Errorf("期望失衡度 %v，实际 %v", 20.0/240.0, result)
+	}
+
+	if _, err := NewImbalanceBuiltin().Execute(context.Background(), []interface{}{testOrderBook(), 1.0, 2.0}, nil); err == nil {
+		t.Error("参数过多应返回错误")
+	}
+}
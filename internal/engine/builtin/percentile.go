@@ -0,0 +1,88 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// PercentileBuiltin percentile函数实现
+type PercentileBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewPercentileBuiltin 创建percentile函数
+func NewPercentileBuiltin() *PercentileBuiltin {
+	signature := Signature{
+		Name:        "percentile",
+		Description: "计算数据序列的第 p 百分位数（线性插值）",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "path",
+				Type:        "string",
+				Required:    true,
+				Description: "数据路径，格式：kline.SYMBOL.field",
+			},
+			{
+				Name:        "interval",
+				Type:        "string",
+				Required:    true,
+				Description: "时间间隔，如：15m, 1h, 1d",
+			},
+			{
+				Name:        "limit",
+				Type:        "number",
+				Required:    true,
+				Description: "数据点数量",
+			},
+			{
+				Name:        "p",
+				Type:        "number",
+				Required:    true,
+				Description: "百分位，取值 0-100",
+			},
+		},
+	}
+
+	return &PercentileBuiltin{
+		BaseBuiltin: NewBaseBuiltin("percentile", "计算数据序列的第 p 百分位数", signature),
+	}
+}
+
+// Execute 执行percentile函数
+func (f *PercentileBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	series, err := toSeries(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to percentile must be a data array: %w", err)
+	}
+
+	p, err := toFloat64(args[3])
+	if err != nil {
+		return nil, fmt.Errorf("percentile p must be a number: %w", err)
+	}
+	if p < 0 || p > 100 {
+		return nil, fmt.Errorf("percentile p must be between 0 and 100, got %v", p)
+	}
+
+	if len(series) == 0 {
+		return nil, fmt.Errorf("percentile requires a non-empty series")
+	}
+
+	sorted := make([]float64, len(series))
+	copy(sorted, series)
+	sort.Float64s(sorted)
+
+	// 线性插值，p=0 为最小值，p=100 为最大值
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(rank)
+	if lower >= len(sorted)-1 {
+		return sorted[len(sorted)-1], nil
+	}
+	frac := rank - float64(lower)
+	return sorted[lower] + frac*(sorted[lower+1]-sorted[lower]), nil
+}
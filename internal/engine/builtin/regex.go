@@ -0,0 +1,73 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// RegexCache 已编译正则表达式缓存
+// 引擎为每个策略维护一个缓存，避免每个检查周期重复编译
+type RegexCache struct {
+	mu       sync.Mutex
+	patterns map[string]*regexp.Regexp
+}
+
+// NewRegexCache 创建正则表达式缓存
+func NewRegexCache() *RegexCache {
+	return &RegexCache{patterns: make(map[string]*regexp.Regexp)}
+}
+
+// Compile 获取已编译的正则表达式，未命中时编译并缓存
+func (c *RegexCache) Compile(pattern string) (*regexp.Regexp, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if re, exists := c.patterns[pattern]; exists {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression %q: %w", pattern, err)
+	}
+
+	c.patterns[pattern] = re
+	return re, nil
+}
+
+// Len 缓存的正则表达式数量
+func (c *RegexCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.patterns)
+}
+
+// regexCacheContextKey 正则表达式缓存在上下文中的键
+type regexCacheContextKey struct{}
+
+// WithRegexCache 将正则表达式缓存写入上下文
+func WithRegexCache(ctx context.Context, cache *RegexCache) context.Context {
+	return context.WithValue(ctx, regexCacheContextKey{}, cache)
+}
+
+// RegexCacheFromContext 从上下文中获取正则表达式缓存
+func RegexCacheFromContext(ctx context.Context) (*RegexCache, bool) {
+	cache, ok := ctx.Value(regexCacheContextKey{}).(*RegexCache)
+	return cache, ok && cache != nil
+}
+
+// compileRegex 编译正则表达式，上下文中有缓存时优先使用缓存
+func compileRegex(ctx context.Context, pattern string) (*regexp.Regexp, error) {
+	if cache, ok := RegexCacheFromContext(ctx); ok {
+		return cache.Compile(pattern)
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression %q: %w", pattern, err)
+	}
+	return re, nil
+}
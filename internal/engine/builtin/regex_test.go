@@ -0,0 +1,98 @@
+package builtin
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMatchBuiltin(t *testing.T) {
+	match := NewMatchBuiltin()
+	ctx := context.Background()
+
+	testCases := []struct {
+		text     string
+		pattern  string
+		expected bool
+	}{
+		{"SEC Approves Spot ETF Applications", `(?i)spot\s+etf\s+approv`, false},
+		{"Spot  ETF approved by SEC", `(?i)spot\s+etf\s+approv`, true},
+		{"Bitcoin ETFs see record inflows", `\bETF\b`, false},
+		{"Bitcoin ETF sees record inflows", `\bETF\b`, true},
+	}
+
+	for _, tc := range testCases {
+		result, err := match.Execute(ctx, []interface{}{tc.text, tc.pattern}, nil)
+		if err != nil {
+			t.Fatalf("match(%q, %q) 执行失败: %v", tc.text, tc.pattern, err)
+		}
+		if result != tc.expected {
+			t.Errorf("match(%q, %q) 期望 %v，实际 %v", tc.text, tc.pattern, tc.expected, result)
+		}
+	}
+
+	if _, err := match.Execute(ctx, []interface{}{"text", "("}, nil); err == nil {
+		t.Error("期望无效正则表达式报错")
+	}
+}
+
+func TestHasAnyBuiltin(t *testing.T) {
+	hasAny := NewHasAnyBuiltin()
+	ctx := context.Background()
+
+	testCases := []struct {
+		text     string
+		keywords []string
+		word     interface{}
+		expected bool
+	}{
+		{"SEC approves spot ETF", []string{"etf", "halving"}, nil, true},
+		{"SEC approves spot ETF", []string{"halving"}, nil, false},
+		{"Bitcoin ETFs see record inflows", []string{"etf"}, nil, true},
+		{"Bitcoin ETFs see record inflows", []string{"etf"}, true, false},
+		{"Bitcoin ETF sees record inflows", []string{"etf"}, true, true},
+		{"比特币现货ETF获批", []string{"现货"}, nil, true},
+		{"比特币现货ETF获批", []string{"现货"}, true, true},
+		{"比特币现货ETF获批", []string{"现货ETF"}, true, true},
+		{"比特币现货ETFs获批", []string{"现货ETF"}, true, false},
+		{"现货ETF获批", []string{"ETF获批"}, true, true},
+	}
+
+	for _, tc := range testCases {
+		args := []interface{}{tc.text, tc.keywords}
+		if tc.word != nil {
+			args = append(args, tc.word)
+		}
+		result, err := hasAny.Execute(ctx, args, nil)
+		if err != nil {
+			t.Fatalf("has_any(%q, %v) 执行失败: %v", tc.text, tc.keywords, err)
+		}
+		if result != tc.expected {
+			t.Errorf("has_any(%q, %v, word=%v) 期望 %v，实际 %v", tc.text, tc.keywords, tc.word, tc.expected, result)
+		}
+	}
+}
+
+func TestRegexCache(t *testing.T) {
+	cache := NewRegexCache()
+	ctx := WithRegexCache(context.Background(), cache)
+	match := NewMatchBuiltin()
+
+	for i := 0; i < 3; i++ {
+		if _, err := match.Execute(ctx, []interface{}{"spot etf", `(?i)spot\s+etf`}, nil); err != nil {
+			t.Fatalf("match 执行失败: %v", err)
+		}
+	}
+	if _, err := NewHasAnyBuiltin().Execute(ctx, []interface{}{"spot etf", []string{"etf"}, true}, nil); err != nil {
+		t.Fatalf("has_any 执行失败: %v", err)
+	}
+
+	if cache.Len() != 2 {
+		t.Errorf("期望缓存 2 个正则表达式，实际 %d", cache.Len())
+	}
+
+	first, _ := cache.Compile(`(?i)spot\s+etf`)
+	second, _ := cache.Compile(`(?i)spot\s+etf`)
+	if first != second {
+		t.Error("期望重复编译返回同一个正则表达式实例")
+	}
+}
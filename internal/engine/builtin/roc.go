@@ -0,0 +1,87 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// RocBuiltin roc函数实现
+type RocBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewRocBuiltin 创建roc函数
+func NewRocBuiltin() *RocBuiltin {
+	signature := Signature{
+		Name:        "roc",
+		Description: "计算数据序列最新值相对 n 个周期前的变化率（百分比）",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "path",
+				Type:        "string",
+				Required:    true,
+				Description: "数据路径，格式：kline.SYMBOL.field",
+			},
+			{
+				Name:        "interval",
+				Type:        "string",
+				Required:    true,
+				Description: "时间间隔，如：15m, 1h, 1d",
+			},
+			{
+				Name:        "limit",
+				Type:        "number",
+				Required:    true,
+				Description: "数据点数量",
+			},
+			{
+				Name:        "n",
+				Type:        "number",
+				Required:    false,
+				Description: "回看周期数，默认为序列首尾之间的周期数",
+			},
+		},
+	}
+
+	return &RocBuiltin{
+		BaseBuiltin: NewBaseBuiltin("roc", "计算数据序列最新值相对 n 个周期前的变化率（百分比）", signature),
+	}
+}
+
+// Execute 执行roc函数
+func (f *RocBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	series, err := toSeries(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to roc must be a data array: %w", err)
+	}
+
+	if len(series) < 2 {
+		return nil, fmt.Errorf("roc requires at least 2 data points, got %d", len(series))
+	}
+
+	n := len(series) - 1
+	if len(args) > 3 && args[3] != nil {
+		value, err := toFloat64(args[3])
+		if err != nil {
+			return nil, fmt.Errorf("roc n must be a number: %w", err)
+		}
+		n = int(value)
+	}
+	if n <= 0 || n >= len(series) {
+		return nil, fmt.Errorf("roc n must be between 1 and %d, got %d", len(series)-1, n)
+	}
+
+	// 序列按时间从早到晚排列，最后一个为最新值
+	latest := series[len(series)-1]
+	base := series[len(series)-1-n]
+	if base == 0 {
+		return nil, fmt.Errorf("roc base value is zero")
+	}
+
+	return (latest - base) / base * 100, nil
+}
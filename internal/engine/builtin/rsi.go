@@ -0,0 +1,105 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// RsiBuiltin rsi函数实现
+type RsiBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewRsiBuiltin 创建rsi函数
+func NewRsiBuiltin() *RsiBuiltin {
+	signature := Signature{
+		Name:        "rsi",
+		Description: "计算数据序列的相对强弱指标 RSI（0-100，Wilder 平滑）",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "path",
+				Type:        "string",
+				Required:    true,
+				Description: "数据路径，格式：kline.SYMBOL.field",
+			},
+			{
+				Name:        "interval",
+				Type:        "string",
+				Required:    true,
+				Description: "时间间隔，如：15m, 1h, 1d",
+			},
+			{
+				Name:        "limit",
+				Type:        "number",
+				Required:    true,
+				Description: "数据点数量，需大于周期数",
+			},
+			{
+				Name:        "n",
+				Type:        "number",
+				Required:    false,
+				Description: "RSI 周期数，默认为序列首尾之间的周期数",
+			},
+		},
+	}
+
+	return &RsiBuiltin{
+		BaseBuiltin: NewBaseBuiltin("rsi", "计算数据序列的相对强弱指标 RSI（0-100，Wilder 平滑）", signature),
+	}
+}
+
+// Execute 执行rsi函数
+func (f *RsiBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	series, err := toSeries(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to rsi must be a data array: %w", err)
+	}
+
+	if len(series) < 2 {
+		return nil, fmt.Errorf("rsi requires at least 2 data points, got %d", len(series))
+	}
+
+	n := len(series) - 1
+	if len(args) > 3 && args[3] != nil {
+		value, err := toFloat64(args[3])
+		if err != nil {
+			return nil, fmt.Errorf("rsi n must be a number: %w", err)
+		}
+		n = int(value)
+	}
+	if n <= 0 || n >= len(series) {
+		return nil, fmt.Errorf("rsi n must be between 1 and %d, got %d", len(series)-1, n)
+	}
+
+	// 前 n 个变化取简单平均，之后按 Wilder 方式平滑
+	var avgGain, avgLoss float64
+	for i := 1; i < len(series); i++ {
+		gain, loss := 0.0, 0.0
+		if change := series[i] - series[i-1]; change > 0 {
+			gain = change
+		} else {
+			loss = -change
+		}
+
+		if i <= n {
+			avgGain += gain / float64(n)
+			avgLoss += loss / float64(n)
+			continue
+		}
+		avgGain = (avgGain*float64(n-1) + gain) / float64(n)
+		avgLoss = (avgLoss*float64(n-1) + loss) / float64(n)
+	}
+
+	if avgLoss == 0 {
+		if avgGain == 0 {
+			return 50.0, nil
+		}
+		return 100.0, nil
+	}
+	return 100 - 100/(1+avgGain/avgLoss), nil
+}
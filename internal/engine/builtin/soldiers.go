@@ -0,0 +1,52 @@
+package builtin
+
+import (
+	"context"
+)
+
+// ThreeWhiteSoldiersBuiltin three_white_soldiers函数实现
+type ThreeWhiteSoldiersBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewThreeWhiteSoldiersBuiltin 创建three_white_soldiers函数
+func NewThreeWhiteSoldiersBuiltin() *ThreeWhiteSoldiersBuiltin {
+	signature := Signature{
+		Name:        "three_white_soldiers",
+		Description: "以目标K线结尾的三根K线均为阳线、收盘价逐根抬高且开盘价位于前一根实体内时返回 true",
+		ReturnType:  "bool",
+		Args:        patternArgs(),
+	}
+
+	return &ThreeWhiteSoldiersBuiltin{
+		BaseBuiltin: NewBaseBuiltin("three_white_soldiers", "以目标K线结尾的三根K线构成红三兵时返回 true", signature),
+	}
+}
+
+// Execute 执行three_white_soldiers函数
+func (f *ThreeWhiteSoldiersBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	window, err := patternWindow(ctx, "three_white_soldiers", args, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, c := range window {
+		if !c.bullish() {
+			return false, nil
+		}
+		if i == 0 {
+			continue
+		}
+
+		prev := window[i-1]
+		if c.Close <= prev.Close || c.Open < prev.Open || c.Open > prev.Close {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
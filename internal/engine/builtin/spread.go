@@ -0,0 +1,52 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// SpreadBuiltin spread函数实现
+type SpreadBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewSpreadBuiltin 创建spread函数
+func NewSpreadBuiltin() *SpreadBuiltin {
+	signature := Signature{
+		Name:        "spread",
+		Description: "计算订单簿买一卖一价差，单位为基点(bps)",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "book",
+				Type:        "orderbook",
+				Required:    true,
+				Description: "订单簿，格式：orderbook.EXCHANGE.SYMBOL.book",
+			},
+		},
+	}
+
+	return &SpreadBuiltin{
+		BaseBuiltin: NewBaseBuiltin("spread", "计算订单簿买一卖一价差，单位为基点(bps)", signature),
+	}
+}
+
+// Execute 执行spread函数
+func (f *SpreadBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	book, err := toOrderBook(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to spread %w", err)
+	}
+
+	bestBid, bestAsk, err := bestPrices(book)
+	if err != nil {
+		return nil, err
+	}
+
+	mid := (bestBid + bestAsk) / 2
+	return (bestAsk - bestBid) / mid * 10000, nil
+}
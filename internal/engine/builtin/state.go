@@ -0,0 +1,153 @@
+package builtin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// State 策略求值状态
+// 供 hold、within 等需要跨检查周期记忆的函数使用，由引擎按订单加载和持久化
+type State struct {
+	mu     sync.Mutex
+	values map[string]json.RawMessage
+	dirty  bool
+}
+
+// NewState 创建空的策略求值状态
+func NewState() *State {
+	return &State{values: make(map[string]json.RawMessage)}
+}
+
+// LoadState 从持久化的 JSON 数据恢复策略求值状态
+func LoadState(data string) (*State, error) {
+	state := NewState()
+	if data == "" {
+		return state, nil
+	}
+
+	if err := json.Unmarshal([]byte(data), &state.values); err != nil {
+		return nil, fmt.Errorf("failed to decode strategy state: %w", err)
+	}
+
+	return state, nil
+}
+
+// Get 读取指定键的状态，不存在时返回 false
+func (s *State) Get(key string, v interface{}) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, exists := s.values[key]
+	if !exists {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return false, fmt.Errorf("failed to decode state %s: %w", key, err)
+	}
+
+	return true, nil
+}
+
+// Set 写入指定键的状态
+func (s *State) Set(key string, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode state %s: %w", key, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if string(s.values[key]) != string(raw) {
+		s.values[key] = raw
+		s.dirty = true
+	}
+
+	return nil
+}
+
+// Dirty 状态自加载后是否发生变化
+func (s *State) Dirty() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.dirty
+}
+
+// Marshal 序列化为 JSON 以便持久化
+func (s *State) Marshal() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.values) == 0 {
+		return "", nil
+	}
+
+	data, err := json.Marshal(s.values)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode strategy state: %w", err)
+	}
+
+	return string(data), nil
+}
+
+type (
+	stateContextKey    struct{}
+	callKeyContextKey  struct{}
+	nowContextKey      struct{}
+	intervalContextKey struct{}
+)
+
+// WithState 将策略求值状态写入上下文
+func WithState(ctx context.Context, state *State) context.Context {
+	return context.WithValue(ctx, stateContextKey{}, state)
+}
+
+// StateFromContext 从上下文中获取策略求值状态
+func StateFromContext(ctx context.Context) (*State, bool) {
+	state, ok := ctx.Value(stateContextKey{}).(*State)
+	return state, ok && state != nil
+}
+
+// WithCallKey 将当前函数调用的唯一标识写入上下文
+// 同一策略中相同的函数调用表达式共享同一份状态
+func WithCallKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, callKeyContextKey{}, key)
+}
+
+// CallKeyFromContext 从上下文中获取当前函数调用的唯一标识
+func CallKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(callKeyContextKey{}).(string)
+	return key
+}
+
+// WithNow 将本次求值使用的当前时间写入上下文
+func WithNow(ctx context.Context, now time.Time) context.Context {
+	return context.WithValue(ctx, nowContextKey{}, now)
+}
+
+// Now 获取本次求值使用的当前时间，未设置时使用系统时间
+func Now(ctx context.Context) time.Time {
+	if now, ok := ctx.Value(nowContextKey{}).(time.Time); ok {
+		return now
+	}
+	return time.Now()
+}
+
+// WithCheckInterval 将引擎的检查间隔写入上下文，hold 等函数据此判断两次求值之间是否连续
+func WithCheckInterval(ctx context.Context, interval time.Duration) context.Context {
+	return context.WithValue(ctx, intervalContextKey{}, interval)
+}
+
+// MaxGap 获取两次求值之间允许的最大间隔：检查间隔的 2 倍，且不小于 1 分钟
+func MaxGap(ctx context.Context) time.Duration {
+	gap := time.Minute
+	if interval, ok := ctx.Value(intervalContextKey{}).(time.Duration); ok && 2*interval > gap {
+		gap = 2 * interval
+	}
+	return gap
+}
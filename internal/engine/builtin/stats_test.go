@@ -0,0 +1,100 @@
+package builtin
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+// klineCloses 模拟 KlineProvider 返回的收盘价序列（字符串）
+var klineCloses = []interface{}{"2", "4", "4", "4", "5", "5", "7", "9"}
+
+// klineVolumes 模拟 KlineProvider 返回的成交量序列（float64）
+var klineVolumes = []interface{}{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0, 8.0}
+
+func execFloat(t *testing.T, fn Builtin, args ...interface{}) float64 {
+	t.Helper()
+
+	result, err := fn.Execute(context.Background(), args, nil)
+	if err != nil {
+		t.Fatalf("%s 执行失败: %v", fn.GetName(), err)
+	}
+
+	return result.(float64)
+}
+
+func assertClose(t *testing.T, name string, actual, expected float64) {
+	t.Helper()
+
+	if math.Abs(actual-expected) > 1e-9 {
+		t.Errorf("%s 期望 %v，实际 %v", name, expected, actual)
+	}
+}
+
+func TestStddevBuiltin(t *testing.T) {
+	fn := NewStddevBuiltin()
+	assertClose(t, "stddev(收盘价)", execFloat(t, fn, klineCloses, "15m", 8.0), 2)
+	assertClose(t, "stddev(成交量)", execFloat(t, fn, klineVolumes, "15m", 8.0), math.Sqrt(5.25))
+
+	if _, err := fn.Execute(context.Background(), []interface{}{[]interface{}{"abc"}, "15m", 1.0}, nil); err == nil {
+		t.Error("期望非数值数据报错")
+	}
+}
+
+func TestZscoreBuiltin(t *testing.T) {
+	fn := NewZscoreBuiltin()
+	assertClose(t, "zscore(9)", execFloat(t, fn, "9", klineCloses, "15m", 8.0), 2)
+	assertClose(t, "zscore(3)", execFloat(t, fn, 3.0, klineCloses, "15m", 8.0), -1)
+	assertClose(t, "zscore(常数序列)", execFloat(t, fn, 3.0, []interface{}{"1", "1"}, "15m", 2.0), 0)
+}
+
+func TestPercentileBuiltin(t *testing.T) {
+	fn := NewPercentileBuiltin()
+	assertClose(t, "percentile(0)", execFloat(t, fn, klineCloses, "15m", 8.0, 0.0), 2)
+	assertClose(t, "percentile(50)", execFloat(t, fn, klineCloses, "15m", 8.0, 50.0), 4.5)
+	assertClose(t, "percentile(100)", execFloat(t, fn, klineCloses, "15m", 8.0, 100.0), 9)
+	assertClose(t, "percentile(90)", execFloat(t, fn, klineVolumes, "15m", 8.0, 90.0), 7.3)
+
+	if _, err := fn.Execute(context.Background(), []interface{}{klineCloses, "15m", 8.0, 120.0}, nil); err == nil {
+		t.Error("期望百分位超出范围报错")
+	}
+}
+
+func TestRocBuiltin(t *testing.T) {
+	fn := NewRocBuiltin()
+	assertClose(t, "roc(默认)", execFloat(t, fn, klineCloses, "15m", 8.0), 350)
+	assertClose(t, "roc(2)", execFloat(t, fn, klineCloses, "15m", 8.0, 2.0), 80)
+	assertClose(t, "roc(成交量)", execFloat(t, fn, klineVolumes, "15m", 8.0, 4.0), 100)
+
+	if _, err := fn.Execute(context.Background(), []interface{}{klineCloses, "15m", 8.0, 8.0}, nil); err == nil {
+		t.Error("期望回看周期超过序列长度报错")
+	}
+}
+
+func TestRsiBuiltin(t *testing.T) {
+	fn := NewRsiBuiltin()
+	prices := []interface{}{"100", "102", "101", "105"}
+	assertClose(t, "rsi(默认)", execFloat(t, fn, prices, "1d", 4.0), 100-100.0/7)
+	assertClose(t, "rsi(2)", execFloat(t, fn, prices, "1d", 4.0, 2.0), 100-100.0/11)
+	assertClose(t, "rsi(只涨)", execFloat(t, fn, klineCloses, "15m", 8.0), 100)
+	assertClose(t, "rsi(不变)", execFloat(t, fn, []interface{}{"1", "1", "1"}, "15m", 3.0), 50)
+
+	if _, err := fn.Execute(context.Background(), []interface{}{prices, "1d", 4.0, 4.0}, nil); err == nil {
+		t.Error("期望周期数超过序列长度报错")
+	}
+}
+
+func TestCorrBuiltin(t *testing.T) {
+	fn := NewCorrBuiltin()
+	assertClose(t, "corr(自身)", execFloat(t, fn, klineVolumes, klineVolumes, "15m", 8.0), 1)
+
+	inverse := []interface{}{"8", "7", "6", "5", "4", "3", "2", "1"}
+	assertClose(t, "corr(反向)", execFloat(t, fn, klineVolumes, inverse, "15m", 8.0), -1)
+
+	// 长度不一致时按最新数据对齐
+	assertClose(t, "corr(对齐)", execFloat(t, fn, klineVolumes, []interface{}{"10", "20", "30"}, "15m", 8.0), 1)
+
+	if _, err := fn.Execute(context.Background(), []interface{}{klineVolumes, []interface{}{"1", "1"}, "15m", 8.0}, nil); err == nil {
+		t.Error("期望常数序列报错")
+	}
+}
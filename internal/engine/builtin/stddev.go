@@ -0,0 +1,58 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// StddevBuiltin stddev函数实现
+type StddevBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewStddevBuiltin 创建stddev函数
+func NewStddevBuiltin() *StddevBuiltin {
+	signature := Signature{
+		Name:        "stddev",
+		Description: "计算指定数据序列的总体标准差",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "path",
+				Type:        "string",
+				Required:    true,
+				Description: "数据路径，格式：kline.SYMBOL.field",
+			},
+			{
+				Name:        "interval",
+				Type:        "string",
+				Required:    true,
+				Description: "时间间隔，如：15m, 1h, 1d",
+			},
+			{
+				Name:        "limit",
+				Type:        "number",
+				Required:    true,
+				Description: "数据点数量",
+			},
+		},
+	}
+
+	return &StddevBuiltin{
+		BaseBuiltin: NewBaseBuiltin("stddev", "计算指定数据序列的总体标准差", signature),
+	}
+}
+
+// Execute 执行stddev函数
+func (f *StddevBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	series, err := toSeries(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to stddev must be a data array: %w", err)
+	}
+
+	return stddev(series), nil
+}
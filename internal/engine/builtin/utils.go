@@ -2,9 +2,13 @@ package builtin
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
+
+	// 内嵌时区数据库，保证在缺少系统时区文件的环境中也能解析 tz 参数
+	_ "time/tzdata"
 )
 
 // toFloat64 转换为float64
@@ -106,3 +110,151 @@ func toStringArray(v interface{}) ([]string, error) {
 		return nil, fmt.Errorf("cannot convert %T to []string", v)
 	}
 }
+
+// toDuration 转换为时间长度
+// 支持 time.Duration、数字（秒）以及 "30s"、"5m"、"2h"、"1d" 格式的字符串
+func toDuration(v interface{}) (time.Duration, error) {
+	switch val := v.(type) {
+	case time.Duration:
+		return val, nil
+	case float64:
+		return time.Duration(val * float64(time.Second)), nil
+	case int:
+		return time.Duration(val) * time.Second, nil
+	case int64:
+		return time.Duration(val) * time.Second, nil
+	case string:
+		if strings.HasSuffix(val, "d") {
+			days, err := strconv.ParseFloat(strings.TrimSuffix(val, "d"), 64)
+			if err != nil {
+				return 0, fmt.Errorf("cannot parse duration string: %s", val)
+			}
+			return time.Duration(days * float64(24*time.Hour)), nil
+		}
+
+		d, err := time.ParseDuration(val)
+		if err != nil {
+			return 0, fmt.Errorf("cannot parse duration string: %s", val)
+		}
+		return d, nil
+	default:
+		return 0, fmt.Errorf("cannot convert %T to duration", v)
+	}
+}
+
+// toLocation 转换为时区，未指定时使用本地时区
+func toLocation(v interface{}) (*time.Location, error) {
+	if v == nil {
+		return time.Local, nil
+	}
+
+	name := toString(v)
+	if name == "" {
+		return time.Local, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown time zone: %s", name)
+	}
+	return loc, nil
+}
+
+// parseClock 解析 "HH:MM" 或 "HH:MM:SS" 格式的时刻，返回距当天零点的时长
+func parseClock(v interface{}) (time.Duration, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("time of day must be a string like \"08:00\", got %T", v)
+	}
+
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second, nil
+		}
+	}
+	return 0, fmt.Errorf("cannot parse time of day: %s", s)
+}
+
+// toSeries 将数据数组转换为数值序列
+// K线数据中价格为字符串、成交量为 float64，这里统一转换
+func toSeries(v interface{}) ([]float64, error) {
+	switch val := v.(type) {
+	case []float64:
+		return val, nil
+	case []interface{}:
+		series := make([]float64, len(val))
+		for i, item := range val {
+			num, err := toFloat64(item)
+			if err != nil {
+				return nil, fmt.Errorf("invalid data in series at index %d: %w", i, err)
+			}
+			series[i] = num
+		}
+		return series, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %T to series", v)
+	}
+}
+
+// mean 计算序列的平均值
+func mean(series []float64) float64 {
+	if len(series) == 0 {
+		return 0
+	}
+
+	sum := 0.0
+	for _, v := range series {
+		sum += v
+	}
+	return sum / float64(len(series))
+}
+
+// stddev 计算序列的总体标准差
+func stddev(series []float64) float64 {
+	if len(series) == 0 {
+		return 0
+	}
+
+	m := mean(series)
+	variance := 0.0
+	for _, v := range series {
+		variance += (v - m) * (v - m)
+	}
+	return math.Sqrt(variance / float64(len(series)))
+}
+
+// toList 转换为列表
+func toList(v interface{}) ([]interface{}, error) {
+	switch val := v.(type) {
+	case []interface{}:
+		return val, nil
+	case []string:
+		result := make([]interface{}, len(val))
+		for i, item := range val {
+			result[i] = item
+		}
+		return result, nil
+	default:
+		return nil, fmt.Errorf("must be a list, got %T", v)
+	}
+}
+
+// countTrue 统计列表中成立的条件数量
+func countTrue(v interface{}) (int, error) {
+	items, err := toList(v)
+	if err != nil {
+		return 0, err
+	}
+
+	matched := 0
+	for i, item := range items {
+		ok, err := toBool(item)
+		if err != nil {
+			return 0, fmt.Errorf("element %d is not a condition: %w", i, err)
+		}
+		if ok {
+			matched++
+		}
+	}
+	return matched, nil
+}
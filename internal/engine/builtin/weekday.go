@@ -0,0 +1,50 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// WeekdayBuiltin weekday函数实现
+type WeekdayBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewWeekdayBuiltin 创建weekday函数
+func NewWeekdayBuiltin() *WeekdayBuiltin {
+	signature := Signature{
+		Name:        "weekday",
+		Description: "返回当前时间在指定时区的星期（0=周日，1=周一，…，6=周六）",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "tz",
+				Type:        "string",
+				Required:    false,
+				Description: "时区，如：UTC, Asia/Shanghai，默认本地时区",
+			},
+		},
+	}
+
+	return &WeekdayBuiltin{
+		BaseBuiltin: NewBaseBuiltin("weekday", "返回当前时间在指定时区的星期（0=周日，1=周一，…，6=周六）", signature),
+	}
+}
+
+// Execute 执行weekday函数
+func (f *WeekdayBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	var tz interface{}
+	if len(args) > 0 {
+		tz = args[0]
+	}
+	loc, err := toLocation(tz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid weekday tz: %w", err)
+	}
+
+	return float64(Now(ctx).In(loc).Weekday()), nil
+}
@@ -0,0 +1,82 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// withinState within 函数的求值状态
+type withinState struct {
+	LastTrue time.Time `json:"last_true"` // 条件最近一次成立的时间
+}
+
+// WithinBuiltin within函数实现
+type WithinBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewWithinBuiltin 创建within函数
+func NewWithinBuiltin() *WithinBuiltin {
+	signature := Signature{
+		Name:        "within",
+		Description: "条件在最近指定时长内曾经成立时返回 true",
+		ReturnType:  "bool",
+		Args: []ArgInfo{
+			{
+				Name:        "condition",
+				Type:        "bool",
+				Required:    true,
+				Description: "需要检查的条件",
+			},
+			{
+				Name:        "duration",
+				Type:        "duration",
+				Required:    true,
+				Description: "回看时长，如：30s, 10m, 1h",
+			},
+		},
+	}
+
+	return &WithinBuiltin{
+		BaseBuiltin: NewBaseBuiltin("within", "条件在最近指定时长内曾经成立时返回 true", signature),
+	}
+}
+
+// Execute 执行within函数
+func (f *WithinBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	cond, err := toBool(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to within must be a condition: %w", err)
+	}
+
+	duration, err := toDuration(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid within duration: %w", err)
+	}
+
+	state, key, err := callState(ctx, f.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	var ws withinState
+	if _, err := state.Get(key, &ws); err != nil {
+		return nil, err
+	}
+
+	now := Now(ctx)
+	if cond {
+		ws.LastTrue = now
+		if err := state.Set(key, ws); err != nil {
+			return nil, err
+		}
+		return true, nil
+	}
+
+	return !ws.LastTrue.IsZero() && now.Sub(ws.LastTrue) <= duration, nil
+}
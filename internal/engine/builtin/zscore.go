@@ -0,0 +1,79 @@
+package builtin
+
+import (
+	"context"
+	"fmt"
+)
+
+// ZscoreBuiltin zscore函数实现
+type ZscoreBuiltin struct {
+	*BaseBuiltin
+}
+
+// NewZscoreBuiltin 创建zscore函数
+func NewZscoreBuiltin() *ZscoreBuiltin {
+	signature := Signature{
+		Name:        "zscore",
+		Description: "计算数值相对数据序列的标准分数 (value - mean) / stddev",
+		ReturnType:  "float64",
+		Args: []ArgInfo{
+			{
+				Name:        "value",
+				Type:        "number",
+				Required:    true,
+				Description: "需要评估的数值，如当前价格",
+			},
+			{
+				Name:        "path",
+				Type:        "string",
+				Required:    true,
+				Description: "数据路径，格式：kline.SYMBOL.field",
+			},
+			{
+				Name:        "interval",
+				Type:        "string",
+				Required:    true,
+				Description: "时间间隔，如：15m, 1h, 1d",
+			},
+			{
+				Name:        "limit",
+				Type:        "number",
+				Required:    true,
+				Description: "数据点数量",
+			},
+		},
+	}
+
+	return &ZscoreBuiltin{
+		BaseBuiltin: NewBaseBuiltin("zscore", "计算数值相对数据序列的标准分数", signature),
+	}
+}
+
+// Execute 执行zscore函数
+func (f *ZscoreBuiltin) Execute(ctx context.Context, args []interface{}, evaluator Evaluator) (interface{}, error) {
+	if err := f.ValidateArgs(args); err != nil {
+		return nil, err
+	}
+
+	value, err := toFloat64(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("first argument to zscore must be a number: %w", err)
+	}
+
+	series, err := toSeries(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("second argument to zscore must be a data array: %w", err)
+	}
+
+	if len(series) == 0 {
+		return nil, fmt.Errorf("zscore requires a non-empty series")
+	}
+
+	// 序列没有波动时无法标准化，视为与均值无偏离
+	sd := stddev(series)
+	if sd == 0 {
+		return 0.0, nil
+	}
+
+	return (value - mean(series)) / sd, nil
+}
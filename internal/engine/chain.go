@@ -0,0 +1,180 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"gorm.io/gorm"
+)
+
+// processChainOrders 处理开仓订单关联的平仓订单（bracket）
+// 开仓订单成交后激活 pending 平仓订单；开仓订单失败、取消或过期时取消 pending 平仓订单
+func (e *Engine) processChainOrders() error {
+	if _, err := e.cancelOrphanedChildren(); err != nil {
+		return err
+	}
+
+	parents, err := e.pendingParents()
+	if err != nil {
+		return err
+	}
+
+	accountParents := make(map[int64][]*model.FoxOrder)
+	for _, parent := range parents {
+		if e.Halted(parent.AccountID) {
+			continue
+		}
+		// 市价单提交成功即视为成交；算法订单结束时已按子订单成交情况处理
+		if parent.OrderType == "market" || parent.Algo != "" {
+			if _, err := e.armChildOrders(parent); err != nil {
+				log.Printf("激活订单 %d 的平仓订单时出错: %v", parent.ID, err)
+			}
+			continue
+		}
+		accountParents[parent.AccountID] = append(accountParents[parent.AccountID], parent)
+	}
+
+	// 限价单、ioc、fok 需确认交易所订单有成交（ioc、fok 可能未成交即被撤销）
+	for accountID, orderList := range accountParents {
+		account, err := database.Adapter().FoxAccount.Where(database.Adapter().FoxAccount.ID.Eq(accountID)).First()
+		if err != nil {
+			log.Printf("获取账户 %d 失败: %v", accountID, err)
+			continue
+		}
+
+		exchangeInstance, err := e.exchangeMgr.GetExchange(account.Exchange)
+		if err != nil {
+			log.Printf("获取交易所 %s 失败: %v", account.Exchange, err)
+			continue
+		}
+		if err := exchangeInstance.Connect(e.ctx, account); err != nil {
+			log.Printf("连接账户 %d 到交易所失败: %v", accountID, err)
+			continue
+		}
+
+		for _, parent := range orderList {
+			if err := e.armFilledChildOrders(exchangeInstance, parent); err != nil {
+				log.Printf("激活订单 %d 的平仓订单时出错: %v", parent.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// pendingParents 获取已提交到交易所且仍有 pending 平仓订单的开仓订单
+func (e *Engine) pendingParents() ([]*model.FoxOrder, error) {
+	q := database.Adapter().FoxOrder
+	parents, err := q.Where(
+		q.Status.Eq("opened"),
+		q.Type.Eq("open"),
+		q.Columns(q.ID).In(q.Select(q.ParentID).Where(q.Status.Eq("pending"), q.ParentID.Gt(0))),
+	).Find()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to get pending parent orders: %w", err)
+	}
+	return parents, nil
+}
+
+// armFilledChildOrders 按交易所中开仓挂单的最终状态处理平仓订单
+// 有成交（含追价撤销的委托上的成交）时激活平仓订单；撤销且无成交时开仓订单置为 cancelled 并取消平仓订单；仍在挂单中时等待
+func (e *Engine) armFilledChildOrders(exchangeInstance exchange.Exchange, parent *model.FoxOrder) error {
+	exchangeOrder, err := exchangeInstance.GetOrder(e.ctx, parent.Symbol, parent.OrderID)
+	if err != nil && !errors.Is(err, exchange.ErrOrderNotFound) {
+		return fmt.Errorf("failed to get order: %w", err)
+	}
+
+	switch {
+	case orderFilled(parent, exchangeOrder).IsPositive() || (exchangeOrder != nil && exchangeOrder.Status == exchange.OrderStatusFilled):
+		_, err = e.armChildOrders(parent)
+		return err
+	case exchangeOrder == nil:
+		// 交易所仅短期保留未成交即撤销的订单，查询不到视为撤销且无成交
+		return e.cancelUnfilledParent(parent, "交易所订单已撤销，未成交")
+	case exchangeOrder.Status == exchange.OrderStatusLive:
+		return nil
+	}
+
+	// 开仓挂单已撤销（手动撤单、post_only 被拒绝等）且没有成交
+	return e.cancelUnfilledParent(parent, fmt.Sprintf("交易所订单已结束（%s），未成交", exchangeOrder.Status))
+}
+
+// cancelUnfilledParent 将未成交即结束的开仓订单置为 cancelled，并取消其 pending 平仓订单
+func (e *Engine) cancelUnfilledParent(parent *model.FoxOrder, msg string) error {
+	parent.Status = "cancelled"
+	parent.Msg = msg
+	if err := database.Adapter().FoxOrder.Save(parent); err != nil {
+		return fmt.Errorf("failed to update order: %w", err)
+	}
+
+	_, err := e.cancelOrphanedChildren()
+	return err
+}
+
+// armChildOrders 激活开仓订单的 pending 平仓订单，返回激活的订单数量
+func (e *Engine) armChildOrders(parent *model.FoxOrder) (int64, error) {
+	q := database.Adapter().FoxOrder
+	info, err := q.Where(
+		q.ParentID.Eq(parent.ID),
+		q.Status.Eq("pending"),
+	).UpdateSimple(q.Status.Value("waiting"))
+	if err != nil {
+		return 0, fmt.Errorf("failed to arm child orders: %w", err)
+	}
+
+	if info.RowsAffected > 0 {
+		log.Printf("开仓订单已成交，激活平仓订单: ParentID=%d, Count=%d", parent.ID, info.RowsAffected)
+	}
+	return info.RowsAffected, nil
+}
+
+// cancelOrphanedChildren 取消开仓订单失败、取消或过期后仍为 pending 的平仓订单，返回取消的订单数量
+func (e *Engine) cancelOrphanedChildren() (int64, error) {
+	q := database.Adapter().FoxOrder
+	parents := q.Select(q.ID).Where(q.Status.In("failed", "cancelled", "expired"))
+	info, err := q.Where(
+		q.Status.Eq("pending"),
+		q.ParentID.Gt(0),
+		q.Columns(q.ParentID).In(parents),
+	).UpdateSimple(
+		q.Status.Value("cancelled"),
+		q.Msg.Value("开仓订单未成交，平仓订单已取消"),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel orphaned child orders: %w", err)
+	}
+
+	if info.RowsAffected > 0 {
+		log.Printf("开仓订单未成交，取消平仓订单: %d 个", info.RowsAffected)
+	}
+	return info.RowsAffected, nil
+}
+
+// cancelSiblingOrders 平仓订单触发后取消同一开仓订单的其余平仓订单（OCO），返回取消的订单数量
+func (e *Engine) cancelSiblingOrders(order *model.FoxOrder) (int64, error) {
+	if order.ParentID == 0 {
+		return 0, nil
+	}
+
+	q := database.Adapter().FoxOrder
+	info, err := q.Where(
+		q.ParentID.Eq(order.ParentID),
+		q.ID.Neq(order.ID),
+		q.Status.In("pending", "waiting", "paused"),
+	).UpdateSimple(
+		q.Status.Value("cancelled"),
+		q.Msg.Value(fmt.Sprintf("OCO: 平仓订单 %d 已触发", order.ID)),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to cancel sibling orders: %w", err)
+	}
+
+	if info.RowsAffected > 0 {
+		log.Printf("OCO 取消平仓订单: ParentID=%d, Count=%d", order.ParentID, info.RowsAffected)
+	}
+	return info.RowsAffected, nil
+}
@@ -0,0 +1,163 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+// mockCloseExchange 模拟交易所，仅记录平仓请求
+type mockCloseExchange struct {
+	exchange.Exchange
+	closed int
+}
+
+func (m *mockCloseExchange) ClosePosition(ctx context.Context, position *exchange.ClosePosition) error {
+	m.closed++
+	return nil
+}
+
+// mockOrderStateExchange 模拟交易所，按客户自定义订单ID返回订单最终状态
+type mockOrderStateExchange struct {
+	exchange.Exchange
+	orders map[string]*exchange.Order
+}
+
+func (m *mockOrderStateExchange) GetOrder(ctx context.Context, symbol string, clientOrderID string) (*exchange.Order, error) {
+	order, ok := m.orders[clientOrderID]
+	if !ok {
+		return nil, exchange.ErrOrderNotFound
+	}
+	return order, nil
+}
+
+func createTestCloseOrder(t *testing.T, parentID int64, status string) *model.FoxOrder {
+	t.Helper()
+
+	order := createTestOrder(t, &model.FoxOrder{Status: status, ParentID: parentID})
+	order.Type = "close"
+	order.Side = "sell"
+	if err := database.Adapter().FoxOrder.Save(order); err != nil {
+		t.Fatalf("Failed to update order: %v", err)
+	}
+	return order
+}
+
+func TestEngine_ProcessChainOrders(t *testing.T) {
+	initTestDB(t)
+
+	opened := createTestOrder(t, &model.FoxOrder{OrderID: "cl1", Status: "opened"})
+	armed := createTestCloseOrder(t, opened.ID, "pending")
+	waiting := createTestOrder(t, &model.FoxOrder{OrderID: "cl2", Status: "waiting"})
+	stillPending := createTestCloseOrder(t, waiting.ID, "pending")
+	failed := createTestOrder(t, &model.FoxOrder{OrderID: "cl3", Status: "failed"})
+	orphaned := createTestCloseOrder(t, failed.ID, "pending")
+	// ioc 提交成功不代表有成交，需经交易所确认（测试库中没有账户，跳过确认）
+	ioc := createTestOrder(t, &model.FoxOrder{OrderID: "cl4", OrderType: "ioc", Status: "opened"})
+	unconfirmed := createTestCloseOrder(t, ioc.ID, "pending")
+
+	e := &Engine{ctx: context.Background()}
+	if err := e.processChainOrders(); err != nil {
+		t.Fatalf("processChainOrders() error = %v", err)
+	}
+
+	for _, tt := range []struct {
+		order *model.FoxOrder
+		want  string
+	}{
+		{order: armed, want: "waiting"},
+		{order: stillPending, want: "pending"},
+		{order: orphaned, want: "cancelled"},
+		{order: unconfirmed, want: "pending"},
+	} {
+		got := getTestOrder(t, tt.order.ID)
+		if got.Status != tt.want {
+			t.Errorf("order %d status = %s, want %s", got.ID, got.Status, tt.want)
+		}
+	}
+}
+
+func TestEngine_ArmFilledChildOrders(t *testing.T) {
+	tests := []struct {
+		name        string
+		order       *exchange.Order // nil 表示交易所查询不到订单
+		chaseFilled string          // 追价撤销的委托上的累计成交张数
+		wantParent  string
+		wantChild   string
+		wantMessage string
+	}{
+		{name: "仍在挂单中", order: &exchange.Order{Status: "live", Remain: 5}, wantParent: "opened", wantChild: "pending"},
+		{name: "部分成交", order: &exchange.Order{Status: "partially_filled", Filled: 2, Remain: 3}, wantParent: "opened", wantChild: "waiting"},
+		{name: "完全成交", order: &exchange.Order{Status: "filled", Filled: 5}, wantParent: "opened", wantChild: "waiting"},
+		{name: "部分成交后撤销", order: &exchange.Order{Status: "canceled", Filled: 2}, wantParent: "opened", wantChild: "waiting"},
+		{name: "撤销且未成交", order: &exchange.Order{Status: "canceled"}, wantParent: "cancelled", wantChild: "cancelled", wantMessage: "未成交"},
+		{name: "查询不到订单", wantParent: "cancelled", wantChild: "cancelled", wantMessage: "未成交"},
+		{name: "追价前委托有成交，当前委托撤销未成交", order: &exchange.Order{Status: "canceled"}, chaseFilled: "2", wantParent: "opened", wantChild: "waiting"},
+		{name: "追价前委托有成交，查询不到当前委托", chaseFilled: "2", wantParent: "opened", wantChild: "waiting"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initTestDB(t)
+
+			parent := createTestOrder(t, &model.FoxOrder{OrderID: "cl1", OrderType: "limit", Status: "opened", ChaseFilled: tt.chaseFilled})
+			child := createTestCloseOrder(t, parent.ID, "pending")
+
+			mock := &mockOrderStateExchange{orders: map[string]*exchange.Order{}}
+			if tt.order != nil {
+				tt.order.OrderID = "cl1"
+				mock.orders["cl1"] = tt.order
+			}
+
+			e := &Engine{ctx: context.Background()}
+			if err := e.armFilledChildOrders(mock, parent); err != nil {
+				t.Fatalf("armFilledChildOrders() error = %v", err)
+			}
+
+			gotParent := getTestOrder(t, parent.ID)
+			if gotParent.Status != tt.wantParent || !strings.Contains(gotParent.Msg, tt.wantMessage) {
+				t.Errorf("parent = %s %q, want %s %q", gotParent.Status, gotParent.Msg, tt.wantParent, tt.wantMessage)
+			}
+			if got := getTestOrder(t, child.ID); got.Status != tt.wantChild {
+				t.Errorf("child status = %s, want %s", got.Status, tt.wantChild)
+			}
+		})
+	}
+}
+
+func TestEngine_SubmitCloseOrderCancelsSiblings(t *testing.T) {
+	initTestDB(t)
+
+	parent := createTestOrder(t, &model.FoxOrder{OrderID: "cl1", Status: "opened"})
+	takeProfit := createTestCloseOrder(t, parent.ID, "waiting")
+	stopLoss := createTestCloseOrder(t, parent.ID, "waiting")
+	other := createTestCloseOrder(t, 0, "waiting")
+
+	e := &Engine{ctx: context.Background()}
+	mock := &mockCloseExchange{}
+	if err := e.submitOrder(mock, takeProfit); err != nil {
+		t.Fatalf("submitOrder() error = %v", err)
+	}
+
+	if got := getTestOrder(t, takeProfit.ID); got.Status != "closed" {
+		t.Errorf("take profit status = %s, want closed", got.Status)
+	}
+	if got := getTestOrder(t, stopLoss.ID); got.Status != "cancelled" {
+		t.Errorf("stop loss status = %s, want cancelled", got.Status)
+	}
+	if got := getTestOrder(t, other.ID); got.Status != "waiting" {
+		t.Errorf("unrelated order status = %s, want waiting", got.Status)
+	}
+
+	// 同一周期内已加载的 OCO 订单不再平仓
+	if err := e.submitOrder(mock, stopLoss); err != nil {
+		t.Fatalf("submitOrder() error = %v", err)
+	}
+	if mock.closed != 1 {
+		t.Errorf("ClosePosition called %d times, want 1", mock.closed)
+	}
+}
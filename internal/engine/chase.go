@@ -0,0 +1,224 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// processChaseOrders 按账户处理追价中的挂单（已提交的开仓、平仓订单），未成交时按买一/卖一价撤单重下
+func (e *Engine) processChaseOrders() error {
+	q := database.Adapter().FoxOrder
+	orders, err := q.Where(
+		q.Status.In("opened", "closed"),
+		q.Chase.Eq("active"),
+		q.OrderType.In(restingOrderTypes...),
+	).Find()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to get chase orders: %w", err)
+	}
+
+	accountOrders := make(map[int64][]*model.FoxOrder)
+	for _, order := range orders {
+		if e.Halted(order.AccountID) {
+			continue
+		}
+		accountOrders[order.AccountID] = append(accountOrders[order.AccountID], order)
+	}
+
+	for accountID, orderList := range accountOrders {
+		account, err := database.Adapter().FoxAccount.Where(database.Adapter().FoxAccount.ID.Eq(accountID)).First()
+		if err != nil {
+			log.Printf("获取账户 %d 失败: %v", accountID, err)
+			continue
+		}
+
+		exchangeInstance, err := e.exchangeMgr.GetExchange(account.Exchange)
+		if err != nil {
+			log.Printf("获取交易所 %s 失败: %v", account.Exchange, err)
+			continue
+		}
+		if err := exchangeInstance.Connect(e.ctx, account); err != nil {
+			log.Printf("连接账户 %d 到交易所失败: %v", accountID, err)
+			continue
+		}
+
+		for _, order := range orderList {
+			if err := e.chaseOrder(exchangeInstance, order); err != nil {
+				log.Printf("追价订单 %d 时出错: %v", order.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// chaseOrder 挂单价格不在买一/卖一时撤单并按买一/卖一价重新下单，超过最大滑点时停止追价
+func (e *Engine) chaseOrder(exchangeInstance exchange.Exchange, order *model.FoxOrder) error {
+	pending, err := exchangeInstance.GetOrders(e.ctx, order.Symbol, "")
+	if err != nil {
+		return fmt.Errorf("failed to get pending orders: %w", err)
+	}
+
+	var exchangeOrder *exchange.Order
+	for i := range pending {
+		if pending[i].OrderID == order.OrderID {
+			exchangeOrder = &pending[i]
+			break
+		}
+	}
+
+	// 挂单已成交（或已在交易所撤销）时结束追价
+	if exchangeOrder == nil {
+		order.Chase = "done"
+		if err := database.Adapter().FoxOrder.Save(order); err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+		e.recordOrderHistory(order, "done", order.Price, "", "挂单已不在交易所未成交列表中，停止追价")
+		return nil
+	}
+
+	target, err := e.chasePrice(exchangeInstance, order)
+	if err != nil {
+		return err
+	}
+	current, _ := decimal.NewFromString(order.Price)
+	if target.Equal(current) {
+		return nil
+	}
+
+	if exceeded, limit := chaseSlippageExceeded(order, target); exceeded {
+		order.Chase = "stopped"
+		order.Msg = fmt.Sprintf("追价达到最大滑点 %s%%（首次委托价 %s，限制价格 %s，当前盘口 %s），停止追价", order.MaxSlippage, order.ChaseBase, limit.String(), target.String())
+		if err := database.Adapter().FoxOrder.Save(order); err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+		e.recordOrderHistory(order, "stop", order.Price, "", order.Msg)
+		log.Printf("追价停止: ID=%d, %s", order.ID, order.Msg)
+		return nil
+	}
+
+	remain := decimal.NewFromFloat(exchangeOrder.Remain)
+	if !remain.IsPositive() {
+		return nil
+	}
+
+	if err := exchangeInstance.CancelOrder(e.ctx, exchangeOrder); err != nil {
+		return fmt.Errorf("failed to cancel chase order: %w", err)
+	}
+
+	// 读取未成交列表到撤单完成之间可能有新的成交，按撤单后的实际成交数量计算需重新下单的数量
+	cancelled, err := exchangeInstance.GetOrder(e.ctx, order.Symbol, order.OrderID)
+	if err != nil {
+		order.Chase = "stopped"
+		order.Msg = fmt.Sprintf("追价撤单后获取成交数量失败，未重新下单: %v", err)
+		if err := database.Adapter().FoxOrder.Save(order); err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+		e.recordOrderHistory(order, "failed", order.Price, "", order.Msg)
+		return fmt.Errorf("failed to get cancelled chase order: %w", err)
+	}
+	size, err := decimal.NewFromString(cancelled.Size)
+	if err != nil {
+		return fmt.Errorf("invalid chase order size %q: %w", cancelled.Size, err)
+	}
+	remain = size.Sub(decimal.NewFromFloat(cancelled.Filled))
+	if !remain.IsPositive() {
+		order.Chase = "done"
+		if err := database.Adapter().FoxOrder.Save(order); err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+		e.recordOrderHistory(order, "done", order.Price, "", "撤单前挂单已完全成交，停止追价")
+		return nil
+	}
+
+	// 撤单后以新的客户自定义订单ID重新下单，订单记录指向新的委托；原委托的成交计入累计成交数量，供激活平仓订单和过期撤单使用
+	previous := order.Price
+	order.ChaseFilled = orderFilled(order, cancelled).String()
+	order.OrderID = exchangeInstance.GetClientOrderId(e.ctx)
+	_, err = exchangeInstance.CreateOrder(e.ctx, &exchange.Order{
+		OrderID:    order.OrderID,
+		Symbol:     order.Symbol,
+		Side:       order.Side,
+		PosSide:    order.PosSide,
+		MarginType: order.MarginType,
+		Price:      target.String(),
+		Size:       remain.String(),
+		Type:       order.OrderType,
+		ReduceOnly: order.Type == "close" && closeReduceOnly(order),
+	})
+	if err != nil {
+		order.Chase = "stopped"
+		order.Msg = fmt.Sprintf("追价重新下单失败，原挂单已撤销: %v", err)
+		if err := database.Adapter().FoxOrder.Save(order); err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+		e.recordOrderHistory(order, "failed", target.String(), remain.String(), order.Msg)
+		return fmt.Errorf("failed to re-place chase order: %w", err)
+	}
+
+	order.Price = target.String()
+	if err := database.Adapter().FoxOrder.Save(order); err != nil {
+		return fmt.Errorf("failed to update order: %w", err)
+	}
+	e.recordOrderHistory(order, "amend", order.Price, remain.String(), fmt.Sprintf("追价改单: %s -> %s", previous, order.Price))
+	log.Printf("追价改单: ID=%d, OrderID=%s, Price=%s -> %s, Size=%s", order.ID, order.OrderID, previous, order.Price, remain.String())
+	return nil
+}
+
+// orderFilled 计算订单的累计成交张数：追价撤销的各笔委托成交数量加上当前委托的成交数量
+func orderFilled(order *model.FoxOrder, exchangeOrder *exchange.Order) decimal.Decimal {
+	filled, _ := decimal.NewFromString(order.ChaseFilled)
+	if exchangeOrder != nil {
+		filled = filled.Add(decimal.NewFromFloat(exchangeOrder.Filled))
+	}
+	return filled
+}
+
+// chasePrice 获取追价目标价格：买单取买一价，卖单取卖一价
+func (e *Engine) chasePrice(exchangeInstance exchange.Exchange, order *model.FoxOrder) (decimal.Decimal, error) {
+	book, err := exchangeInstance.GetOrderBook(e.ctx, order.Symbol, 1)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get order book: %w", err)
+	}
+
+	levels := book.Bids
+	if order.Side == "sell" {
+		levels = book.Asks
+	}
+	if len(levels) == 0 {
+		return decimal.Zero, fmt.Errorf("order book of %s is empty", order.Symbol)
+	}
+
+	price, err := decimal.NewFromString(levels[0].Price)
+	if err != nil || !price.IsPositive() {
+		return decimal.Zero, fmt.Errorf("invalid top of book price: %s", levels[0].Price)
+	}
+	return price, nil
+}
+
+// chaseSlippageExceeded 判断追价目标价格是否超过最大滑点，返回是否超过及限制价格（买单向上、卖单向下）
+func chaseSlippageExceeded(order *model.FoxOrder, target decimal.Decimal) (bool, decimal.Decimal) {
+	slippage, err := decimal.NewFromString(order.MaxSlippage)
+	if err != nil || !slippage.IsPositive() {
+		return false, decimal.Zero
+	}
+	base, err := decimal.NewFromString(order.ChaseBase)
+	if err != nil || !base.IsPositive() {
+		return false, decimal.Zero
+	}
+
+	ratio := slippage.Div(decimal.NewFromInt(100))
+	if order.Side == "sell" {
+		limit := base.Mul(decimal.NewFromInt(1).Sub(ratio))
+		return target.LessThan(limit), limit
+	}
+	limit := base.Mul(decimal.NewFromInt(1).Add(ratio))
+	return target.GreaterThan(limit), limit
+}
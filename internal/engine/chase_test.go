@@ -0,0 +1,159 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+// mockChaseExchange 模拟交易所，pending 为未成交挂单，book 为买一/卖一盘口，fillOnCancel 为撤单过程中新成交的张数
+type mockChaseExchange struct {
+	exchange.Exchange
+	pending      []exchange.Order
+	book         exchange.OrderBook
+	fillOnCancel float64
+	cancelled    []exchange.Order
+	created      []exchange.Order
+	ids          int
+}
+
+func (m *mockChaseExchange) GetOrders(ctx context.Context, symbol string, status string) ([]exchange.Order, error) {
+	return m.pending, nil
+}
+
+func (m *mockChaseExchange) GetOrderBook(ctx context.Context, symbol string, depth int) (*exchange.OrderBook, error) {
+	return &m.book, nil
+}
+
+func (m *mockChaseExchange) CancelOrder(ctx context.Context, order *exchange.Order) error {
+	cancelled := *order
+	cancelled.Status = exchange.OrderStatusCanceled
+	cancelled.Filled += m.fillOnCancel
+	cancelled.Remain = 0
+	m.cancelled = append(m.cancelled, cancelled)
+	return nil
+}
+
+func (m *mockChaseExchange) GetOrder(ctx context.Context, symbol string, clientOrderID string) (*exchange.Order, error) {
+	for i := range m.cancelled {
+		if m.cancelled[i].OrderID == clientOrderID {
+			return &m.cancelled[i], nil
+		}
+	}
+	return nil, exchange.ErrOrderNotFound
+}
+
+func (m *mockChaseExchange) CreateOrder(ctx context.Context, order *exchange.Order) (*exchange.Order, error) {
+	m.created = append(m.created, *order)
+	return order, nil
+}
+
+func (m *mockChaseExchange) GetClientOrderId(ctx context.Context) string {
+	m.ids++
+	return fmt.Sprintf("FOXCHASE%d", m.ids)
+}
+
+func getTestOrderHistory(t *testing.T, orderID int64) []*model.FoxOrderHistory {
+	t.Helper()
+
+	hq := database.Adapter().FoxOrderHistory
+	histories, err := hq.Where(hq.OrderID.Eq(orderID)).Order(hq.ID).Find()
+	if err != nil {
+		t.Fatalf("Failed to get order history: %v", err)
+	}
+	return histories
+}
+
+func TestEngine_ChaseOrder(t *testing.T) {
+	tests := []struct {
+		name          string
+		side          string
+		orderType     string
+		price         string
+		pending       bool
+		fillOnCancel  float64
+		bid           string
+		ask           string
+		wantChase     string
+		wantPrice     string
+		wantCancelled bool
+		wantSize      string // 重新下单的张数，为空表示不重新下单
+		wantAction    string
+	}{
+		{name: "买单追买一价", side: "buy", price: "100", pending: true, bid: "100.3", ask: "100.4", wantChase: "active", wantPrice: "100.3", wantCancelled: true, wantSize: "3", wantAction: "amend"},
+		{name: "卖单追卖一价", side: "sell", orderType: "close", price: "100", pending: true, bid: "99.6", ask: "99.7", wantChase: "active", wantPrice: "99.7", wantCancelled: true, wantSize: "3", wantAction: "amend"},
+		{name: "撤单时部分成交", side: "sell", orderType: "close", price: "100", pending: true, fillOnCancel: 2, bid: "99.6", ask: "99.7", wantChase: "active", wantPrice: "99.7", wantCancelled: true, wantSize: "1", wantAction: "amend"},
+		{name: "撤单时完全成交", side: "buy", price: "100", pending: true, fillOnCancel: 3, bid: "100.3", ask: "100.4", wantChase: "done", wantPrice: "100", wantCancelled: true, wantAction: "done"},
+		{name: "价格未变化", side: "buy", price: "100", pending: true, bid: "100", ask: "100.1", wantChase: "active", wantPrice: "100"},
+		{name: "超过最大滑点", side: "buy", price: "100.3", pending: true, bid: "100.6", ask: "100.7", wantChase: "stopped", wantPrice: "100.3", wantAction: "stop"},
+		{name: "挂单已成交", side: "buy", price: "100", bid: "101", ask: "101.1", wantChase: "done", wantPrice: "100", wantAction: "done"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initTestDB(t)
+
+			order := createTestOrder(t, &model.FoxOrder{OrderID: "FOX1", Status: "opened", OrderType: "limit", Price: tt.price, Chase: "active", MaxSlippage: "0.5", ChaseBase: "100"})
+			order.Side = tt.side
+			if tt.orderType != "" {
+				order.Type = tt.orderType
+				order.ReduceOnly = 1
+			}
+
+			mock := &mockChaseExchange{fillOnCancel: tt.fillOnCancel, book: exchange.OrderBook{
+				Bids: []exchange.OrderBookLevel{{Price: tt.bid, Size: "10"}},
+				Asks: []exchange.OrderBookLevel{{Price: tt.ask, Size: "10"}},
+			}}
+			if tt.pending {
+				mock.pending = []exchange.Order{{ID: "1", OrderID: "FOX1", Price: tt.price, Size: "5", Filled: 2, Remain: 3}}
+			}
+
+			e := &Engine{ctx: context.Background()}
+			if err := e.chaseOrder(mock, order); err != nil {
+				t.Fatalf("chaseOrder() error = %v", err)
+			}
+
+			got := getTestOrder(t, order.ID)
+			if got.Chase != tt.wantChase || got.Price != tt.wantPrice {
+				t.Errorf("order chase = %s price = %s, want %s %s", got.Chase, got.Price, tt.wantChase, tt.wantPrice)
+			}
+
+			if tt.wantCancelled != (len(mock.cancelled) == 1 && mock.cancelled[0].OrderID == "FOX1") {
+				t.Errorf("撤销的挂单 = %+v, want cancelled %v", mock.cancelled, tt.wantCancelled)
+			}
+			if tt.wantSize != "" {
+				if len(mock.created) != 1 {
+					t.Fatalf("CreateOrder called %d times, want 1", len(mock.created))
+				}
+				created := mock.created[0]
+				if created.Price != tt.wantPrice || created.Size != tt.wantSize || created.Type != "limit" || created.ReduceOnly != (tt.orderType == "close") {
+					t.Errorf("重新下单 = %+v, want limit %s x %s", created, tt.wantPrice, tt.wantSize)
+				}
+				if got.OrderID != created.OrderID || got.OrderID == "FOX1" {
+					t.Errorf("order id = %s, want new client order id %s", got.OrderID, created.OrderID)
+				}
+				// 被撤销委托的成交计入累计成交数量（挂单已成交 2 张，加上撤单过程中的成交）
+				if want := fmt.Sprint(2 + tt.fillOnCancel); got.ChaseFilled != want {
+					t.Errorf("chase filled = %s, want %s", got.ChaseFilled, want)
+				}
+			} else if len(mock.created) != 0 {
+				t.Errorf("created = %+v, want none", mock.created)
+			}
+
+			histories := getTestOrderHistory(t, order.ID)
+			if tt.wantAction == "" {
+				if len(histories) != 0 {
+					t.Errorf("order history = %+v, want none", histories)
+				}
+				return
+			}
+			if len(histories) != 1 || histories[0].Action != tt.wantAction || histories[0].ClientOrderID != got.OrderID {
+				t.Errorf("order history = %+v, want one %s", histories, tt.wantAction)
+			}
+		})
+	}
+}
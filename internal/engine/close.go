@@ -0,0 +1,131 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/shopspring/decimal"
+)
+
+// isPartialClose 判断平仓订单是否只平掉部分仓位（未指定数量或 100% 时平掉全部仓位）
+func isPartialClose(order *model.FoxOrder) bool {
+	size, err := decimal.NewFromString(order.Size)
+	if err != nil || !size.IsPositive() {
+		return false
+	}
+	return order.SizeType != "percent" || size.LessThan(decimal.NewFromInt(100))
+}
+
+// closeReduceOnly 判断平仓订单是否以只减仓方式提交：指定 reduce_only 或部分平仓时只减仓
+func closeReduceOnly(order *model.FoxOrder) bool {
+	return order.ReduceOnly == 1 || isPartialClose(order)
+}
+
+// closePosition 平仓，部分平仓或指定限价时以只减仓订单提交
+func (e *Engine) closePosition(exchangeInstance exchange.Exchange, order *model.FoxOrder) error {
+	closePosition := &exchange.ClosePosition{
+		Symbol:  order.Symbol,
+		Margin:  order.MarginType,
+		PosSide: order.PosSide,
+	}
+	market := order.OrderType == "market"
+	if market && !isPartialClose(order) {
+		return exchangeInstance.ClosePosition(e.ctx, closePosition)
+	}
+
+	positions, err := exchangeInstance.GetPositions(e.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get positions: %w", err)
+	}
+
+	positionSize := decimal.Zero
+	for _, position := range positions {
+		if strings.EqualFold(position.Symbol, order.Symbol) && position.PosSide == order.PosSide && position.MarginType == order.MarginType {
+			positionSize, _ = decimal.NewFromString(position.Size)
+			positionSize = positionSize.Abs()
+			break
+		}
+	}
+	if !positionSize.IsPositive() {
+		return fmt.Errorf("无可平仓位: %s %s %s", order.Symbol, order.PosSide, order.MarginType)
+	}
+
+	contracts := positionSize
+	if isPartialClose(order) {
+		var err error
+		contracts, err = e.closeContracts(exchangeInstance, order, positionSize)
+		if err != nil {
+			return err
+		}
+	}
+
+	// 市价平仓数量不小于持仓数量时平掉全部仓位，限价平仓最多委托持仓数量
+	if contracts.GreaterThanOrEqual(positionSize) {
+		if market {
+			return exchangeInstance.ClosePosition(e.ctx, closePosition)
+		}
+		contracts = positionSize
+	}
+
+	_, err = exchangeInstance.CreateOrder(e.ctx, &exchange.Order{
+		OrderID:    order.OrderID,
+		Symbol:     order.Symbol,
+		Side:       order.Side,
+		PosSide:    order.PosSide,
+		MarginType: order.MarginType,
+		Price:      order.Price,
+		Size:       contracts.String(),
+		Type:       order.OrderType,
+		ReduceOnly: closeReduceOnly(order),
+	})
+	return err
+}
+
+// closeContracts 计算部分平仓的张数，按下单数量精度向下取整，不足最小下单数量时返回错误
+func (e *Engine) closeContracts(exchangeInstance exchange.Exchange, order *model.FoxOrder, positionSize decimal.Decimal) (decimal.Decimal, error) {
+	size, err := decimal.NewFromString(order.Size)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to parse close size: %w", err)
+	}
+
+	var contracts decimal.Decimal
+	if order.SizeType == "percent" {
+		contracts = positionSize.Mul(size).Div(decimal.NewFromInt(100))
+	} else {
+		cost, err := exchangeInstance.CalcOrderCost(e.ctx, &exchange.OrderCostReq{
+			Side:       order.Side,
+			Symbol:     order.Symbol,
+			Amount:     order.Size,
+			AmountType: order.SizeType,
+			MarginType: order.MarginType,
+		})
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("failed to calc close contracts: %w", err)
+		}
+		contracts, err = decimal.NewFromString(cost.Contracts)
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("failed to parse close contracts: %w", err)
+		}
+	}
+
+	symbol, err := exchangeInstance.GetSymbols(e.ctx, order.Symbol)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get symbol info: %w", err)
+	}
+	// 未提供下单数量精度时按最小下单数量取整
+	lotSize, err := decimal.NewFromString(symbol.LotSize)
+	if err != nil || !lotSize.IsPositive() {
+		lotSize, _ = decimal.NewFromString(symbol.MinSize)
+	}
+	if lotSize.IsPositive() {
+		contracts = contracts.Div(lotSize).Floor().Mul(lotSize)
+	}
+
+	minSize, _ := decimal.NewFromString(symbol.MinSize)
+	if !contracts.IsPositive() || contracts.LessThan(minSize) {
+		return decimal.Zero, fmt.Errorf("平仓数量 %s 小于最小下单数量: %s", contracts.String(), symbol.MinSize)
+	}
+	return contracts, nil
+}
@@ -0,0 +1,120 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+// mockPartialCloseExchange 模拟交易所，记录部分平仓与全部平仓请求
+type mockPartialCloseExchange struct {
+	exchange.Exchange
+	positions []exchange.Position
+	contracts string
+	created   []exchange.Order
+	closed    int
+}
+
+func (m *mockPartialCloseExchange) GetPositions(ctx context.Context) ([]exchange.Position, error) {
+	return m.positions, nil
+}
+
+func (m *mockPartialCloseExchange) GetSymbols(ctx context.Context, symbol string) (*exchange.Symbol, error) {
+	return &exchange.Symbol{Name: symbol, MinSize: "1", LotSize: "0.1"}, nil
+}
+
+func (m *mockPartialCloseExchange) CalcOrderCost(ctx context.Context, req *exchange.OrderCostReq) (*exchange.OrderCostResp, error) {
+	return &exchange.OrderCostResp{Contracts: m.contracts}, nil
+}
+
+func (m *mockPartialCloseExchange) CreateOrder(ctx context.Context, order *exchange.Order) (*exchange.Order, error) {
+	m.created = append(m.created, *order)
+	return order, nil
+}
+
+func (m *mockPartialCloseExchange) ClosePosition(ctx context.Context, position *exchange.ClosePosition) error {
+	m.closed++
+	return nil
+}
+
+func TestEngine_ClosePosition(t *testing.T) {
+	positions := []exchange.Position{
+		{Symbol: "BTC-USDT-SWAP", PosSide: "long", MarginType: "isolated", Size: "10"},
+		{Symbol: "BTC-USDT-SWAP", PosSide: "long", MarginType: "cross", Size: "3"},
+	}
+
+	tests := []struct {
+		name        string
+		size        string
+		sizeType    string
+		orderType   string
+		price       string
+		reduceOnly  int64
+		positions   []exchange.Position
+		contracts   string
+		wantCreated string
+		wantClosed  int
+		wantReduce  bool
+		wantErr     bool
+	}{
+		{name: "全部仓位", size: "0", positions: positions, wantClosed: 1},
+		{name: "百分之百", size: "100", sizeType: "percent", positions: positions, wantClosed: 1},
+		{name: "百分比", size: "50", sizeType: "percent", positions: positions, wantCreated: "5", wantReduce: true},
+		{name: "按下单数量精度向下取整", size: "33.3", sizeType: "percent", positions: positions, wantCreated: "3.3", wantReduce: true},
+		{name: "USDT 金额", size: "200", sizeType: "USDT", positions: positions, contracts: "2.75", wantCreated: "2.7", wantReduce: true},
+		{name: "超过持仓数量", size: "0.5", positions: positions, contracts: "12", wantClosed: 1},
+		{name: "小于最小下单数量", size: "5", sizeType: "percent", positions: positions, wantErr: true},
+		{name: "无持仓", size: "50", sizeType: "percent", wantErr: true},
+		{name: "限价全部平仓", size: "0", orderType: "post_only", price: "70000", positions: positions, wantCreated: "10"},
+		{name: "限价全部平仓只减仓", size: "0", orderType: "post_only", price: "70000", reduceOnly: 1, positions: positions, wantCreated: "10", wantReduce: true},
+		{name: "限价部分平仓", size: "50", sizeType: "percent", orderType: "ioc", price: "70000", positions: positions, wantCreated: "5", wantReduce: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &mockPartialCloseExchange{positions: tt.positions, contracts: tt.contracts}
+			orderType := tt.orderType
+			if orderType == "" {
+				orderType = "market"
+			}
+			order := &model.FoxOrder{
+				OrderID:    "cl1",
+				Symbol:     "BTC-USDT-SWAP",
+				Side:       "sell",
+				PosSide:    "long",
+				MarginType: "isolated",
+				Size:       tt.size,
+				SizeType:   tt.sizeType,
+				OrderType:  orderType,
+				Price:      tt.price,
+				Type:       "close",
+				ReduceOnly: tt.reduceOnly,
+			}
+
+			e := &Engine{ctx: context.Background()}
+			err := e.closePosition(mock, order)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("closePosition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if mock.closed != tt.wantClosed {
+				t.Errorf("ClosePosition called %d times, want %d", mock.closed, tt.wantClosed)
+			}
+
+			if tt.wantCreated == "" {
+				if len(mock.created) != 0 {
+					t.Errorf("CreateOrder called with %+v, want none", mock.created)
+				}
+				return
+			}
+			if len(mock.created) != 1 {
+				t.Fatalf("CreateOrder called %d times, want 1", len(mock.created))
+			}
+			created := mock.created[0]
+			if created.Size != tt.wantCreated || created.ReduceOnly != tt.wantReduce || created.Type != orderType || created.Price != tt.price {
+				t.Errorf("CreateOrder = %+v, want %s order of %s, reduce-only %v", created, orderType, tt.wantCreated, tt.wantReduce)
+			}
+		})
+	}
+}
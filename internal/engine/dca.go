@@ -0,0 +1,153 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/engine/builtin"
+	"github.com/lemconn/foxflow/internal/engine/provider"
+	"github.com/lemconn/foxflow/internal/engine/schedule"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/lemconn/foxflow/internal/pkg/dao/query"
+	"gorm.io/gorm"
+)
+
+// processDcaPlans 按账户执行到期的定投计划，创建的开仓订单由本周期后续的策略检查提交
+// 引擎停止、暂停期间错过的定投不补做，到期后只执行一次并按 cron 表达式计算下次执行时间
+func (e *Engine) processDcaPlans(now time.Time) error {
+	q := database.Adapter().FoxDcaPlan
+	plans, err := q.Where(
+		q.Status.Eq("active"),
+		q.NextRunAt.Lte(now.Unix()),
+	).Find()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("failed to get dca plans: %w", err)
+	}
+
+	accountPlans := make(map[int64][]*model.FoxDcaPlan)
+	for _, plan := range plans {
+		if e.Halted(plan.AccountID) {
+			continue
+		}
+		accountPlans[plan.AccountID] = append(accountPlans[plan.AccountID], plan)
+	}
+
+	for accountID, planList := range accountPlans {
+		account, err := database.Adapter().FoxAccount.Where(database.Adapter().FoxAccount.ID.Eq(accountID)).First()
+		if err != nil {
+			log.Printf("获取账户 %d 失败: %v", accountID, err)
+			continue
+		}
+
+		exchangeInstance, err := e.exchangeMgr.GetExchange(account.Exchange)
+		if err != nil {
+			log.Printf("获取交易所 %s 失败: %v", account.Exchange, err)
+			continue
+		}
+		if err := exchangeInstance.Connect(e.ctx, account); err != nil {
+			log.Printf("连接账户 %d 到交易所失败: %v", accountID, err)
+			continue
+		}
+
+		// 执行条件中的账户、持仓数据使用定投计划所属账户的凭证获取
+		ctx := provider.WithAccount(e.ctx, account)
+		for _, plan := range planList {
+			if err := e.runDcaPlan(ctx, exchangeInstance, plan, now); err != nil {
+				log.Printf("执行定投计划 %d 时出错: %v", plan.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// runDcaPlan 执行一次定投：执行条件满足时创建市价开仓订单，否则跳过，并更新下次执行时间
+func (e *Engine) runDcaPlan(ctx context.Context, exchangeInstance exchange.Exchange, plan *model.FoxDcaPlan, now time.Time) error {
+	cron, err := schedule.Parse(plan.Schedule)
+	if err != nil {
+		plan.Status = "cancelled"
+		plan.Msg = fmt.Sprintf("cron 表达式无效，定投计划已取消: %v", err)
+		if err := database.Adapter().FoxDcaPlan.Save(plan); err != nil {
+			return fmt.Errorf("failed to update dca plan: %w", err)
+		}
+		return err
+	}
+
+	plan.LastRunAt = now.Unix()
+	if next := cron.Next(now); next.IsZero() {
+		plan.Status = "cancelled"
+	} else {
+		plan.NextRunAt = next.Unix()
+	}
+
+	if plan.Guard != "" {
+		ok, err := e.evaluateGuard(ctx, plan.Guard, now)
+		if err != nil || !ok {
+			plan.Skips++
+			plan.Msg = "执行条件不满足，跳过本次定投"
+			if err != nil {
+				plan.Msg = fmt.Sprintf("执行条件求值失败，跳过本次定投: %v", err)
+			}
+			if err := database.Adapter().FoxDcaPlan.Save(plan); err != nil {
+				return fmt.Errorf("failed to update dca plan: %w", err)
+			}
+			log.Printf("定投跳过: ID=%d, Guard=%s, %s", plan.ID, plan.Guard, plan.Msg)
+			return nil
+		}
+	}
+
+	side := "buy"
+	if plan.PosSide == "short" {
+		side = "sell"
+	}
+	order := &model.FoxOrder{
+		OrderID:    exchangeInstance.GetClientOrderId(ctx),
+		Exchange:   plan.Exchange,
+		AccountID:  plan.AccountID,
+		Symbol:     plan.Symbol,
+		Side:       side,
+		PosSide:    plan.PosSide,
+		MarginType: plan.MarginType,
+		Size:       plan.Amount,
+		SizeType:   plan.AmountType,
+		OrderType:  "market",
+		Type:       "open",
+		Status:     "waiting",
+		Msg:        fmt.Sprintf("定投计划 %d 第 %d 次定投", plan.ID, plan.Runs+1),
+	}
+
+	err = database.Adapter().Transaction(func(tx *query.Query) error {
+		if err := tx.FoxOrder.Create(order); err != nil {
+			return err
+		}
+		plan.Runs++
+		plan.LastOrderID = order.ID
+		plan.Msg = fmt.Sprintf("已创建订单 %d", order.ID)
+		return tx.FoxDcaPlan.Save(plan)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create dca order: %w", err)
+	}
+	log.Printf("定投下单: ID=%d, OrderID=%d, Symbol=%s, Amount=%s%s", plan.ID, order.ID, plan.Symbol, plan.Amount, plan.AmountType)
+	return nil
+}
+
+// evaluateGuard 对定投执行条件求值，每次执行独立求值（创建计划时已拒绝依赖跨周期状态的 hold、within）
+func (e *Engine) evaluateGuard(ctx context.Context, guard string, now time.Time) (bool, error) {
+	node, err := e.syntaxEngine.Parse(guard)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse guard syntax: %w", err)
+	}
+	if err := e.syntaxEngine.GetEvaluator().Validate(node); err != nil {
+		return false, fmt.Errorf("failed to validate AST: %w", err)
+	}
+
+	ctx = builtin.WithNow(builtin.WithState(ctx, builtin.NewState()), now)
+	ctx = builtin.WithRegexCache(ctx, builtin.NewRegexCache())
+	return e.syntaxEngine.ExecuteToBool(ctx, node)
+}
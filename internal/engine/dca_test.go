@@ -0,0 +1,100 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/engine/syntax"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+// mockDcaExchange 模拟交易所，仅生成客户自定义订单ID
+type mockDcaExchange struct {
+	exchange.Exchange
+}
+
+func (m *mockDcaExchange) GetClientOrderId(ctx context.Context) string {
+	return "FOXDCA"
+}
+
+func TestEngine_RunDcaPlan(t *testing.T) {
+	// 2025-07-02 为周三
+	now := time.Date(2025, 7, 2, 9, 0, 10, 0, time.Local)
+	nextMonday := time.Date(2025, 7, 7, 9, 0, 0, 0, time.Local).Unix()
+
+	tests := []struct {
+		name       string
+		schedule   string
+		guard      string
+		wantStatus string
+		wantNext   int64
+		wantRuns   int64
+		wantSkips  int64
+	}{
+		{name: "无执行条件", schedule: "0 9 * * MON", wantStatus: "active", wantNext: nextMonday, wantRuns: 1},
+		{name: "执行条件满足", schedule: "0 9 * * MON", guard: "2 > 1", wantStatus: "active", wantNext: nextMonday, wantRuns: 1},
+		{name: "执行条件不满足", schedule: "0 9 * * MON", guard: "1 > 2", wantStatus: "active", wantNext: nextMonday, wantSkips: 1},
+		{name: "cron 表达式无效", schedule: "0 9 * *", wantStatus: "cancelled", wantNext: now.Unix()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			initTestDB(t)
+
+			plan := &model.FoxDcaPlan{
+				AccountID:  1,
+				Exchange:   "okx",
+				Symbol:     "BTC-USDT-SWAP",
+				PosSide:    "long",
+				MarginType: "cross",
+				Amount:     "50",
+				AmountType: "USDT",
+				Schedule:   tt.schedule,
+				Guard:      tt.guard,
+				Status:     "active",
+				NextRunAt:  now.Unix(),
+			}
+			if err := database.Adapter().FoxDcaPlan.Create(plan); err != nil {
+				t.Fatalf("Failed to create dca plan: %v", err)
+			}
+
+			e := &Engine{ctx: context.Background(), syntaxEngine: syntax.NewEngine()}
+			err := e.runDcaPlan(context.Background(), &mockDcaExchange{}, plan, now)
+			if (err != nil) != (tt.wantStatus == "cancelled") {
+				t.Fatalf("runDcaPlan() error = %v", err)
+			}
+
+			pq := database.Adapter().FoxDcaPlan
+			got, err := pq.Where(pq.ID.Eq(plan.ID)).First()
+			if err != nil {
+				t.Fatalf("Failed to get dca plan: %v", err)
+			}
+			if got.Status != tt.wantStatus || got.NextRunAt != tt.wantNext || got.Runs != tt.wantRuns || got.Skips != tt.wantSkips {
+				t.Errorf("plan = %s next=%d runs=%d skips=%d, want %s next=%d runs=%d skips=%d",
+					got.Status, got.NextRunAt, got.Runs, got.Skips, tt.wantStatus, tt.wantNext, tt.wantRuns, tt.wantSkips)
+			}
+
+			orders, err := database.Adapter().FoxOrder.Find()
+			if err != nil {
+				t.Fatalf("Failed to get orders: %v", err)
+			}
+			if int64(len(orders)) != tt.wantRuns {
+				t.Fatalf("created %d orders, want %d", len(orders), tt.wantRuns)
+			}
+			if tt.wantRuns == 0 {
+				return
+			}
+			order := orders[0]
+			if order.Status != "waiting" || order.Type != "open" || order.OrderType != "market" || order.Side != "buy" ||
+				order.Size != "50" || order.SizeType != "USDT" || order.MarginType != "cross" || order.Strategy != "" {
+				t.Errorf("order = %+v, want waiting market open order of 50 USDT", order)
+			}
+			if got.LastOrderID != order.ID || got.LastRunAt != now.Unix() {
+				t.Errorf("plan last_order_id = %d last_run_at = %d, want %d %d", got.LastOrderID, got.LastRunAt, order.ID, now.Unix())
+			}
+		})
+	}
+}
@@ -8,11 +8,17 @@ import (
 	"sync"
 	"time"
 
+	"github.com/lemconn/foxflow/internal/config"
 	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/engine/builtin"
+	"github.com/lemconn/foxflow/internal/engine/provider"
+	"github.com/lemconn/foxflow/internal/engine/risk"
 	"github.com/lemconn/foxflow/internal/engine/syntax"
 	"github.com/lemconn/foxflow/internal/exchange"
 	"github.com/lemconn/foxflow/internal/news"
 	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/lemconn/foxflow/internal/repository"
+	"github.com/shopspring/decimal"
 	"gorm.io/gorm"
 )
 
@@ -24,7 +30,20 @@ type Engine struct {
 	exchangeMgr   *exchange.Manager
 	syntaxEngine  *syntax.Engine
 	newsManager   *news.Manager
+	regexCaches   map[int64]*builtin.RegexCache // 按订单缓存已编译的正则表达式，仅由检查协程访问
 	checkInterval time.Duration
+	clock         func() time.Time
+	clockMu       sync.RWMutex
+	riskRules     []risk.Rule // 引擎注册的风控规则，对所有账户生效
+	riskMu        sync.RWMutex
+	halted        map[int64]bool // 紧急停止的账户
+	haltAll       bool           // 全局紧急停止
+	haltMu        sync.RWMutex
+	cycleMu       sync.Mutex                 // 检查周期与紧急停止互斥执行
+	paused        bool                       // 手动暂停
+	windows       []config.MaintenanceWindow // 维护时间窗口，窗口内自动暂停
+	maintenance   bool                       // 上一检查周期是否处于维护时间窗口，仅由检查协程访问
+	pauseMu       sync.RWMutex
 	running       bool
 	mu            sync.RWMutex
 }
@@ -40,6 +59,20 @@ func NewEngine() *Engine {
 	newsManager := news.NewManager()
 	blockBeats := news.NewBlockBeats()
 	newsManager.RegisterSource(blockBeats)
+	newsManager.RegisterFeeds(newsFeeds())
+
+	// 策略中的新闻数据与 show news 共用同一组新闻源，轮询获取的新闻写入归档供 show news 检索
+	if p, ok := syntaxEngine.GetRegistry().GetProvider("news"); ok {
+		if newsProvider, ok := p.(*provider.NewsProvider); ok {
+			newsProvider.SetArchive(repository.SaveNews)
+			newsProvider.SetManager(newsManager)
+		}
+	}
+
+	var windows []config.MaintenanceWindow
+	if config.GlobalConfig != nil {
+		windows = config.GlobalConfig.MaintenanceWindows
+	}
 
 	return &Engine{
 		ctx:           ctx,
@@ -47,7 +80,11 @@ func NewEngine() *Engine {
 		exchangeMgr:   exchange.GetManager(),
 		syntaxEngine:  syntaxEngine,
 		newsManager:   newsManager,
+		regexCaches:   make(map[int64]*builtin.RegexCache),
+		halted:        make(map[int64]bool),
 		checkInterval: 5 * time.Second, // 每5秒检查一次
+		clock:         time.Now,
+		windows:       windows,
 	}
 }
 
@@ -60,6 +97,16 @@ func (e *Engine) Start() error {
 		return fmt.Errorf("engine is already running")
 	}
 
+	// 恢复紧急停止状态，未解除前不处理订单
+	if err := e.loadKillSwitches(); err != nil {
+		return err
+	}
+
+	// 恢复手动暂停状态，未恢复前不处理订单
+	if err := e.loadEnginePause(); err != nil {
+		return err
+	}
+
 	e.running = true
 	log.Println("策略引擎启动")
 
@@ -104,7 +151,10 @@ func (e *Engine) run() {
 		case <-e.ctx.Done():
 			return
 		case <-ticker.C:
-			if err := e.checkStrategies(); err != nil {
+			e.cycleMu.Lock()
+			err := e.checkStrategies()
+			e.cycleMu.Unlock()
+			if err != nil {
 				log.Printf("策略检查错误: %v", err)
 			}
 		}
@@ -113,7 +163,48 @@ func (e *Engine) run() {
 
 // checkStrategies 检查所有等待中的策略订单
 func (e *Engine) checkStrategies() error {
-	// 获取所有等待中的策略订单
+	// 全局紧急停止时不处理任何订单
+	if e.HaltedAll() {
+		return nil
+	}
+
+	// 过期的策略订单不再处理（暂停期间同样过期）
+	now := e.now()
+	if _, err := e.expireWaitingOrders(now); err != nil {
+		log.Printf("处理过期订单时出错: %v", err)
+	}
+
+	// 手动暂停或处于维护时间窗口时跳过本次检查
+	if e.suspended() {
+		return nil
+	}
+
+	// 撤销交易所中已过期的未成交限价单
+	if err := e.processExpiredOrders(now); err != nil {
+		log.Printf("撤销过期挂单时出错: %v", err)
+	}
+
+	// 推进执行中的算法订单（TWAP、冰山单），撤销已取消算法订单的子订单
+	if err := e.processAlgoOrders(now); err != nil {
+		log.Printf("处理算法订单时出错: %v", err)
+	}
+
+	// 追价中的挂单按买一/卖一价撤单重下
+	if err := e.processChaseOrders(); err != nil {
+		log.Printf("处理追价订单时出错: %v", err)
+	}
+
+	// 到期的定投计划创建开仓订单，随本周期的等待中订单一起提交
+	if err := e.processDcaPlans(now); err != nil {
+		log.Printf("处理定投计划时出错: %v", err)
+	}
+
+	// 激活已成交开仓订单的平仓订单，取消未成交开仓订单的平仓订单
+	if err := e.processChainOrders(); err != nil {
+		log.Printf("处理关联平仓订单时出错: %v", err)
+	}
+
+	// 获取所有等待中的策略订单（已暂停的订单不处理）
 	orders, err := database.Adapter().FoxOrder.Where(
 		database.Adapter().FoxOrder.Status.Eq("waiting"),
 	).Find()
@@ -124,10 +215,22 @@ func (e *Engine) checkStrategies() error {
 
 	// 按用户分组处理
 	userOrders := make(map[int64][]*model.FoxOrder)
+	waiting := make(map[int64]struct{}, len(orders))
 	for _, order := range orders {
+		waiting[order.ID] = struct{}{}
+		if e.Halted(order.AccountID) {
+			continue
+		}
 		userOrders[order.AccountID] = append(userOrders[order.AccountID], order)
 	}
 
+	// 清理已不再等待的订单的正则表达式缓存
+	for orderID := range e.regexCaches {
+		if _, exists := waiting[orderID]; !exists {
+			delete(e.regexCaches, orderID)
+		}
+	}
+
 	// 处理每个用户的订单
 	for userID, userOrderList := range userOrders {
 		if err := e.processUserOrders(userID, userOrderList); err != nil {
@@ -161,9 +264,12 @@ func (e *Engine) processUserOrders(userID int64, orders []*model.FoxOrder) error
 		return fmt.Errorf("failed to connect user to exchange: %w", err)
 	}
 
+	// 策略中的账户、持仓数据使用订单所属账户的凭证获取
+	ctx := provider.WithAccount(e.ctx, user)
+
 	// 处理每个订单
 	for _, order := range orders {
-		if err := e.processOrder(exchangeInstance, order); err != nil {
+		if err := e.processOrder(ctx, exchangeInstance, order); err != nil {
 			log.Printf("处理订单 %d 时出错: %v", order.ID, err)
 		}
 	}
@@ -172,10 +278,10 @@ func (e *Engine) processUserOrders(userID int64, orders []*model.FoxOrder) error
 }
 
 // processOrder 处理单个订单
-func (e *Engine) processOrder(exchangeInstance exchange.Exchange, order *model.FoxOrder) error {
+func (e *Engine) processOrder(ctx context.Context, exchangeInstance exchange.Exchange, order *model.FoxOrder) error {
 	// 如果没有策略，直接提交订单
 	if order.Strategy == "" {
-		return e.submitOrder(exchangeInstance, order)
+		return e.triggerOrder(ctx, exchangeInstance, order)
 	}
 
 	// 解析语法表达式
@@ -189,31 +295,89 @@ func (e *Engine) processOrder(exchangeInstance exchange.Exchange, order *model.F
 		return fmt.Errorf("failed to validate AST: %w", err)
 	}
 
+	// 加载订单的策略求值状态（hold、within 等函数跨周期使用）
+	state, err := builtin.LoadState(order.StrategyState)
+	if err != nil {
+		log.Printf("订单 %d 策略状态损坏，重新开始计算: %v", order.ID, err)
+		state = builtin.NewState()
+	}
+	ctx = builtin.WithNow(builtin.WithState(ctx, state), e.now())
+	ctx = builtin.WithCheckInterval(ctx, e.interval())
+	ctx = builtin.WithRegexCache(ctx, e.regexCache(order.ID))
+
 	// 执行AST并获取布尔结果
-	conditionResult, err := e.syntaxEngine.ExecuteToBool(e.ctx, node)
+	conditionResult, err := e.syntaxEngine.ExecuteToBool(ctx, node)
 	if err != nil {
 		return fmt.Errorf("failed to execute strategy AST: %w", err)
 	}
 
+	// 持久化策略求值状态，保证引擎重启后继续计算
+	if err := e.saveStrategyState(order, state); err != nil {
+		return err
+	}
+
 	// 如果条件满足，提交订单
 	if conditionResult {
 		log.Printf("策略条件满足，提交订单: ID=%d, Strategy=%s", order.ID, order.Strategy)
-		return e.submitOrder(exchangeInstance, order)
+		return e.triggerOrder(ctx, exchangeInstance, order)
 	}
 
 	log.Printf("策略条件不满足，跳过订单: ID=%d, Strategy=%s", order.ID, order.Strategy)
 	return nil
 }
 
+// regexCache 获取订单的正则表达式缓存
+func (e *Engine) regexCache(orderID int64) *builtin.RegexCache {
+	cache, exists := e.regexCaches[orderID]
+	if !exists {
+		cache = builtin.NewRegexCache()
+		e.regexCaches[orderID] = cache
+	}
+	return cache
+}
+
+// saveStrategyState 保存订单的策略求值状态
+func (e *Engine) saveStrategyState(order *model.FoxOrder, state *builtin.State) error {
+	if !state.Dirty() {
+		return nil
+	}
+
+	data, err := state.Marshal()
+	if err != nil {
+		return err
+	}
+
+	order.StrategyState = data
+	if _, err := database.Adapter().FoxOrder.Where(
+		database.Adapter().FoxOrder.ID.Eq(order.ID),
+	).Update(database.Adapter().FoxOrder.StrategyState, data); err != nil {
+		return fmt.Errorf("failed to save strategy state: %w", err)
+	}
+
+	return nil
+}
+
 // submitOrder 提交订单到交易所
 func (e *Engine) submitOrder(exchangeInstance exchange.Exchange, order *model.FoxOrder) error {
+	// 紧急停止后不再提交订单（检查周期执行过程中触发紧急停止的情况）
+	if e.Halted(order.AccountID) {
+		return fmt.Errorf("engine halted by kill switch")
+	}
+
 	if order.Type == "close" {
-		closeExchangeOrder := &exchange.ClosePosition{
-			Symbol:  order.Symbol,
-			Margin:  order.MarginType,
-			PosSide: order.PosSide,
+		// 同一周期内 OCO 的其他平仓订单已触发时不再平仓
+		if order.ParentID > 0 {
+			current, err := database.Adapter().FoxOrder.Where(database.Adapter().FoxOrder.ID.Eq(order.ID)).First()
+			if err != nil {
+				return fmt.Errorf("failed to get order: %w", err)
+			}
+			if current.Status != "waiting" {
+				log.Printf("OCO 平仓订单已取消，跳过: ID=%d", order.ID)
+				return nil
+			}
 		}
-		err := exchangeInstance.ClosePosition(e.ctx, closeExchangeOrder)
+
+		err := e.closePosition(exchangeInstance, order)
 		if err != nil {
 			order.Msg = err.Error()
 			order.Status = "failed"
@@ -224,18 +388,28 @@ func (e *Engine) submitOrder(exchangeInstance exchange.Exchange, order *model.Fo
 			return fmt.Errorf("failed to close position: %w", err)
 		}
 		order.Status = "closed"
+		if order.Chase == "active" {
+			order.ChaseBase = order.Price
+		}
 		if err := database.Adapter().FoxOrder.Save(order); err != nil {
 			return fmt.Errorf("failed to update order: %w", err)
 		}
+		e.recordOrderHistory(order, "submit", order.Price, order.Size, "平仓委托已提交")
 		log.Printf("平仓成功: ID=%d, OrderID=%s", order.ID, order.OrderID)
+
+		if _, err := e.cancelSiblingOrders(order); err != nil {
+			log.Printf("取消 OCO 平仓订单时出错: %v", err)
+		}
 	}
 
 	if order.Type == "open" {
 		preCheckOrder := &exchange.OrderCostReq{
+			Side:       order.Side,
 			Symbol:     order.Symbol,
 			Amount:     order.Size,
 			AmountType: order.SizeType,
 			MarginType: order.MarginType,
+			LimitPrice: order.Price,
 		}
 
 		preOrder, err := exchangeInstance.CalcOrderCost(e.ctx, preCheckOrder)
@@ -247,6 +421,26 @@ func (e *Engine) submitOrder(exchangeInstance exchange.Exchange, order *model.Fo
 			return fmt.Errorf("insufficient balance to place order")
 		}
 
+		// 风控检查不通过时订单置为失败并记录拦截原因，获取账户数据失败则下个周期重试
+		if err := e.checkRisk(exchangeInstance, order, preOrder); err != nil {
+			var violation *risk.Violation
+			if !errors.As(err, &violation) {
+				return fmt.Errorf("failed to check risk: %w", err)
+			}
+			order.Msg = violation.Error()
+			order.Status = "failed"
+			if err := database.Adapter().FoxOrder.Save(order); err != nil {
+				return fmt.Errorf("failed to update order: %w", err)
+			}
+			log.Printf("风控拦截: ID=%d, Reason=%s", order.ID, order.Msg)
+			return violation
+		}
+
+		// 算法订单拆分为子订单分批提交
+		if order.Algo != "" {
+			return e.startAlgo(exchangeInstance, order, preOrder.Contracts)
+		}
+
 		exchangeOrder := &exchange.Order{
 			OrderID:    order.OrderID,
 			Symbol:     order.Symbol,
@@ -268,14 +462,71 @@ func (e *Engine) submitOrder(exchangeInstance exchange.Exchange, order *model.Fo
 			return fmt.Errorf("failed to open position: %w", err)
 		}
 		order.Status = "opened"
+		if order.Chase == "active" {
+			order.ChaseBase = order.Price
+		}
 		if err := database.Adapter().FoxOrder.Save(order); err != nil {
 			return fmt.Errorf("failed to update order: %w", err)
 		}
+		e.recordOrderHistory(order, "submit", order.Price, exchangeOrder.Size, "开仓委托已提交")
 		log.Printf("开仓成功: ID=%d, OrderID=%s", order.ID, result.ID)
+
+		// 市价单提交成功即视为成交，立即激活平仓订单；ioc、fok 可能未成交即被撤销，由 processChainOrders 确认成交后激活
+		if order.OrderType == "market" {
+			if _, err := e.armChildOrders(order); err != nil {
+				log.Printf("激活订单 %d 的平仓订单时出错: %v", order.ID, err)
+			}
+		}
 	}
 	return nil
 }
 
+// checkRisk 使用账户风控规则及引擎注册的风控规则检查开仓订单
+func (e *Engine) checkRisk(exchangeInstance exchange.Exchange, order *model.FoxOrder, preOrder *exchange.OrderCostResp) error {
+	config, err := repository.GetRiskRule(order.AccountID)
+	if err != nil {
+		return fmt.Errorf("failed to get risk rule: %w", err)
+	}
+
+	rules, err := risk.RulesFromConfig(config)
+	if err != nil {
+		return err
+	}
+
+	e.riskMu.RLock()
+	rules = append(rules, e.riskRules...)
+	e.riskMu.RUnlock()
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	// 名义价值 = 所需保证金 * 杠杆倍数
+	marginRequired, err := decimal.NewFromString(preOrder.MarginRequired)
+	if err != nil {
+		return fmt.Errorf("failed to parse margin required: %w", err)
+	}
+
+	riskOrder := &risk.Order{
+		Symbol:   order.Symbol,
+		Coin:     exchangeInstance.ConvertFromExchangeSymbol(order.Symbol),
+		PosSide:  order.PosSide,
+		Notional: marginRequired.Mul(decimal.NewFromInt(preOrder.Lever)),
+		Lever:    preOrder.Lever,
+	}
+	account := risk.NewAccount(e.ctx, exchangeInstance, e.now())
+
+	return risk.NewManager(rules...).Check(riskOrder, account)
+}
+
+// AddRiskRule 注册对所有账户生效的风控规则
+func (e *Engine) AddRiskRule(rule risk.Rule) {
+	e.riskMu.Lock()
+	defer e.riskMu.Unlock()
+
+	e.riskRules = append(e.riskRules, rule)
+}
+
 // GetStatus 获取引擎状态
 func (e *Engine) GetStatus() map[string]interface{} {
 	e.mu.RLock()
@@ -284,6 +535,9 @@ func (e *Engine) GetStatus() map[string]interface{} {
 	return map[string]interface{}{
 		"running":        e.running,
 		"check_interval": e.checkInterval.String(),
+		"halted":         e.HaltedAll(),
+		"paused":         e.Paused(),
+		"maintenance":    e.InMaintenance(),
 	}
 }
 
@@ -295,6 +549,59 @@ func (e *Engine) SetCheckInterval(interval time.Duration) {
 	e.checkInterval = interval
 }
 
+// interval 获取检查间隔
+func (e *Engine) interval() time.Duration {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.checkInterval
+}
+
+// SetClock 设置引擎时钟，策略中的时间函数均以该时钟为准（主要用于测试）
+func (e *Engine) SetClock(clock func() time.Time) {
+	e.clockMu.Lock()
+	defer e.clockMu.Unlock()
+
+	if clock == nil {
+		clock = time.Now
+	}
+	e.clock = clock
+}
+
+// now 获取引擎时钟的当前时间
+// 使用独立的锁，避免 Stop 持有 mu 等待检查协程时发生死锁
+func (e *Engine) now() time.Time {
+	e.clockMu.RLock()
+	defer e.clockMu.RUnlock()
+
+	return e.clock()
+}
+
+// newsFeeds 获取配置和数据库中的 RSS/Atom 新闻源，名称相同时以数据库为准
+func newsFeeds() []news.FeedConfig {
+	feeds := news.ConfiguredFeeds()
+
+	stored, err := repository.ListNewsFeeds()
+	if err != nil {
+		log.Printf("加载数据库新闻源失败: %v", err)
+		return feeds
+	}
+
+	index := make(map[string]int, len(feeds))
+	for i, feed := range feeds {
+		index[feed.Name] = i
+	}
+	for _, feed := range stored {
+		if i, ok := index[feed.Name]; ok {
+			feeds[i] = feed
+			continue
+		}
+		index[feed.Name] = len(feeds)
+		feeds = append(feeds, feed)
+	}
+	return feeds
+}
+
 // GetNewsManager 获取新闻管理器
 func (e *Engine) GetNewsManager() *news.Manager {
 	return e.newsManager
@@ -0,0 +1,47 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/config"
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+func TestNewsFeeds(t *testing.T) {
+	initTestDB(t)
+
+	config.GlobalConfig.NewsFeeds = []config.NewsFeed{
+		{Name: "coindesk", URL: "https://config.example/coindesk"},
+		{Name: "cointelegraph", URL: "https://config.example/cointelegraph"},
+	}
+
+	q := database.Adapter().FoxNewsFeed
+	for _, feed := range []*model.FoxNewsFeed{
+		{Name: "coindesk", URL: "https://db.example/coindesk"},
+		{Name: "mynews", DisplayName: "My News", URL: "https://db.example/mynews"},
+		{Name: "decrypt", URL: "https://db.example/decrypt"},
+	} {
+		if err := q.Create(feed); err != nil {
+			t.Fatalf("创建新闻源失败: %v", err)
+		}
+	}
+	if _, err := q.Where(q.Name.Eq("decrypt")).Update(q.IsActive, 0); err != nil {
+		t.Fatalf("停用新闻源失败: %v", err)
+	}
+
+	feeds := newsFeeds()
+	want := []struct{ name, displayName, url string }{
+		{"coindesk", "CoinDesk", "https://db.example/coindesk"},
+		{"cointelegraph", "Cointelegraph", "https://config.example/cointelegraph"},
+		{"mynews", "My News", "https://db.example/mynews"},
+	}
+	if len(feeds) != len(want) {
+		t.Fatalf("期望 %d 个新闻源，实际 %+v", len(want), feeds)
+	}
+	for i, w := range want {
+		if feeds[i].Name != w.name || feeds[i].DisplayName != w.displayName || feeds[i].URL != w.url {
+			t.Errorf("第 %d 个新闻源期望 %+v，实际 %+v", i, w, feeds[i])
+		}
+	}
+}
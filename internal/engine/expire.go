@@ -0,0 +1,155 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"gorm.io/gorm"
+)
+
+// expireWaitingOrders 将已过期的等待中（含已暂停）策略订单置为 expired，返回过期的订单数量
+func (e *Engine) expireWaitingOrders(now time.Time) (int64, error) {
+	q := database.Adapter().FoxOrder
+	info, err := q.Where(
+		q.Status.In("waiting", "paused"),
+		q.ExpireAt.Gt(0),
+		q.ExpireAt.Lte(now.Unix()),
+	).UpdateSimple(
+		q.Status.Value("expired"),
+		q.Msg.Value(fmt.Sprintf("订单已过期（%s），未满足策略条件", now.Format("2006-01-02 15:04:05"))),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire waiting orders: %w", err)
+	}
+
+	if info.RowsAffected > 0 {
+		log.Printf("策略订单已过期: %d 个", info.RowsAffected)
+	}
+	return info.RowsAffected, nil
+}
+
+// processExpiredOrders 按账户撤销已过期的交易所挂单
+func (e *Engine) processExpiredOrders(now time.Time) error {
+	orders, err := e.expiredSubmittedOrders(now)
+	if err != nil {
+		return err
+	}
+
+	accountOrders := make(map[int64][]*model.FoxOrder)
+	for _, order := range orders {
+		if e.Halted(order.AccountID) {
+			continue
+		}
+		accountOrders[order.AccountID] = append(accountOrders[order.AccountID], order)
+	}
+
+	for accountID, orderList := range accountOrders {
+		account, err := database.Adapter().FoxAccount.Where(database.Adapter().FoxAccount.ID.Eq(accountID)).First()
+		if err != nil {
+			log.Printf("获取账户 %d 失败: %v", accountID, err)
+			continue
+		}
+
+		exchangeInstance, err := e.exchangeMgr.GetExchange(account.Exchange)
+		if err != nil {
+			log.Printf("获取交易所 %s 失败: %v", account.Exchange, err)
+			continue
+		}
+		if err := exchangeInstance.Connect(e.ctx, account); err != nil {
+			log.Printf("连接账户 %d 到交易所失败: %v", accountID, err)
+			continue
+		}
+
+		for _, order := range orderList {
+			if err := e.expireSubmittedOrder(exchangeInstance, order); err != nil {
+				log.Printf("撤销过期订单 %d 时出错: %v", order.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// expiredSubmittedOrders 获取已提交到交易所且已过期的挂单（limit、post_only）开仓订单
+func (e *Engine) expiredSubmittedOrders(now time.Time) ([]*model.FoxOrder, error) {
+	q := database.Adapter().FoxOrder
+	orders, err := q.Where(
+		q.Status.Eq("opened"),
+		q.Type.Eq("open"),
+		q.OrderType.In(restingOrderTypes...),
+		q.ExpireAt.Gt(0),
+		q.ExpireAt.Lte(now.Unix()),
+	).Find()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("failed to get expired submitted orders: %w", err)
+	}
+	return orders, nil
+}
+
+// expireSubmittedOrder 按交易所中订单的状态处理过期的限价单
+// 已完全成交时保留 opened 状态，仅清除过期时间；仍在挂单中时撤单；其余情况置为 expired，有成交时激活平仓订单
+func (e *Engine) expireSubmittedOrder(exchangeInstance exchange.Exchange, order *model.FoxOrder) error {
+	exchangeOrder, err := e.getSubmittedOrder(exchangeInstance, order)
+	if err != nil {
+		return err
+	}
+
+	msg := "订单已过期，交易所订单已撤销"
+	if exchangeOrder != nil && (exchangeOrder.Status == exchange.OrderStatusLive || exchangeOrder.Status == exchange.OrderStatusPartiallyFilled) {
+		if err := exchangeInstance.CancelOrder(e.ctx, exchangeOrder); err != nil {
+			return fmt.Errorf("failed to cancel expired order: %w", err)
+		}
+
+		// 撤单前可能还有成交，撤单后重新查询最终成交数量
+		cancelled, err := e.getSubmittedOrder(exchangeInstance, order)
+		if err != nil {
+			return err
+		}
+		if cancelled != nil {
+			exchangeOrder = cancelled
+		}
+		msg = "订单已过期，已撤销交易所挂单"
+	}
+
+	if exchangeOrder != nil && exchangeOrder.Status == exchange.OrderStatusFilled {
+		order.ExpireAt = 0
+		if err := database.Adapter().FoxOrder.Save(order); err != nil {
+			return fmt.Errorf("failed to update order: %w", err)
+		}
+		return nil
+	}
+
+	order.Status = "expired"
+	order.Msg = msg
+	if filled := orderFilled(order, exchangeOrder); filled.IsPositive() {
+		order.Msg = fmt.Sprintf("%s（已成交 %s 张）", msg, filled.String())
+
+		// 部分成交的仓位仍需止盈止损，先激活平仓订单，避免过期后被当作未成交订单的平仓订单取消
+		if _, err := e.armChildOrders(order); err != nil {
+			return err
+		}
+	}
+	if err := database.Adapter().FoxOrder.Save(order); err != nil {
+		return fmt.Errorf("failed to update order: %w", err)
+	}
+
+	log.Printf("过期挂单已处理: ID=%d, OrderID=%s, %s", order.ID, order.OrderID, order.Msg)
+	return nil
+}
+
+// getSubmittedOrder 按客户自定义订单ID查询交易所订单，交易所仅短期保留未成交即撤销的订单，查询不到时返回 nil
+func (e *Engine) getSubmittedOrder(exchangeInstance exchange.Exchange, order *model.FoxOrder) (*exchange.Order, error) {
+	exchangeOrder, err := exchangeInstance.GetOrder(e.ctx, order.Symbol, order.OrderID)
+	if errors.Is(err, exchange.ErrOrderNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	return exchangeOrder, nil
+}
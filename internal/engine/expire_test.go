@@ -0,0 +1,219 @@
+package engine
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/config"
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+// mockExpireExchange 模拟交易所，仅实现处理过期挂单需要的方法，orders 为按客户自定义订单ID记录的交易所订单
+type mockExpireExchange struct {
+	exchange.Exchange
+	orders    map[string]*exchange.Order
+	cancelled []exchange.Order
+}
+
+func (m *mockExpireExchange) GetOrder(ctx context.Context, symbol string, clientOrderID string) (*exchange.Order, error) {
+	order, ok := m.orders[clientOrderID]
+	if !ok {
+		return nil, exchange.ErrOrderNotFound
+	}
+	result := *order
+	return &result, nil
+}
+
+func (m *mockExpireExchange) CancelOrder(ctx context.Context, order *exchange.Order) error {
+	m.cancelled = append(m.cancelled, *order)
+	m.orders[order.OrderID].Status = exchange.OrderStatusCanceled
+	m.orders[order.OrderID].Remain = 0
+	return nil
+}
+
+func initTestDB(t *testing.T) {
+	t.Helper()
+
+	dbFile := filepath.Join(t.TempDir(), "foxflow.db")
+	if err := os.WriteFile(dbFile, nil, 0644); err != nil {
+		t.Fatalf("Failed to create db file: %v", err)
+	}
+	original := config.GlobalConfig
+	config.GlobalConfig = &config.Config{DBFile: dbFile}
+	t.Cleanup(func() { config.GlobalConfig = original })
+
+	if err := database.InitDB(); err != nil {
+		t.Fatalf("Failed to init db: %v", err)
+	}
+}
+
+func createTestOrder(t *testing.T, order *model.FoxOrder) *model.FoxOrder {
+	t.Helper()
+
+	order.AccountID = 1
+	order.Exchange = "okx"
+	order.Symbol = "BTC-USDT-SWAP"
+	order.Side = "buy"
+	order.PosSide = "long"
+	order.MarginType = "isolated"
+	order.Type = "open"
+	if order.OrderType == "" {
+		order.OrderType = "market"
+	}
+	if err := database.Adapter().FoxOrder.Create(order); err != nil {
+		t.Fatalf("Failed to create order: %v", err)
+	}
+	return order
+}
+
+func getTestOrder(t *testing.T, id int64) *model.FoxOrder {
+	t.Helper()
+
+	order, err := database.Adapter().FoxOrder.Where(database.Adapter().FoxOrder.ID.Eq(id)).First()
+	if err != nil {
+		t.Fatalf("Failed to get order: %v", err)
+	}
+	return order
+}
+
+func TestEngine_ExpireWaitingOrders(t *testing.T) {
+	initTestDB(t)
+
+	now := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	waiting := createTestOrder(t, &model.FoxOrder{Status: "waiting", ExpireAt: now.Add(-time.Minute).Unix()})
+	paused := createTestOrder(t, &model.FoxOrder{Status: "paused", ExpireAt: now.Unix()})
+	active := createTestOrder(t, &model.FoxOrder{Status: "waiting", ExpireAt: now.Add(time.Minute).Unix()})
+	forever := createTestOrder(t, &model.FoxOrder{Status: "waiting"})
+	opened := createTestOrder(t, &model.FoxOrder{Status: "opened", ExpireAt: now.Add(-time.Minute).Unix()})
+
+	e := &Engine{ctx: context.Background()}
+	expired, err := e.expireWaitingOrders(now)
+	if err != nil {
+		t.Fatalf("expireWaitingOrders() error = %v", err)
+	}
+	if expired != 2 {
+		t.Errorf("expireWaitingOrders() = %d, want 2", expired)
+	}
+
+	for _, tt := range []struct {
+		order *model.FoxOrder
+		want  string
+	}{
+		{order: waiting, want: "expired"},
+		{order: paused, want: "expired"},
+		{order: active, want: "waiting"},
+		{order: forever, want: "waiting"},
+		{order: opened, want: "opened"},
+	} {
+		got := getTestOrder(t, tt.order.ID)
+		if got.Status != tt.want {
+			t.Errorf("order %d status = %s, want %s", got.ID, got.Status, tt.want)
+		}
+		if tt.want == "expired" && !strings.Contains(got.Msg, "已过期") {
+			t.Errorf("order %d msg = %q, want expiry reason", got.ID, got.Msg)
+		}
+	}
+}
+
+func TestEngine_ExpireSubmittedOrder(t *testing.T) {
+	initTestDB(t)
+
+	now := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	partial := createTestOrder(t, &model.FoxOrder{OrderID: "cl1", OrderType: "limit", Status: "opened", ExpireAt: now.Unix()})
+	filled := createTestOrder(t, &model.FoxOrder{OrderID: "cl2", OrderType: "limit", Status: "opened", ExpireAt: now.Unix()})
+	createTestOrder(t, &model.FoxOrder{OrderID: "cl3", OrderType: "market", Status: "opened", ExpireAt: now.Unix()})
+	createTestOrder(t, &model.FoxOrder{OrderID: "cl4", OrderType: "limit", Status: "opened", ExpireAt: now.Add(time.Hour).Unix()})
+	unfilled := createTestOrder(t, &model.FoxOrder{OrderID: "cl5", OrderType: "limit", Status: "opened", ExpireAt: now.Add(time.Hour).Unix()})
+	manual := createTestOrder(t, &model.FoxOrder{OrderID: "cl6", OrderType: "limit", Status: "opened", ExpireAt: now.Unix()})
+	missing := createTestOrder(t, &model.FoxOrder{OrderID: "cl7", OrderType: "limit", Status: "opened", ExpireAt: now.Unix()})
+	chased := createTestOrder(t, &model.FoxOrder{OrderID: "cl8", OrderType: "limit", Status: "opened", ExpireAt: now.Unix(), ChaseFilled: "3"})
+	partialChild := createTestOrder(t, &model.FoxOrder{Status: "pending", ParentID: partial.ID})
+	unfilledChild := createTestOrder(t, &model.FoxOrder{Status: "pending", ParentID: unfilled.ID})
+	chasedChild := createTestOrder(t, &model.FoxOrder{Status: "pending", ParentID: chased.ID})
+
+	e := &Engine{ctx: context.Background()}
+	orders, err := e.expiredSubmittedOrders(now)
+	if err != nil {
+		t.Fatalf("expiredSubmittedOrders() error = %v", err)
+	}
+	if len(orders) != 5 {
+		t.Fatalf("expiredSubmittedOrders() = %d orders, want 5", len(orders))
+	}
+
+	mock := &mockExpireExchange{
+		orders: map[string]*exchange.Order{
+			"cl1": {ID: "100", OrderID: "cl1", Symbol: "BTC-USDT-SWAP", Status: exchange.OrderStatusPartiallyFilled, Filled: 2, Remain: 3},
+			"cl2": {ID: "101", OrderID: "cl2", Symbol: "BTC-USDT-SWAP", Status: exchange.OrderStatusFilled, Filled: 5},
+			"cl5": {ID: "102", OrderID: "cl5", Symbol: "BTC-USDT-SWAP", Status: exchange.OrderStatusLive, Remain: 5},
+			"cl6": {ID: "103", OrderID: "cl6", Symbol: "BTC-USDT-SWAP", Status: exchange.OrderStatusCanceled},
+			"cl8": {ID: "104", OrderID: "cl8", Symbol: "BTC-USDT-SWAP", Status: exchange.OrderStatusCanceled},
+		},
+	}
+
+	// 部分成交：撤销剩余挂单并置为过期
+	if err := e.expireSubmittedOrder(mock, partial); err != nil {
+		t.Fatalf("expireSubmittedOrder() error = %v", err)
+	}
+	if len(mock.cancelled) != 1 || mock.cancelled[0].ID != "100" {
+		t.Errorf("cancelled = %+v, want order 100", mock.cancelled)
+	}
+	got := getTestOrder(t, partial.ID)
+	if got.Status != "expired" || !strings.Contains(got.Msg, "已成交 2") {
+		t.Errorf("partial order = %s %q, want expired with filled size", got.Status, got.Msg)
+	}
+
+	// 已完全成交：保留 opened，清除过期时间
+	if err := e.expireSubmittedOrder(mock, filled); err != nil {
+		t.Fatalf("expireSubmittedOrder() error = %v", err)
+	}
+	got = getTestOrder(t, filled.ID)
+	if got.Status != "opened" || got.ExpireAt != 0 {
+		t.Errorf("filled order = %s expire_at %d, want opened without expiry", got.Status, got.ExpireAt)
+	}
+
+	// 在交易所手动撤销或交易所查询不到：无需撤单，直接置为过期
+	for _, order := range []*model.FoxOrder{manual, missing} {
+		if err := e.expireSubmittedOrder(mock, order); err != nil {
+			t.Fatalf("expireSubmittedOrder() error = %v", err)
+		}
+		if got := getTestOrder(t, order.ID); got.Status != "expired" || strings.Contains(got.Msg, "已成交") {
+			t.Errorf("order %s = %s %q, want expired without fills", order.OrderID, got.Status, got.Msg)
+		}
+	}
+	if len(mock.cancelled) != 1 {
+		t.Errorf("cancelled = %d orders, want 1", len(mock.cancelled))
+	}
+
+	// 追价撤销的委托有成交，最后一笔委托未成交：按累计成交数量记录并激活平仓订单
+	if err := e.expireSubmittedOrder(mock, chased); err != nil {
+		t.Fatalf("expireSubmittedOrder() error = %v", err)
+	}
+	if got := getTestOrder(t, chased.ID); got.Status != "expired" || !strings.Contains(got.Msg, "已成交 3") {
+		t.Errorf("chased order = %s %q, want expired with filled size", got.Status, got.Msg)
+	}
+
+	// 未成交：撤销挂单，平仓订单随后作为孤儿订单取消
+	if err := e.expireSubmittedOrder(mock, unfilled); err != nil {
+		t.Fatalf("expireSubmittedOrder() error = %v", err)
+	}
+	if _, err := e.cancelOrphanedChildren(); err != nil {
+		t.Fatalf("cancelOrphanedChildren() error = %v", err)
+	}
+
+	// 有成交的平仓订单已激活，不会被取消；未成交的平仓订单被取消
+	if got := getTestOrder(t, partialChild.ID); got.Status != "waiting" {
+		t.Errorf("partial child status = %s, want waiting", got.Status)
+	}
+	if got := getTestOrder(t, chasedChild.ID); got.Status != "waiting" {
+		t.Errorf("chased child status = %s, want waiting", got.Status)
+	}
+	if got := getTestOrder(t, unfilledChild.ID); got.Status != "cancelled" {
+		t.Errorf("unfilled child status = %s, want cancelled", got.Status)
+	}
+}
@@ -0,0 +1,23 @@
+package engine
+
+import (
+	"log"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+// recordOrderHistory 记录订单的委托变更，写入失败只记录日志，不影响订单处理
+func (e *Engine) recordOrderHistory(order *model.FoxOrder, action, price, size, msg string) {
+	history := &model.FoxOrderHistory{
+		OrderID:       order.ID,
+		Action:        action,
+		ClientOrderID: order.OrderID,
+		Price:         price,
+		Size:          size,
+		Msg:           msg,
+	}
+	if err := database.Adapter().FoxOrderHistory.Create(history); err != nil {
+		log.Printf("记录订单 %d 历史失败: %v", order.ID, err)
+	}
+}
@@ -0,0 +1,213 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/lemconn/foxflow/internal/repository"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// killSwitchReason 紧急停止时写入被取消订单的描述
+const killSwitchReason = "紧急停止：策略订单已取消"
+
+// KillSwitchResult 紧急停止执行结果
+type KillSwitchResult struct {
+	CancelledOrders         int64    // 取消的等待中策略订单数量
+	CancelledExchangeOrders int64    // 撤销的交易所挂单数量
+	ClosedPositions         int64    // 平仓的仓位数量
+	Errors                  []string // 执行失败的操作（不中断其他账户/仓位的处理）
+}
+
+// KillSwitch 紧急停止：停止处理订单，取消等待中的策略订单，撤销交易所挂单并平掉所有仓位
+// accountID 为 0 表示所有账户；停止状态持久化，需调用 Rearm 才会恢复
+func (e *Engine) KillSwitch(accountID int64) (*KillSwitchResult, error) {
+	// 先在内存中标记停止，正在执行的检查周期不会再提交新订单
+	e.setHalted(accountID, true)
+
+	// 等待正在执行的检查周期结束，避免与引擎同时操作交易所
+	e.cycleMu.Lock()
+	defer e.cycleMu.Unlock()
+
+	cancelled, err := repository.ActivateKillSwitch(accountID, killSwitchReason)
+	if err != nil {
+		return nil, fmt.Errorf("failed to activate kill switch: %w", err)
+	}
+	result := &KillSwitchResult{CancelledOrders: cancelled}
+
+	accounts, err := e.killSwitchAccounts(accountID)
+	if err != nil {
+		return result, err
+	}
+
+	for _, account := range accounts {
+		e.flattenAccount(account, result)
+	}
+
+	log.Printf("紧急停止已执行: AccountID=%d, 取消策略订单=%d, 撤销挂单=%d, 平仓=%d, 失败=%d",
+		accountID, result.CancelledOrders, result.CancelledExchangeOrders, result.ClosedPositions, len(result.Errors))
+	return result, nil
+}
+
+// Rearm 解除紧急停止，accountID 为 0 表示解除所有账户（包括单独停止的账户）
+func (e *Engine) Rearm(accountID int64) error {
+	if err := repository.DeleteKillSwitch(accountID); err != nil {
+		return fmt.Errorf("failed to delete kill switch: %w", err)
+	}
+
+	e.setHalted(accountID, false)
+	log.Printf("紧急停止已解除: AccountID=%d", accountID)
+	return nil
+}
+
+// Halted 判断账户是否处于紧急停止状态（全局停止时所有账户均处于停止状态）
+func (e *Engine) Halted(accountID int64) bool {
+	e.haltMu.RLock()
+	defer e.haltMu.RUnlock()
+
+	return e.haltAll || e.halted[accountID]
+}
+
+// HaltedAll 判断是否处于全局紧急停止状态
+func (e *Engine) HaltedAll() bool {
+	e.haltMu.RLock()
+	defer e.haltMu.RUnlock()
+
+	return e.haltAll
+}
+
+// setHalted 更新内存中的紧急停止状态
+func (e *Engine) setHalted(accountID int64, halted bool) {
+	e.haltMu.Lock()
+	defer e.haltMu.Unlock()
+
+	if accountID == 0 {
+		e.haltAll = halted
+		if !halted {
+			e.halted = make(map[int64]bool)
+		}
+		return
+	}
+
+	if halted {
+		e.halted[accountID] = true
+	} else {
+		delete(e.halted, accountID)
+	}
+}
+
+// loadKillSwitches 从数据库恢复紧急停止状态，保证引擎重启后仍保持停止
+func (e *Engine) loadKillSwitches() error {
+	list, err := repository.KillSwitchList()
+	if err != nil {
+		return fmt.Errorf("failed to load kill switches: %w", err)
+	}
+
+	for _, killSwitch := range list {
+		e.setHalted(killSwitch.AccountID, true)
+	}
+	return nil
+}
+
+// killSwitchAccounts 获取紧急停止涉及的账户
+func (e *Engine) killSwitchAccounts(accountID int64) ([]*model.FoxAccount, error) {
+	q := database.Adapter().FoxAccount
+	if accountID == 0 {
+		accounts, err := q.Find()
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("failed to get accounts: %w", err)
+		}
+		return accounts, nil
+	}
+
+	account, err := q.Where(q.ID.Eq(accountID)).First()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account %d: %w", accountID, err)
+	}
+	return []*model.FoxAccount{account}, nil
+}
+
+// flattenAccount 撤销账户在交易所的挂单并平掉所有仓位，失败的操作记录到结果中
+func (e *Engine) flattenAccount(account *model.FoxAccount, result *KillSwitchResult) {
+	fail := func(format string, args ...interface{}) {
+		message := fmt.Sprintf("[%s] ", account.Name) + fmt.Sprintf(format, args...)
+		log.Printf("紧急停止失败: %s", message)
+		result.Errors = append(result.Errors, message)
+	}
+
+	exchangeInstance, err := e.exchangeMgr.GetExchange(account.Exchange)
+	if err != nil {
+		fail("获取交易所失败: %v", err)
+		return
+	}
+	if err := exchangeInstance.Connect(e.ctx, account); err != nil {
+		if errors.Is(err, exchange.ErrNotSupported) {
+			return
+		}
+		fail("连接交易所失败: %v", err)
+		return
+	}
+
+	orders, err := exchangeInstance.GetOrders(e.ctx, "", "")
+	if err != nil {
+		fail("获取挂单失败: %v", err)
+	}
+	for i := range orders {
+		if err := exchangeInstance.CancelOrder(e.ctx, &orders[i]); err != nil {
+			fail("撤销挂单 %s 失败: %v", orders[i].ID, err)
+			continue
+		}
+		result.CancelledExchangeOrders++
+
+		if err := e.cancelSubmittedOrder(account.ID, &orders[i]); err != nil {
+			fail("更新挂单 %s 对应的策略订单失败: %v", orders[i].ID, err)
+		}
+	}
+
+	positions, err := exchangeInstance.GetPositions(e.ctx)
+	if err != nil {
+		fail("获取持仓失败: %v", err)
+		return
+	}
+	for _, position := range positions {
+		if size, err := decimal.NewFromString(position.Size); err == nil && size.IsZero() {
+			continue
+		}
+
+		err := exchangeInstance.ClosePosition(e.ctx, &exchange.ClosePosition{
+			Symbol:  position.Symbol,
+			Margin:  position.MarginType,
+			PosSide: position.PosSide,
+		})
+		if err != nil {
+			fail("平仓 %s %s 失败: %v", position.Symbol, position.PosSide, err)
+			continue
+		}
+		result.ClosedPositions++
+	}
+}
+
+// cancelSubmittedOrder 将已撤销交易所挂单对应的已提交策略订单置为 cancelled
+func (e *Engine) cancelSubmittedOrder(accountID int64, exchangeOrder *exchange.Order) error {
+	if exchangeOrder.OrderID == "" {
+		return nil
+	}
+
+	msg := "紧急停止：交易所挂单已撤销"
+	if exchangeOrder.Filled > 0 {
+		msg = fmt.Sprintf("紧急停止：交易所挂单已撤销（已成交 %v 张）", exchangeOrder.Filled)
+	}
+
+	q := database.Adapter().FoxOrder
+	_, err := q.Where(
+		q.AccountID.Eq(accountID),
+		q.OrderID.Eq(exchangeOrder.OrderID),
+		q.Status.In("opened", "closed"),
+	).UpdateSimple(q.Status.Value("cancelled"), q.Msg.Value(msg))
+	return err
+}
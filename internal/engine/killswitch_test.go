@@ -0,0 +1,45 @@
+package engine
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+func TestEngine_CancelSubmittedOrder(t *testing.T) {
+	initTestDB(t)
+
+	opened := createTestOrder(t, &model.FoxOrder{OrderID: "cl1", OrderType: "limit", Status: "opened"})
+	partial := createTestOrder(t, &model.FoxOrder{OrderID: "cl2", OrderType: "limit", Status: "opened"})
+	expired := createTestOrder(t, &model.FoxOrder{OrderID: "cl3", OrderType: "limit", Status: "expired"})
+
+	e := &Engine{ctx: context.Background()}
+	for _, exchangeOrder := range []exchange.Order{
+		{ID: "100", OrderID: "cl1"},
+		{ID: "101", OrderID: "cl2", Filled: 2, Remain: 3},
+		{ID: "102", OrderID: "cl3"},
+		{ID: "103"},
+	} {
+		if err := e.cancelSubmittedOrder(1, &exchangeOrder); err != nil {
+			t.Fatalf("cancelSubmittedOrder() error = %v", err)
+		}
+	}
+
+	for _, tt := range []struct {
+		order   *model.FoxOrder
+		status  string
+		wantMsg string
+	}{
+		{order: opened, status: "cancelled", wantMsg: "交易所挂单已撤销"},
+		{order: partial, status: "cancelled", wantMsg: "已成交 2"},
+		{order: expired, status: "expired"},
+	} {
+		got := getTestOrder(t, tt.order.ID)
+		if got.Status != tt.status || !strings.Contains(got.Msg, tt.wantMsg) {
+			t.Errorf("order %d = %s %q, want %s %q", got.ID, got.Status, got.Msg, tt.status, tt.wantMsg)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lemconn/foxflow/internal/engine/builtin"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/shopspring/decimal"
+)
+
+// restingOrderTypes 提交后挂在交易所订单簿上等待成交的订单类型（market、ioc、fok 提交后立即结束）
+var restingOrderTypes = []string{"limit", "post_only"}
+
+// triggerOrder 策略条件满足后提交订单，限价单先求值限价表达式
+func (e *Engine) triggerOrder(ctx context.Context, exchangeInstance exchange.Exchange, order *model.FoxOrder) error {
+	if order.PriceExpr != "" {
+		price, err := e.evalLimitPrice(ctx, exchangeInstance, order)
+		if err != nil {
+			return fmt.Errorf("failed to evaluate limit price: %w", err)
+		}
+		order.Price = price.String()
+	}
+
+	return e.submitOrder(exchangeInstance, order)
+}
+
+// evalLimitPrice 求值限价表达式，并按交易对价格精度取整（买单向下、卖单向上，保证委托价格不劣于表达式结果）
+func (e *Engine) evalLimitPrice(ctx context.Context, exchangeInstance exchange.Exchange, order *model.FoxOrder) (decimal.Decimal, error) {
+	node, err := e.syntaxEngine.Parse(order.PriceExpr)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to parse limit price: %w", err)
+	}
+
+	ctx = builtin.WithNow(ctx, e.now())
+	result, err := e.syntaxEngine.Execute(ctx, node)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var price decimal.Decimal
+	switch v := result.(type) {
+	case float64:
+		price = decimal.NewFromFloat(v)
+	case int:
+		price = decimal.NewFromInt(int64(v))
+	case int64:
+		price = decimal.NewFromInt(v)
+	case string:
+		price, err = decimal.NewFromString(v)
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("limit price is not a number: %s", v)
+		}
+	default:
+		return decimal.Zero, fmt.Errorf("limit price must be a number, got %T", result)
+	}
+
+	symbol, err := exchangeInstance.GetSymbols(e.ctx, order.Symbol)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get symbol info: %w", err)
+	}
+	price = roundToTick(price, symbol.TickSize, order.Side)
+
+	if !price.IsPositive() {
+		return decimal.Zero, fmt.Errorf("limit price must be positive, got %s", price.String())
+	}
+	return price, nil
+}
+
+// roundToTick 按价格精度取整，买单向下取整，卖单向上取整；价格精度无效时不取整
+func roundToTick(price decimal.Decimal, tickSize, side string) decimal.Decimal {
+	tick, err := decimal.NewFromString(tickSize)
+	if err != nil || !tick.IsPositive() {
+		return price
+	}
+
+	steps := price.Div(tick)
+	if side == "sell" {
+		steps = steps.Ceil()
+	} else {
+		steps = steps.Floor()
+	}
+	return steps.Mul(tick)
+}
@@ -0,0 +1,71 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/engine/syntax"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/shopspring/decimal"
+)
+
+// mockTickExchange 模拟交易所，仅返回交易对价格精度
+type mockTickExchange struct {
+	exchange.Exchange
+	tickSize string
+}
+
+func (m *mockTickExchange) GetSymbols(ctx context.Context, symbol string) (*exchange.Symbol, error) {
+	return &exchange.Symbol{Name: symbol, TickSize: m.tickSize}, nil
+}
+
+func TestRoundToTick(t *testing.T) {
+	tests := []struct {
+		price    string
+		tickSize string
+		side     string
+		want     string
+	}{
+		{price: "65012.37", tickSize: "0.1", side: "buy", want: "65012.3"},
+		{price: "65012.37", tickSize: "0.1", side: "sell", want: "65012.4"},
+		{price: "65012.3", tickSize: "0.1", side: "sell", want: "65012.3"},
+		{price: "0.123456", tickSize: "0.0005", side: "buy", want: "0.1230"},
+		{price: "65012.37", tickSize: "", side: "buy", want: "65012.37"},
+	}
+
+	for _, tt := range tests {
+		got := roundToTick(decimal.RequireFromString(tt.price), tt.tickSize, tt.side)
+		if !got.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("roundToTick(%s, %s, %s) = %s, want %s", tt.price, tt.tickSize, tt.side, got, tt.want)
+		}
+	}
+}
+
+func TestEngine_EvalLimitPrice(t *testing.T) {
+	e := &Engine{ctx: context.Background(), syntaxEngine: syntax.NewEngine(), clock: time.Now}
+	mock := &mockTickExchange{tickSize: "0.5"}
+
+	tests := []struct {
+		expr    string
+		side    string
+		want    string
+		wantErr bool
+	}{
+		{expr: "65000 * 0.998", side: "buy", want: "64870"},
+		{expr: "65000.3", side: "sell", want: "65000.5"},
+		{expr: "0 - 1", side: "buy", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		order := &model.FoxOrder{Symbol: "BTC-USDT-SWAP", Side: tt.side, PriceExpr: tt.expr}
+		got, err := e.evalLimitPrice(context.Background(), mock, order)
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("evalLimitPrice(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+		}
+		if !tt.wantErr && !got.Equal(decimal.RequireFromString(tt.want)) {
+			t.Errorf("evalLimitPrice(%q) = %s, want %s", tt.expr, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package engine
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/lemconn/foxflow/internal/config"
+	"github.com/lemconn/foxflow/internal/repository"
+)
+
+// enginePauseReason 手动暂停引擎时写入暂停记录的描述
+const enginePauseReason = "手动暂停引擎"
+
+// Pause 暂停引擎，暂停期间不处理任何策略订单（不会取消订单，恢复后继续处理）
+// 暂停状态持久化，引擎重启后仍保持暂停，需调用 Resume 才会恢复
+func (e *Engine) Pause() error {
+	e.pauseMu.Lock()
+	defer e.pauseMu.Unlock()
+
+	// 先在内存中标记暂停，持久化失败时本次运行仍保持暂停
+	if !e.paused {
+		log.Println("策略引擎已暂停")
+	}
+	e.paused = true
+
+	if err := repository.SaveEnginePause(enginePauseReason); err != nil {
+		return fmt.Errorf("failed to save engine pause: %w", err)
+	}
+	return nil
+}
+
+// Resume 恢复引擎处理策略订单（维护时间窗口内仍保持暂停）
+func (e *Engine) Resume() error {
+	e.pauseMu.Lock()
+	defer e.pauseMu.Unlock()
+
+	if err := repository.DeleteEnginePause(); err != nil {
+		return fmt.Errorf("failed to delete engine pause: %w", err)
+	}
+
+	if e.paused {
+		log.Println("策略引擎已恢复")
+	}
+	e.paused = false
+	return nil
+}
+
+// loadEnginePause 从数据库恢复引擎暂停状态，保证引擎重启后仍保持暂停
+func (e *Engine) loadEnginePause() error {
+	paused, err := repository.EnginePaused()
+	if err != nil {
+		return fmt.Errorf("failed to load engine pause: %w", err)
+	}
+
+	e.pauseMu.Lock()
+	defer e.pauseMu.Unlock()
+
+	e.paused = paused
+	if paused {
+		log.Println("策略引擎处于暂停状态，恢复后开始处理订单")
+	}
+	return nil
+}
+
+// Paused 判断引擎是否被手动暂停
+func (e *Engine) Paused() bool {
+	e.pauseMu.RLock()
+	defer e.pauseMu.RUnlock()
+
+	return e.paused
+}
+
+// InMaintenance 判断当前是否处于维护时间窗口
+func (e *Engine) InMaintenance() bool {
+	e.pauseMu.RLock()
+	defer e.pauseMu.RUnlock()
+
+	now := e.now()
+	for _, window := range e.windows {
+		if window.Contains(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetMaintenanceWindows 设置维护时间窗口，窗口内引擎自动暂停
+func (e *Engine) SetMaintenanceWindows(windows []config.MaintenanceWindow) {
+	e.pauseMu.Lock()
+	defer e.pauseMu.Unlock()
+
+	e.windows = windows
+}
+
+// suspended 判断本次检查周期是否跳过，进入或离开维护时间窗口时记录日志
+func (e *Engine) suspended() bool {
+	inMaintenance := e.InMaintenance()
+	if inMaintenance != e.maintenance {
+		e.maintenance = inMaintenance
+		if inMaintenance {
+			log.Println("进入维护时间窗口，策略引擎自动暂停")
+		} else {
+			log.Println("维护时间窗口结束，策略引擎恢复")
+		}
+	}
+
+	return e.Paused() || inMaintenance
+}
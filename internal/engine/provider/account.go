@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+type (
+	accountContextKey          struct{} // 上下文中存放策略所属账户的键
+	accountExchangesContextKey struct{} // 上下文中存放账户交易所实例缓存的键
+)
+
+// accountExchanges 按交易所缓存绑定策略账户的交易所实例，同一上下文中的多个账户、持仓字段共用一个实例
+type accountExchanges struct {
+	mu        sync.Mutex
+	exchanges map[string]exchange.Exchange
+}
+
+// WithAccount 将策略所属账户写入上下文
+// 账户、持仓等私有数据模块会使用该账户的凭证访问交易所，交易所实例在该上下文内只创建一次
+func WithAccount(ctx context.Context, account *model.FoxAccount) context.Context {
+	ctx = context.WithValue(ctx, accountContextKey{}, account)
+	return context.WithValue(ctx, accountExchangesContextKey{}, &accountExchanges{exchanges: make(map[string]exchange.Exchange)})
+}
+
+// AccountFromContext 从上下文中获取策略所属账户
+func AccountFromContext(ctx context.Context) (*model.FoxAccount, bool) {
+	account, ok := ctx.Value(accountContextKey{}).(*model.FoxAccount)
+	return account, ok && account != nil
+}
+
+// AccountProvider 账户资产数据模块
+type AccountProvider struct {
+	*BaseProvider
+	newExchange accountExchangeFunc
+}
+
+// NewAccountProvider 创建账户资产数据模块
+func NewAccountProvider() *AccountProvider {
+	return &AccountProvider{
+		BaseProvider: NewBaseProvider("account"),
+		newExchange:  exchange.GetManager().NewAccountExchange,
+	}
+}
+
+// GetData 获取数据
+// AccountProvider 使用上下文中订单所属账户的凭证实时获取资产数据
+// 字段格式为 "CCY.FIELD"，如 "USDT.available"
+func (p *AccountProvider) GetData(ctx context.Context, dataSource, field string, params ...interface{}) (interface{}, error) {
+	fieldParts := strings.Split(field, ".")
+	if len(fieldParts) < 2 {
+		return nil, fmt.Errorf("account field must be in format 'CCY.FIELD', got: %s", field)
+	}
+
+	currency := strings.ToUpper(fieldParts[0])
+	fieldName := fieldParts[1]
+
+	exchangeInstance, err := accountExchange(ctx, p.newExchange, dataSource)
+	if err != nil {
+		return nil, err
+	}
+
+	assets, err := exchangeInstance.GetBalance(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance from %s: %w", dataSource, err)
+	}
+
+	// 未持有该币种时视为 0
+	asset := exchange.Asset{Currency: currency}
+	for _, item := range assets {
+		if strings.EqualFold(item.Currency, currency) {
+			asset = item
+			break
+		}
+	}
+
+	switch fieldName {
+	case "available":
+		return parseAmount(asset.Available)
+	case "balance":
+		return parseAmount(asset.Balance)
+	case "frozen":
+		return parseAmount(asset.Frozen)
+	default:
+		return nil, fmt.Errorf("unknown field: %s", fieldName)
+	}
+}
+
+// accountExchangeFunc 创建绑定指定账户的交易所实例
+type accountExchangeFunc func(ctx context.Context, name string, account *model.FoxAccount) (exchange.Exchange, error)
+
+// accountExchange 获取上下文账户独立的交易所实例（不修改共享实例上的账户），同一上下文内复用已创建的实例
+func accountExchange(ctx context.Context, newExchange accountExchangeFunc, dataSource string) (exchange.Exchange, error) {
+	account, ok := AccountFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no account bound to strategy context")
+	}
+
+	if account.Exchange != dataSource {
+		return nil, fmt.Errorf("account %s belongs to exchange %s, cannot query %s", account.Name, account.Exchange, dataSource)
+	}
+
+	cache, _ := ctx.Value(accountExchangesContextKey{}).(*accountExchanges)
+	if cache != nil {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+
+		if exchangeInstance, ok := cache.exchanges[dataSource]; ok {
+			return exchangeInstance, nil
+		}
+	}
+
+	exchangeInstance, err := newExchange(ctx, dataSource, account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange %s for account %s: %w", dataSource, account.Name, err)
+	}
+
+	if cache != nil {
+		cache.exchanges[dataSource] = exchangeInstance
+	}
+	return exchangeInstance, nil
+}
+
+// parseAmount 将交易所返回的数值字符串转换为 float64，空字符串视为 0
+func parseAmount(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid numeric value %q: %w", value, err)
+	}
+
+	return amount, nil
+}
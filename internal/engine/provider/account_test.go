@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+// mockAccountExchange 模拟交易所，仅实现账户与持仓相关方法
+type mockAccountExchange struct {
+	exchange.Exchange
+	account   *model.FoxAccount
+	assets    []exchange.Asset
+	positions []exchange.Position
+}
+
+func (m *mockAccountExchange) SetAccount(ctx context.Context, account *model.FoxAccount) error {
+	m.account = account
+	return nil
+}
+
+func (m *mockAccountExchange) GetBalance(ctx context.Context) ([]exchange.Asset, error) {
+	if m.account == nil {
+		return nil, fmt.Errorf("account information is missing")
+	}
+	return m.assets, nil
+}
+
+func (m *mockAccountExchange) GetPositions(ctx context.Context) ([]exchange.Position, error) {
+	if m.account == nil {
+		return nil, fmt.Errorf("account information is missing")
+	}
+	return m.positions, nil
+}
+
+func (m *mockAccountExchange) GetSwapSymbolByName(ctx context.Context, coinName string) string {
+	return coinName + "-USDT-SWAP"
+}
+
+func newMockGetExchange(ex exchange.Exchange) func(name string) (exchange.Exchange, error) {
+	return func(name string) (exchange.Exchange, error) {
+		if name != "okx" {
+			return nil, fmt.Errorf("exchange %s not found", name)
+		}
+		return ex, nil
+	}
+}
+
+// newMockAccountExchange 返回绑定账户后的模拟交易所，模拟按账户创建交易所实例
+func newMockAccountExchange(ex exchange.Exchange) accountExchangeFunc {
+	return func(ctx context.Context, name string, account *model.FoxAccount) (exchange.Exchange, error) {
+		if name != "okx" {
+			return nil, fmt.Errorf("exchange %s not found", name)
+		}
+		if err := ex.SetAccount(ctx, account); err != nil {
+			return nil, err
+		}
+		return ex, nil
+	}
+}
+
+func TestAccountProviderGetData(t *testing.T) {
+	mock := &mockAccountExchange{
+		assets: []exchange.Asset{
+			{Currency: "USDT", Balance: "1200.5", Frozen: "200.5", Available: "1000"},
+		},
+	}
+	provider := NewAccountProvider()
+	provider.newExchange = newMockAccountExchange(mock)
+
+	account := &model.FoxAccount{ID: 1, Name: "test", Exchange: "okx"}
+	ctx := WithAccount(context.Background(), account)
+
+	testCases := []struct {
+		field    string
+		expected float64
+	}{
+		{"USDT.available", 1000},
+		{"USDT.balance", 1200.5},
+		{"USDT.frozen", 200.5},
+		{"BTC.available", 0},
+	}
+
+	for _, tc := range testCases {
+		data, err := provider.GetData(ctx, "okx", tc.field)
+		if err != nil {
+			t.Errorf("获取 %s 失败: %v", tc.field, err)
+			continue
+		}
+		if data != tc.expected {
+			t.Errorf("%s 期望 %v，实际 %v", tc.field, tc.expected, data)
+		}
+	}
+
+	if mock.account != account {
+		t.Error("应使用上下文中的账户访问交易所")
+	}
+}
+
+func TestAccountProviderGetDataErrors(t *testing.T) {
+	provider := NewAccountProvider()
+	provider.newExchange = newMockAccountExchange(&mockAccountExchange{})
+
+	// 上下文中没有账户
+	if _, err := provider.GetData(context.Background(), "okx", "USDT.available"); err == nil {
+		t.Error("上下文缺少账户时应返回错误")
+	}
+
+	ctx := WithAccount(context.Background(), &model.FoxAccount{Name: "test", Exchange: "okx"})
+
+	// 账户与数据源交易所不一致
+	if _, err := provider.GetData(ctx, "binance", "USDT.available"); err == nil {
+		t.Error("账户与交易所不匹配时应返回错误")
+	}
+
+	// 字段格式错误
+	if _, err := provider.GetData(ctx, "okx", "USDT"); err == nil {
+		t.Error("字段格式错误时应返回错误")
+	}
+
+	// 未知字段
+	if _, err := provider.GetData(ctx, "okx", "USDT.unknown"); err == nil {
+		t.Error("未知字段应返回错误")
+	}
+}
+
+func TestAccountExchangeCachedPerContext(t *testing.T) {
+	mock := &mockAccountExchange{
+		assets:    []exchange.Asset{{Currency: "USDT", Available: "1000"}},
+		positions: []exchange.Position{{Symbol: "BTC-USDT-SWAP", PosSide: "long", Size: "1"}},
+	}
+	created := 0
+	newExchange := func(ctx context.Context, name string, account *model.FoxAccount) (exchange.Exchange, error) {
+		created++
+		return newMockAccountExchange(mock)(ctx, name, account)
+	}
+
+	accountProvider := NewAccountProvider()
+	accountProvider.newExchange = newExchange
+	positionProvider := NewPositionProvider()
+	positionProvider.newExchange = newExchange
+
+	// 同一上下文中的多个账户、持仓字段共用一个交易所实例
+	ctx := WithAccount(context.Background(), &model.FoxAccount{ID: 1, Name: "test", Exchange: "okx"})
+	for _, field := range []string{"USDT.available", "USDT.balance"} {
+		if _, err := accountProvider.GetData(ctx, "okx", field); err != nil {
+			t.Fatalf("GetData(%s) error = %v", field, err)
+		}
+	}
+	if _, err := positionProvider.GetData(ctx, "okx", "BTC.size"); err != nil {
+		t.Fatalf("GetData(BTC.size) error = %v", err)
+	}
+	if created != 1 {
+		t.Errorf("创建交易所实例 %d 次，期望 1 次", created)
+	}
+
+	// 新的上下文（下一次求值）重新创建
+	ctx = WithAccount(context.Background(), &model.FoxAccount{ID: 1, Name: "test", Exchange: "okx"})
+	if _, err := accountProvider.GetData(ctx, "okx", "USDT.available"); err != nil {
+		t.Fatalf("GetData() error = %v", err)
+	}
+	if created != 2 {
+		t.Errorf("创建交易所实例 %d 次，期望 2 次", created)
+	}
+}
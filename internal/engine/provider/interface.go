@@ -81,6 +81,9 @@ func InitDefaultProviders() *Manager {
 	manager.RegisterProvider(NewKlineProvider())
 	manager.RegisterProvider(NewMarketProvider())
 	manager.RegisterProvider(NewNewsProvider())
+	manager.RegisterProvider(NewAccountProvider())
+	manager.RegisterProvider(NewPositionProvider())
+	manager.RegisterProvider(NewOrderBookProvider())
 
 	return manager
 }
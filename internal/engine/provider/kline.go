@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/lemconn/foxflow/internal/exchange"
@@ -34,6 +35,7 @@ func NewKlineProvider() *KlineProvider {
 // - params[1]: int - 历史数据周期数（必需）
 // - params[2]: time.Time - 开始时间（可选）
 // - params[3]: time.Time - 结束时间（可选）
+// 返回的序列按时间从早到晚排列
 func (p *KlineProvider) GetData(ctx context.Context, dataSource, field string, params ...interface{}) (interface{}, error) {
 	// 解析字段 - 支持多级字段如 "BTC.close"
 	fieldParts := strings.Split(field, ".")
@@ -92,6 +94,11 @@ func (p *KlineProvider) GetData(ctx context.Context, dataSource, field string, p
 		return nil, fmt.Errorf("failed to get kline data for %s %s %s: %w", dataSource, exchangeSymbol, exchangeInterval, err)
 	}
 
+	// 各交易所返回顺序不一致（OKX 为从新到旧），统一按时间从早到晚排列
+	sort.SliceStable(klineData, func(i, j int) bool {
+		return klineData[i].Timestamp.Before(klineData[j].Timestamp)
+	})
+
 	// 提取指定字段的历史数据
 	result := make([]interface{}, 0, len(klineData))
 	for _, kline := range klineData {
@@ -107,6 +114,8 @@ func (p *KlineProvider) GetData(ctx context.Context, dataSource, field string, p
 			value = kline.Close
 		case "volume":
 			value = kline.Volume
+		case "candles":
+			value = kline
 		default:
 			return nil, fmt.Errorf("unknown field: %s", fieldName)
 		}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/lemconn/foxflow/internal/exchange"
 )
@@ -11,24 +12,41 @@ import (
 // 使用 exchange 包中的 Ticker 类型
 type MarketData = exchange.Ticker
 
+// DefaultMaxStaleness 行情数据默认最大允许延迟
+const DefaultMaxStaleness = 30 * time.Second
+
 // MarketProvider 行情数据模块
 type MarketProvider struct {
 	*BaseProvider
-	exchangeMgr *exchange.Manager
+	exchangeMgr  *exchange.Manager
+	getExchange  func(name string) (exchange.Exchange, error)
+	maxStaleness time.Duration
+	now          func() time.Time
 }
 
 // NewMarketProvider 创建行情数据模块
 func NewMarketProvider() *MarketProvider {
+	exchangeMgr := exchange.GetManager()
 	module := &MarketProvider{
 		BaseProvider: NewBaseProvider("market"),
-		exchangeMgr:  exchange.GetManager(),
+		exchangeMgr:  exchangeMgr,
+		getExchange:  exchangeMgr.GetExchange,
+		maxStaleness: DefaultMaxStaleness,
+		now:          time.Now,
 	}
 
 	return module
 }
 
+// SetMaxStaleness 设置行情数据最大允许延迟，小于等于 0 表示不检查
+func (p *MarketProvider) SetMaxStaleness(d time.Duration) {
+	p.maxStaleness = d
+}
+
 // GetData 获取数据
 // MarketProvider 通过 exchange 实时获取行情数据
+// 每个数据源（交易所）独立获取，与订单所属账户的交易所无关，
+// 行情时间超过最大允许延迟时返回错误，避免跨交易所比较时使用过期数据
 // params 参数（可选）：
 // - 目前暂未使用，保留用于未来扩展
 func (p *MarketProvider) GetData(ctx context.Context, dataSource, field string, params ...interface{}) (interface{}, error) {
@@ -42,7 +60,7 @@ func (p *MarketProvider) GetData(ctx context.Context, dataSource, field string,
 	fieldName := fieldParts[1]
 
 	// 获取交易所实例
-	exchangeInstance, err := p.exchangeMgr.GetExchange(dataSource)
+	exchangeInstance, err := p.getExchange(dataSource)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get exchange %s: %w", dataSource, err)
 	}
@@ -50,6 +68,52 @@ func (p *MarketProvider) GetData(ctx context.Context, dataSource, field string,
 	// 使用 GetSwapSymbolByName 转换 symbol 参数
 	exchangeSymbol := exchangeInstance.GetSwapSymbolByName(ctx, symbol)
 
+	// 衍生品行情字段通过对应的公共接口获取
+	switch fieldName {
+	case "funding_rate", "next_funding_rate":
+		fundingRate, err := exchangeInstance.GetFundingRate(ctx, exchangeSymbol)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get funding rate for %s %s: %w", dataSource, exchangeSymbol, err)
+		}
+		if err := p.checkFresh(dataSource, exchangeSymbol, fundingRate.Timestamp); err != nil {
+			return nil, err
+		}
+		if fieldName == "next_funding_rate" {
+			return fundingRate.NextFundingRate, nil
+		}
+		return fundingRate.FundingRate, nil
+	case "mark_price":
+		markPrice, err := exchangeInstance.GetMarkPrice(ctx, exchangeSymbol)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get mark price for %s %s: %w", dataSource, exchangeSymbol, err)
+		}
+		if err := p.checkFresh(dataSource, exchangeSymbol, markPrice.Timestamp); err != nil {
+			return nil, err
+		}
+		return markPrice.MarkPrice, nil
+	case "index_price":
+		indexPrice, err := exchangeInstance.GetIndexPrice(ctx, exchangeSymbol)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get index price for %s %s: %w", dataSource, exchangeSymbol, err)
+		}
+		if err := p.checkFresh(dataSource, exchangeSymbol, indexPrice.Timestamp); err != nil {
+			return nil, err
+		}
+		return indexPrice.IndexPrice, nil
+	case "open_interest", "open_interest_usd":
+		openInterest, err := exchangeInstance.GetOpenInterest(ctx, exchangeSymbol)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get open interest for %s %s: %w", dataSource, exchangeSymbol, err)
+		}
+		if err := p.checkFresh(dataSource, exchangeSymbol, openInterest.Timestamp); err != nil {
+			return nil, err
+		}
+		if fieldName == "open_interest_usd" {
+			return openInterest.OpenInterestUsd, nil
+		}
+		return openInterest.OpenInterest, nil
+	}
+
 	// 通过 exchange 实时获取行情数据
 	ticker, err := exchangeInstance.GetTicker(ctx, exchangeSymbol)
 	if err != nil {
@@ -61,8 +125,14 @@ func (p *MarketProvider) GetData(ctx context.Context, dataSource, field string,
 		return nil, fmt.Errorf("symbol mismatch: expected %s, got %s", exchangeSymbol, ticker.Symbol)
 	}
 
+	if err := p.checkFresh(dataSource, exchangeSymbol, ticker.Timestamp); err != nil {
+		return nil, err
+	}
+
 	// 提取指定字段
 	switch fieldName {
+	case "timestamp":
+		return ticker.Timestamp, nil
 	case "price":
 		return ticker.Price, nil
 	case "volume":
@@ -75,3 +145,16 @@ func (p *MarketProvider) GetData(ctx context.Context, dataSource, field string,
 		return nil, fmt.Errorf("unknown field: %s", fieldName)
 	}
 }
+
+// checkFresh 检查行情数据是否过期，交易所未返回时间时不检查
+func (p *MarketProvider) checkFresh(dataSource, symbol string, ts time.Time) error {
+	if p.maxStaleness <= 0 || ts.IsZero() {
+		return nil
+	}
+
+	if age := p.now().Sub(ts); age > p.maxStaleness {
+		return fmt.Errorf("stale market data for %s %s: %s old exceeds %s", dataSource, symbol, age.Truncate(time.Millisecond), p.maxStaleness)
+	}
+
+	return nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/lemconn/foxflow/internal/exchange"
 	"github.com/shopspring/decimal"
 )
 
@@ -37,6 +39,10 @@ func TestMarketProviderGetDataValidFields(t *testing.T) {
 		{"okx", "BTC.volume"},
 		{"okx", "BTC.high"},
 		{"okx", "BTC.low"},
+		{"okx", "BTC.funding_rate"},
+		{"okx", "BTC.mark_price"},
+		{"okx", "BTC.index_price"},
+		{"okx", "BTC.open_interest"},
 	}
 
 	for _, tc := range testCases {
@@ -279,3 +285,58 @@ func TestMarketProviderGetDataWithContextTODO(t *testing.T) {
 		t.Errorf("使用 context.TODO() 获取的数据类型错误，期望 float64，实际 %T", data)
 	}
 }
+
+// mockMarketExchange 模拟交易所，仅实现行情相关方法
+type mockMarketExchange struct {
+	exchange.Exchange
+	symbolSuffix string
+	ticker       exchange.Ticker
+}
+
+func (m *mockMarketExchange) GetSwapSymbolByName(ctx context.Context, coinName string) string {
+	return coinName + m.symbolSuffix
+}
+
+func (m *mockMarketExchange) GetTicker(ctx context.Context, symbol string) (*exchange.Ticker, error) {
+	ticker := m.ticker
+	ticker.Symbol = symbol
+	return &ticker, nil
+}
+
+func TestMarketProviderStaleness(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	exchanges := map[string]exchange.Exchange{
+		"okx":     &mockMarketExchange{symbolSuffix: "-USDT-SWAP", ticker: exchange.Ticker{Price: "65000", Timestamp: now.Add(-2 * time.Second)}},
+		"binance": &mockMarketExchange{symbolSuffix: "USDT", ticker: exchange.Ticker{Price: "65080", Timestamp: now.Add(-time.Minute)}},
+	}
+
+	provider := NewMarketProvider()
+	provider.now = func() time.Time { return now }
+	provider.getExchange = func(name string) (exchange.Exchange, error) {
+		return exchanges[name], nil
+	}
+	ctx := context.Background()
+
+	// 各交易所独立获取
+	price, err := provider.GetData(ctx, "okx", "BTC.price")
+	if err != nil || price != "65000" {
+		t.Errorf("获取 okx 行情失败: %v, %v", price, err)
+	}
+
+	ts, err := provider.GetData(ctx, "okx", "BTC.timestamp")
+	if err != nil || ts != now.Add(-2*time.Second) {
+		t.Errorf("获取 okx 行情时间失败: %v, %v", ts, err)
+	}
+
+	// 超过最大延迟的数据视为过期
+	if _, err := provider.GetData(ctx, "binance", "BTC.price"); err == nil || !strings.Contains(err.Error(), "stale") {
+		t.Errorf("过期的 binance 行情应返回错误，实际 %v", err)
+	}
+
+	// 放宽最大延迟后可以获取
+	provider.SetMaxStaleness(2 * time.Minute)
+	price, err = provider.GetData(ctx, "binance", "BTC.price")
+	if err != nil || price != "65080" {
+		t.Errorf("获取 binance 行情失败: %v, %v", price, err)
+	}
+}
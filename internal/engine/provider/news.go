@@ -3,74 +3,154 @@ package provider
 import (
 	"context"
 	"fmt"
+	"log"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/lemconn/foxflow/internal/config"
 	"github.com/lemconn/foxflow/internal/news"
 )
 
+const (
+	// DefaultNewsPollInterval 默认新闻轮询间隔
+	DefaultNewsPollInterval = 5 * time.Minute
+
+	// newsFetchCount 每次轮询每个新闻源获取的新闻数量
+	newsFetchCount = 20
+
+	// newsBufferSize 每个新闻源保留的最近新闻数量
+	newsBufferSize = 200
+)
+
 // NewsData 新闻数据
 type NewsData struct {
-	Title    string    `json:"title"`     // 新闻标题
-	Content  string    `json:"content"`   // 新闻内容
-	Datetime time.Time `json:"datetime"`  // 发布时间
+	Title    string    `json:"title"`    // 新闻标题
+	Content  string    `json:"content"`  // 新闻内容
+	Datetime time.Time `json:"datetime"` // 发布时间
 }
 
+// NewsArchiveFunc 新闻归档函数，接收新闻源名称及本次轮询新增的新闻，返回实际归档数量
+type NewsArchiveFunc func(source string, items []news.NewsItem) (int, error)
+
 // NewsProvider 新闻数据模块
+// 按新闻源维护按 ID 去重的最近新闻缓冲区（从新到旧），避免两次轮询之间的新闻丢失
 type NewsProvider struct {
 	*BaseProvider
-	news     map[string]*NewsData
-	mu       sync.RWMutex
-	manager  *news.Manager
-	ctx      context.Context
-	cancel   context.CancelFunc
-	stopChan chan struct{}
+	news         map[string][]news.NewsItem
+	seen         map[string]map[string]struct{}
+	mu           sync.RWMutex
+	manager      *news.Manager
+	archive      NewsArchiveFunc
+	pollInterval time.Duration
+	intervalChan chan time.Duration
+	refreshChan  chan struct{}
+	ctx          context.Context
+	cancel       context.CancelFunc
+	stopChan     chan struct{}
 }
 
 // NewNewsProvider 创建新闻数据模块
+// 新闻管理器由引擎通过 SetManager 注入，与引擎共用同一组新闻源，注入前不轮询
 func NewNewsProvider() *NewsProvider {
+	return newNewsProvider(nil, newsPollInterval())
+}
+
+// newNewsProvider 使用指定的新闻管理器创建新闻数据模块并启动轮询
+func newNewsProvider(manager *news.Manager, pollInterval time.Duration) *NewsProvider {
 	ctx, cancel := context.WithCancel(context.Background())
-	
+
 	module := &NewsProvider{
 		BaseProvider: NewBaseProvider("news"),
-		news:         make(map[string]*NewsData),
-		manager:      news.NewManager(),
+		news:         make(map[string][]news.NewsItem),
+		seen:         make(map[string]map[string]struct{}),
+		manager:      manager,
+		pollInterval: pollInterval,
+		intervalChan: make(chan time.Duration, 1),
+		refreshChan:  make(chan struct{}, 1),
 		ctx:          ctx,
 		cancel:       cancel,
 		stopChan:     make(chan struct{}),
 	}
 
-	// 注册新闻源
-	module.registerNewsSources()
-	
 	// 启动协程定期更新新闻数据
 	go module.startNewsUpdater()
-	
+
 	return module
 }
 
-// registerNewsSources 注册所有可用的新闻源
-func (p *NewsProvider) registerNewsSources() {
-	// 注册 BlockBeats 新闻源
-	blockBeats := news.NewBlockBeats()
-	p.manager.RegisterSource(blockBeats)
-	
-	// 可以在这里添加更多新闻源
-	// 例如：coindesk, cointelegraph 等
+// newsPollInterval 获取配置的新闻轮询间隔
+func newsPollInterval() time.Duration {
+	if config.GlobalConfig != nil && config.GlobalConfig.NewsPollInterval > 0 {
+		return config.GlobalConfig.NewsPollInterval
+	}
+	return DefaultNewsPollInterval
+}
+
+// SetPollInterval 设置新闻轮询间隔，立即生效
+func (p *NewsProvider) SetPollInterval(interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("news poll interval must be positive, got %s", interval)
+	}
+
+	p.mu.Lock()
+	p.pollInterval = interval
+	p.mu.Unlock()
+
+	// 只保留最近一次设置
+	select {
+	case <-p.intervalChan:
+	default:
+	}
+	p.intervalChan <- interval
+
+	return nil
+}
+
+// SetManager 设置轮询使用的新闻管理器，并立即轮询一次
+func (p *NewsProvider) SetManager(manager *news.Manager) {
+	p.mu.Lock()
+	p.manager = manager
+	p.mu.Unlock()
+
+	select {
+	case p.refreshChan <- struct{}{}:
+	default:
+	}
+}
+
+// SetArchive 设置新闻归档函数，轮询获取的新增新闻会写入归档
+func (p *NewsProvider) SetArchive(archive NewsArchiveFunc) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.archive = archive
+}
+
+// GetPollInterval 获取新闻轮询间隔
+func (p *NewsProvider) GetPollInterval() time.Duration {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.pollInterval
 }
 
 // startNewsUpdater 启动新闻更新协程
 func (p *NewsProvider) startNewsUpdater() {
-	ticker := time.NewTicker(5 * time.Minute) // 每5分钟更新一次
+	ticker := time.NewTicker(p.GetPollInterval())
 	defer ticker.Stop()
-	
+
 	// 立即执行一次更新
 	p.updateNewsData()
-	
+
 	for {
 		select {
 		case <-ticker.C:
 			p.updateNewsData()
+		case interval := <-p.intervalChan:
+			ticker.Reset(interval)
+		case <-p.refreshChan:
+			p.updateNewsData()
 		case <-p.ctx.Done():
 			return
 		case <-p.stopChan:
@@ -81,60 +161,121 @@ func (p *NewsProvider) startNewsUpdater() {
 
 // updateNewsData 更新新闻数据
 func (p *NewsProvider) updateNewsData() {
+	p.mu.RLock()
+	manager := p.manager
+	p.mu.RUnlock()
+	if manager == nil {
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(p.ctx, 30*time.Second)
 	defer cancel()
-	
-	// 从所有新闻源获取最新新闻
-	allNews, err := p.manager.GetNewsFromAllSources(ctx, 1) // 每个源获取1条最新新闻
+
+	// 从所有新闻源获取最近的新闻
+	allNews, err := manager.GetNewsFromAllSources(ctx, newsFetchCount)
 	if err != nil {
-		fmt.Printf("获取新闻数据失败: %v\n", err)
+		log.Printf("获取新闻数据失败: %v", err)
 		return
 	}
-	
+
 	p.mu.Lock()
-	defer p.mu.Unlock()
-	
-	// 更新每个新闻源的数据
+	added := make(map[string][]news.NewsItem, len(allNews))
 	for sourceName, newsItems := range allNews {
-		if len(newsItems) > 0 {
-			// 获取最新的一条新闻
-			latestNews := newsItems[0]
-			
-			// 直接转换为 NewsData 格式
-			p.news[sourceName] = &NewsData{
-				Title:    latestNews.Title,
-				Content:  latestNews.Content,
-				Datetime: latestNews.PublishedAt,
-			}
+		added[sourceName] = p.mergeNews(sourceName, newsItems)
+	}
+	archive := p.archive
+	p.mu.Unlock()
+
+	if archive == nil {
+		return
+	}
+	for sourceName, newsItems := range added {
+		if len(newsItems) == 0 {
+			continue
+		}
+		if _, err := archive(sourceName, newsItems); err != nil {
+			log.Printf("归档新闻失败: source=%s, err=%v", sourceName, err)
 		}
 	}
 }
 
+// mergeNews 将新获取的新闻按 ID 去重后合并到缓冲区，返回新增的新闻，调用方需持有写锁
+func (p *NewsProvider) mergeNews(sourceName string, items []news.NewsItem) []news.NewsItem {
+	seen, exists := p.seen[sourceName]
+	if !exists {
+		seen = make(map[string]struct{})
+		p.seen[sourceName] = seen
+	}
+
+	var added []news.NewsItem
+	buffer := p.news[sourceName]
+	for _, item := range items {
+		key := newsKey(item)
+		if _, dup := seen[key]; dup {
+			continue
+		}
+		seen[key] = struct{}{}
+		buffer = append(buffer, item)
+		added = append(added, item)
+	}
+
+	// 按发布时间从新到旧排列，超出容量的旧新闻移出缓冲区
+	sort.SliceStable(buffer, func(i, j int) bool {
+		return buffer[i].PublishedAt.After(buffer[j].PublishedAt)
+	})
+	if len(buffer) > newsBufferSize {
+		for _, item := range buffer[newsBufferSize:] {
+			delete(seen, newsKey(item))
+		}
+		buffer = buffer[:newsBufferSize]
+	}
 
+	p.news[sourceName] = buffer
+	return added
+}
 
+// newsKey 新闻去重键，缺少 ID 时使用标题和发布时间
+func newsKey(item news.NewsItem) string {
+	if item.ID != "" {
+		return item.ID
+	}
+	return item.Title + "|" + item.PublishedAt.String()
+}
 
 // GetData 获取数据
-// NewsProvider 只支持单个数据值，不支持历史数据
+// title、content、datetime、sentiment（情绪分值 [-1, 1]）、symbols（提及的币种列表）字段返回最新一条新闻；
+// 不指定字段（如 news.blockbeats）时返回缓冲区中的全部新闻（[]news.NewsItem，从新到旧），供 news_count 等函数使用
 // params 参数（可选）：
 // - 目前暂未使用，保留用于未来扩展
 func (p *NewsProvider) GetData(ctx context.Context, dataSource, field string, params ...interface{}) (interface{}, error) {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
 
-
-	newsData, exists := p.news[dataSource]
-	if !exists {
+	items := p.news[dataSource]
+	if len(items) == 0 {
 		return nil, fmt.Errorf("no news data found for data source: %s", dataSource)
 	}
 
+	latest := items[0]
+
 	// News 模块支持简单字段名，不需要多级字段
 	switch field {
+	case "", "items":
+		result := make([]news.NewsItem, len(items))
+		copy(result, items)
+		return result, nil
 	case "title":
-		return newsData.Title, nil
+		return latest.Title, nil
 	case "content":
-		return newsData.Content, nil
+		return latest.Content, nil
 	case "datetime":
-		return newsData.Datetime, nil
+		return latest.PublishedAt, nil
+	case "sentiment":
+		return latest.Sentiment, nil
+	case "symbols":
+		symbols := make([]string, len(latest.Symbols))
+		copy(symbols, latest.Symbols)
+		return symbols, nil
 	default:
 		return nil, fmt.Errorf("unknown field: %s", field)
 	}
@@ -145,5 +286,3 @@ func (p *NewsProvider) Stop() {
 	p.cancel()
 	close(p.stopChan)
 }
-
-
@@ -0,0 +1,226 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/news"
+)
+
+// mockNewsSource 模拟新闻源，返回可替换的新闻列表
+type mockNewsSource struct {
+	mu    sync.Mutex
+	items []news.NewsItem
+}
+
+func (m *mockNewsSource) GetName() string {
+	return "mock"
+}
+
+func (m *mockNewsSource) GetDisplayName() string {
+	return "Mock"
+}
+
+func (m *mockNewsSource) GetNews(ctx context.Context, count int) ([]news.NewsItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.items) > count {
+		return m.items[:count], nil
+	}
+	return m.items, nil
+}
+
+func (m *mockNewsSource) IsHealthy(ctx context.Context) bool {
+	return true
+}
+
+func (m *mockNewsSource) setItems(items ...news.NewsItem) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.items = items
+}
+
+func newMockNewsProvider(t *testing.T, source *mockNewsSource) *NewsProvider {
+	t.Helper()
+
+	manager := news.NewManager()
+	manager.RegisterSource(source)
+
+	p := newNewsProvider(manager, time.Hour)
+	t.Cleanup(p.Stop)
+	return p
+}
+
+func TestNewsProviderRollingBuffer(t *testing.T) {
+	base := time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC)
+	source := &mockNewsSource{}
+	source.setItems(
+		news.NewsItem{ID: "2", Title: "Exchange hack drains hot wallet", PublishedAt: base.Add(-5 * time.Minute)},
+		news.NewsItem{ID: "1", Title: "Bitcoin ETF inflows", PublishedAt: base.Add(-20 * time.Minute)},
+	)
+	p := newMockNewsProvider(t, source)
+	p.updateNewsData()
+
+	// 两次轮询之间出现的新闻与已有新闻合并，重复 ID 只保留一条
+	source.setItems(
+		news.NewsItem{ID: "3", Title: "Protocol hack post-mortem", PublishedAt: base.Add(-1 * time.Minute)},
+		news.NewsItem{ID: "2", Title: "Exchange hack drains hot wallet", PublishedAt: base.Add(-5 * time.Minute)},
+	)
+	p.updateNewsData()
+
+	data, err := p.GetData(context.Background(), "mock", "")
+	if err != nil {
+		t.Fatalf("获取新闻缓冲区失败: %v", err)
+	}
+
+	items := data.([]news.NewsItem)
+	if len(items) != 3 {
+		t.Fatalf("期望缓冲区有 3 条新闻，实际 %d", len(items))
+	}
+	for i, id := range []string{"3", "2", "1"} {
+		if items[i].ID != id {
+			t.Errorf("第 %d 条新闻期望 ID %s，实际 %s", i, id, items[i].ID)
+		}
+	}
+
+	title, err := p.GetData(context.Background(), "mock", "title")
+	if err != nil || title != "Protocol hack post-mortem" {
+		t.Errorf("期望 title 返回最新新闻标题，实际 %v, %v", title, err)
+	}
+}
+
+func TestNewsProviderSetManager(t *testing.T) {
+	p := newNewsProvider(nil, time.Hour)
+	t.Cleanup(p.Stop)
+
+	// 未注入新闻管理器时不轮询
+	p.updateNewsData()
+	if _, err := p.GetData(context.Background(), "mock", ""); err == nil {
+		t.Fatal("期望未注入新闻管理器时没有新闻数据")
+	}
+
+	source := &mockNewsSource{}
+	source.setItems(news.NewsItem{ID: "1", Title: "Bitcoin ETF inflows", PublishedAt: time.Now()})
+	manager := news.NewManager()
+	manager.RegisterSource(source)
+
+	// 注入后立即轮询一次，不必等待轮询间隔
+	p.SetManager(manager)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		title, err := p.GetData(context.Background(), "mock", "title")
+		if err == nil && title == "Bitcoin ETF inflows" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("期望注入新闻管理器后立即轮询，实际 %v, %v", title, err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestNewsProviderBufferLimit(t *testing.T) {
+	base := time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC)
+	p := newMockNewsProvider(t, &mockNewsSource{})
+
+	p.mu.Lock()
+	for i := 0; i < newsBufferSize+10; i++ {
+		p.mergeNews("mock", []news.NewsItem{{ID: time.Duration(i).String(), PublishedAt: base.Add(time.Duration(i) * time.Second)}})
+	}
+	p.mu.Unlock()
+
+	data, err := p.GetData(context.Background(), "mock", "items")
+	if err != nil {
+		t.Fatalf("获取新闻缓冲区失败: %v", err)
+	}
+
+	items := data.([]news.NewsItem)
+	if len(items) != newsBufferSize {
+		t.Errorf("期望缓冲区保留 %d 条新闻，实际 %d", newsBufferSize, len(items))
+	}
+	if !items[len(items)-1].PublishedAt.Equal(base.Add(10 * time.Second)) {
+		t.Errorf("期望移出最旧的新闻，实际最旧的为 %v", items[len(items)-1].PublishedAt)
+	}
+}
+
+func TestNewsProviderPollInterval(t *testing.T) {
+	p := newMockNewsProvider(t, &mockNewsSource{})
+
+	if err := p.SetPollInterval(0); err == nil {
+		t.Error("期望非正数的轮询间隔报错")
+	}
+
+	if err := p.SetPollInterval(30 * time.Second); err != nil {
+		t.Fatalf("设置轮询间隔失败: %v", err)
+	}
+	if p.GetPollInterval() != 30*time.Second {
+		t.Errorf("期望轮询间隔为 30s，实际 %s", p.GetPollInterval())
+	}
+}
+
+func TestNewsProviderArchive(t *testing.T) {
+	base := time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC)
+	source := &mockNewsSource{}
+	p := newMockNewsProvider(t, source)
+
+	var mu sync.Mutex
+	archived := make(map[string]int)
+	p.SetArchive(func(sourceName string, items []news.NewsItem) (int, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if sourceName != "mock" {
+			t.Errorf("期望归档新闻源 mock，实际 %s", sourceName)
+		}
+		for _, item := range items {
+			archived[item.ID]++
+		}
+		return len(items), nil
+	})
+
+	// 只归档新增的新闻，重复轮询到的新闻不会再次归档
+	source.setItems(
+		news.NewsItem{ID: "2", Title: "Exchange hack drains hot wallet", PublishedAt: base.Add(-5 * time.Minute)},
+		news.NewsItem{ID: "1", Title: "Bitcoin ETF inflows", PublishedAt: base.Add(-20 * time.Minute)},
+	)
+	p.updateNewsData()
+	p.updateNewsData()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		mu.Lock()
+		done := archived["1"] > 0 && archived["2"] > 0
+		mu.Unlock()
+		if done || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if archived["1"] != 1 || archived["2"] != 1 || len(archived) != 2 {
+		t.Errorf("期望每条新闻只归档一次，实际 %v", archived)
+	}
+}
+
+func TestNewsProviderSentimentFields(t *testing.T) {
+	source := &mockNewsSource{}
+	source.setItems(news.NewsItem{ID: "1", Title: "SEC approves spot Bitcoin ETF", PublishedAt: time.Now()})
+	p := newMockNewsProvider(t, source)
+	p.updateNewsData()
+
+	sentiment, err := p.GetData(context.Background(), "mock", "sentiment")
+	if err != nil || sentiment.(float64) <= 0.6 {
+		t.Errorf("期望利好新闻情绪分值大于 0.6，实际 %v, %v", sentiment, err)
+	}
+
+	symbols, err := p.GetData(context.Background(), "mock", "symbols")
+	if err != nil || len(symbols.([]string)) != 1 || symbols.([]string)[0] != "BTC" {
+		t.Errorf("期望识别出 BTC，实际 %v, %v", symbols, err)
+	}
+}
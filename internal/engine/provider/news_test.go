@@ -10,7 +10,7 @@ import (
 
 func TestNewsProviderIntegration(t *testing.T) {
 	// 创建新闻提供者
-	provider := NewNewsProvider()
+	provider := newBlockBeatsNewsProvider()
 	defer provider.Stop()
 
 	// 等待一段时间让协程有时间获取数据
@@ -64,7 +64,7 @@ func TestNewsProviderIntegration(t *testing.T) {
 }
 
 func TestNewsProviderStop(t *testing.T) {
-	provider := NewNewsProvider()
+	provider := newBlockBeatsNewsProvider()
 	
 	// 测试停止功能
 	provider.Stop()
@@ -113,7 +113,7 @@ func TestConvertToNewsData(t *testing.T) {
 }
 
 func TestNewsProviderGetDataInvalidField(t *testing.T) {
-	provider := NewNewsProvider()
+	provider := newBlockBeatsNewsProvider()
 	defer provider.Stop()
 	
 	// 等待一段时间让协程获取数据
@@ -134,7 +134,7 @@ func TestNewsProviderGetDataInvalidField(t *testing.T) {
 }
 
 func TestNewsProviderGetDataInvalidSource(t *testing.T) {
-	provider := NewNewsProvider()
+	provider := newBlockBeatsNewsProvider()
 	defer provider.Stop()
 	
 	ctx := context.Background()
@@ -152,7 +152,7 @@ func TestNewsProviderGetDataInvalidSource(t *testing.T) {
 }
 
 func TestNewsProviderGetDataAllFields(t *testing.T) {
-	provider := NewNewsProvider()
+	provider := newBlockBeatsNewsProvider()
 	defer provider.Stop()
 	
 	// 等待一段时间让协程获取数据
@@ -195,7 +195,7 @@ func TestNewsProviderGetDataAllFields(t *testing.T) {
 }
 
 func TestNewsProviderConcurrentAccess(t *testing.T) {
-	provider := NewNewsProvider()
+	provider := newBlockBeatsNewsProvider()
 	defer provider.Stop()
 	
 	// 等待一段时间让协程获取数据
@@ -226,3 +226,12 @@ func TestNewsProviderConcurrentAccess(t *testing.T) {
 	t.Log("并发访问测试完成")
 }
 
+// newBlockBeatsNewsProvider 创建轮询 BlockBeats 的新闻数据模块
+func newBlockBeatsNewsProvider() *NewsProvider {
+	manager := news.NewManager()
+	manager.RegisterSource(news.NewBlockBeats())
+
+	provider := NewNewsProvider()
+	provider.SetManager(manager)
+	return provider
+}
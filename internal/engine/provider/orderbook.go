@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+)
+
+// 使用 exchange 包中的 OrderBook 类型
+type OrderBookData = exchange.OrderBook
+
+// orderBookDepth 默认获取的深度档位数量
+const orderBookDepth = 400
+
+// OrderBookProvider 订单簿深度数据模块
+type OrderBookProvider struct {
+	*BaseProvider
+	getExchange func(name string) (exchange.Exchange, error)
+}
+
+// NewOrderBookProvider 创建订单簿深度数据模块
+func NewOrderBookProvider() *OrderBookProvider {
+	return &OrderBookProvider{
+		BaseProvider: NewBaseProvider("orderbook"),
+		getExchange:  exchange.GetManager().GetExchange,
+	}
+}
+
+// GetData 获取数据
+// OrderBookProvider 通过 exchange 实时获取订单簿深度
+// 字段格式为 "SYMBOL.FIELD"，支持：
+// - book: 完整订单簿，供 spread、depth、imbalance 等函数使用
+// - best_bid / best_ask: 买一价 / 卖一价
+// params 参数：
+// - 由外层函数传入（如 depth 的 side、pct），本模块不使用
+func (p *OrderBookProvider) GetData(ctx context.Context, dataSource, field string, params ...interface{}) (interface{}, error) {
+	fieldParts := strings.Split(field, ".")
+	if len(fieldParts) < 2 {
+		return nil, fmt.Errorf("orderbook field must be in format 'SYMBOL.FIELD', got: %s", field)
+	}
+
+	symbol := fieldParts[0]
+	fieldName := fieldParts[1]
+
+	switch fieldName {
+	case "book", "best_bid", "best_ask":
+	default:
+		return nil, fmt.Errorf("unknown field: %s", fieldName)
+	}
+
+	exchangeInstance, err := p.getExchange(dataSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange %s: %w", dataSource, err)
+	}
+
+	exchangeSymbol := exchangeInstance.GetSwapSymbolByName(ctx, symbol)
+
+	book, err := exchangeInstance.GetOrderBook(ctx, exchangeSymbol, orderBookDepth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order book for %s %s: %w", dataSource, exchangeSymbol, err)
+	}
+
+	switch fieldName {
+	case "best_bid":
+		if len(book.Bids) == 0 {
+			return nil, fmt.Errorf("order book for %s has no bids", exchangeSymbol)
+		}
+		return book.Bids[0].Price, nil
+	case "best_ask":
+		if len(book.Asks) == 0 {
+			return nil, fmt.Errorf("order book for %s has no asks", exchangeSymbol)
+		}
+		return book.Asks[0].Price, nil
+	default:
+		return book, nil
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+)
+
+// mockOrderBookExchange 模拟交易所，仅实现订单簿相关方法
+type mockOrderBookExchange struct {
+	exchange.Exchange
+	book *exchange.OrderBook
+}
+
+func (m *mockOrderBookExchange) GetOrderBook(ctx context.Context, symbol string, depth int) (*exchange.OrderBook, error) {
+	book := *m.book
+	book.Symbol = symbol
+	return &book, nil
+}
+
+func (m *mockOrderBookExchange) GetSwapSymbolByName(ctx context.Context, coinName string) string {
+	return coinName + "-USDT-SWAP"
+}
+
+func TestOrderBookProviderGetData(t *testing.T) {
+	provider := NewOrderBookProvider()
+	provider.getExchange = newMockGetExchange(&mockOrderBookExchange{
+		book: &exchange.OrderBook{
+			Bids: []exchange.OrderBookLevel{{Price: "99.9", Size: "10"}, {Price: "99.5", Size: "20"}},
+			Asks: []exchange.OrderBookLevel{{Price: "100.1", Size: "5"}, {Price: "100.6", Size: "8"}},
+		},
+	})
+	ctx := context.Background()
+
+	data, err := provider.GetData(ctx, "okx", "BTC.book")
+	if err != nil {
+		t.Fatalf("获取订单簿失败: %v", err)
+	}
+	book, ok := data.(*exchange.OrderBook)
+	if !ok {
+		t.Fatalf("期望 *exchange.OrderBook 类型，实际 %T", data)
+	}
+	if book.Symbol != "BTC-USDT-SWAP" || len(book.Bids) != 2 || len(book.Asks) != 2 {
+		t.Errorf("订单簿内容错误: %+v", book)
+	}
+
+	bestBid, err := provider.GetData(ctx, "okx", "BTC.best_bid")
+	if err != nil || bestBid != "99.9" {
+		t.Errorf("买一价错误: %v, %v", bestBid, err)
+	}
+
+	bestAsk, err := provider.GetData(ctx, "okx", "BTC.best_ask")
+	if err != nil || bestAsk != "100.1" {
+		t.Errorf("卖一价错误: %v, %v", bestAsk, err)
+	}
+
+	if _, err := provider.GetData(ctx, "okx", "BTC.unknown"); err == nil {
+		t.Error("未知字段应返回错误")
+	}
+
+	if _, err := provider.GetData(ctx, "binance", "BTC.book"); err == nil {
+		t.Error("不存在的交易所应返回错误")
+	}
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+)
+
+// PositionProvider 持仓数据模块
+type PositionProvider struct {
+	*BaseProvider
+	newExchange accountExchangeFunc
+}
+
+// NewPositionProvider 创建持仓数据模块
+func NewPositionProvider() *PositionProvider {
+	return &PositionProvider{
+		BaseProvider: NewBaseProvider("position"),
+		newExchange:  exchange.GetManager().NewAccountExchange,
+	}
+}
+
+// GetData 获取数据
+// PositionProvider 使用上下文中订单所属账户的凭证实时获取持仓数据
+// 字段格式为 "SYMBOL.FIELD"，如 "BTC.unreal_pnl"
+// params 参数（可选）：
+// - params[0]: 持仓方向 (string)，如 "long"、"short"，不传时汇总该标的全部持仓
+func (p *PositionProvider) GetData(ctx context.Context, dataSource, field string, params ...interface{}) (interface{}, error) {
+	fieldParts := strings.Split(field, ".")
+	if len(fieldParts) < 2 {
+		return nil, fmt.Errorf("position field must be in format 'SYMBOL.FIELD', got: %s", field)
+	}
+
+	symbol := fieldParts[0]
+	fieldName := fieldParts[1]
+
+	posSide := ""
+	if len(params) > 0 {
+		side, ok := params[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("position pos_side parameter must be string, got %T", params[0])
+		}
+		posSide = side
+	}
+
+	exchangeInstance, err := accountExchange(ctx, p.newExchange, dataSource)
+	if err != nil {
+		return nil, err
+	}
+
+	exchangeSymbol := exchangeInstance.GetSwapSymbolByName(ctx, symbol)
+
+	positions, err := exchangeInstance.GetPositions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get positions from %s: %w", dataSource, err)
+	}
+
+	// 汇总匹配的持仓，无持仓时各字段均为 0
+	var size, unrealPnl, cost float64
+	for _, position := range positions {
+		if position.Symbol != exchangeSymbol {
+			continue
+		}
+		if posSide != "" && position.PosSide != posSide {
+			continue
+		}
+
+		positionSize, err := parseAmount(position.Size)
+		if err != nil {
+			return nil, err
+		}
+		avgPrice, err := parseAmount(position.AvgPrice)
+		if err != nil {
+			return nil, err
+		}
+		pnl, err := parseAmount(position.UnrealPnl)
+		if err != nil {
+			return nil, err
+		}
+
+		size += math.Abs(positionSize)
+		cost += math.Abs(positionSize) * avgPrice
+		unrealPnl += pnl
+	}
+
+	switch fieldName {
+	case "size":
+		return size, nil
+	case "avg_price":
+		if size == 0 {
+			return 0.0, nil
+		}
+		return cost / size, nil
+	case "unreal_pnl":
+		return unrealPnl, nil
+	default:
+		return nil, fmt.Errorf("unknown field: %s", fieldName)
+	}
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+func TestPositionProviderGetData(t *testing.T) {
+	mock := &mockAccountExchange{
+		positions: []exchange.Position{
+			{Symbol: "BTC-USDT-SWAP", PosSide: "long", MarginType: "isolated", Size: "3", AvgPrice: "60000", UnrealPnl: "150"},
+			{Symbol: "BTC-USDT-SWAP", PosSide: "short", MarginType: "cross", Size: "1", AvgPrice: "64000", UnrealPnl: "100"},
+			{Symbol: "ETH-USDT-SWAP", PosSide: "long", MarginType: "cross", Size: "10", AvgPrice: "3000", UnrealPnl: "-20"},
+		},
+	}
+	provider := NewPositionProvider()
+	provider.newExchange = newMockAccountExchange(mock)
+
+	ctx := WithAccount(context.Background(), &model.FoxAccount{ID: 1, Name: "test", Exchange: "okx"})
+
+	testCases := []struct {
+		field    string
+		params   []interface{}
+		expected float64
+	}{
+		{"BTC.unreal_pnl", nil, 250},
+		{"BTC.size", nil, 4},
+		{"BTC.avg_price", nil, 61000},
+		{"BTC.unreal_pnl", []interface{}{"long"}, 150},
+		{"BTC.avg_price", []interface{}{"short"}, 64000},
+		{"ETH.unreal_pnl", nil, -20},
+		{"SOL.size", nil, 0},
+		{"SOL.avg_price", nil, 0},
+	}
+
+	for _, tc := range testCases {
+		data, err := provider.GetData(ctx, "okx", tc.field, tc.params...)
+		if err != nil {
+			t.Errorf("获取 %s 失败: %v", tc.field, err)
+			continue
+		}
+		if data != tc.expected {
+			t.Errorf("%s %v 期望 %v，实际 %v", tc.field, tc.params, tc.expected, data)
+		}
+	}
+
+	if _, err := provider.GetData(ctx, "okx", "BTC.unknown"); err == nil {
+		t.Error("未知字段应返回错误")
+	}
+
+	if _, err := provider.GetData(context.Background(), "okx", "BTC.size"); err == nil {
+		t.Error("上下文缺少账户时应返回错误")
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"testing"
 	"time"
+
+	"github.com/lemconn/foxflow/internal/news"
 )
 
 func TestDataManager(t *testing.T) {
@@ -13,7 +15,7 @@ func TestDataManager(t *testing.T) {
 
 	// 测试列出所有模块
 	modules := manager.ListProviders()
-	expectedProviders := []string{"kline", "market", "news"}
+	expectedProviders := []string{"kline", "market", "news", "account", "position", "orderbook"}
 
 	if len(modules) != len(expectedProviders) {
 		t.Errorf("期望 %d 个模块，但得到 %d 个", len(expectedProviders), len(modules))
@@ -105,6 +107,15 @@ func TestNewsProvider(t *testing.T) {
 	manager := InitDefaultProviders()
 	ctx := context.Background()
 
+	// 新闻数据模块轮询前需要注入新闻管理器
+	newsProvider, err := manager.GetProvider("news")
+	if err != nil {
+		t.Fatalf("获取新闻提供者失败: %v", err)
+	}
+	newsManager := news.NewManager()
+	newsManager.RegisterSource(news.NewBlockBeats())
+	newsProvider.(*NewsProvider).SetManager(newsManager)
+
 	// 等待一段时间让新闻提供者获取数据
 	time.Sleep(2 * time.Second)
 
@@ -99,11 +99,41 @@ func DefaultRegistry() *Registry {
 	registry.RegisterBuiltin(builtin.NewMaxBuiltin())
 	registry.RegisterBuiltin(builtin.NewMinBuiltin())
 	registry.RegisterBuiltin(builtin.NewSumBuiltin())
+	registry.RegisterBuiltin(builtin.NewSpreadBuiltin())
+	registry.RegisterBuiltin(builtin.NewDepthBuiltin())
+	registry.RegisterBuiltin(builtin.NewImbalanceBuiltin())
+	registry.RegisterBuiltin(builtin.NewHoldBuiltin())
+	registry.RegisterBuiltin(builtin.NewWithinBuiltin())
+	registry.RegisterBuiltin(builtin.NewNowBuiltin())
+	registry.RegisterBuiltin(builtin.NewHourBuiltin())
+	registry.RegisterBuiltin(builtin.NewWeekdayBuiltin())
+	registry.RegisterBuiltin(builtin.NewBetweenBuiltin())
+	registry.RegisterBuiltin(builtin.NewStddevBuiltin())
+	registry.RegisterBuiltin(builtin.NewZscoreBuiltin())
+	registry.RegisterBuiltin(builtin.NewPercentileBuiltin())
+	registry.RegisterBuiltin(builtin.NewRocBuiltin())
+	registry.RegisterBuiltin(builtin.NewRsiBuiltin())
+	registry.RegisterBuiltin(builtin.NewCorrBuiltin())
+	registry.RegisterBuiltin(builtin.NewEngulfingBuiltin())
+	registry.RegisterBuiltin(builtin.NewHammerBuiltin())
+	registry.RegisterBuiltin(builtin.NewDojiBuiltin())
+	registry.RegisterBuiltin(builtin.NewThreeWhiteSoldiersBuiltin())
+	registry.RegisterBuiltin(builtin.NewInsideBarBuiltin())
+	registry.RegisterBuiltin(builtin.NewIfBuiltin())
+	registry.RegisterBuiltin(builtin.NewAnyBuiltin())
+	registry.RegisterBuiltin(builtin.NewAllBuiltin())
+	registry.RegisterBuiltin(builtin.NewCountBuiltin())
+	registry.RegisterBuiltin(builtin.NewMatchBuiltin())
+	registry.RegisterBuiltin(builtin.NewHasAnyBuiltin())
+	registry.RegisterBuiltin(builtin.NewNewsCountBuiltin())
 
 	// 注册默认数据源
 	registry.RegisterProvider(provider.NewKlineProvider())
 	registry.RegisterProvider(provider.NewMarketProvider())
 	registry.RegisterProvider(provider.NewNewsProvider())
+	registry.RegisterProvider(provider.NewAccountProvider())
+	registry.RegisterProvider(provider.NewPositionProvider())
+	registry.RegisterProvider(provider.NewOrderBookProvider())
 
 	return registry
 }
@@ -0,0 +1,186 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/shopspring/decimal"
+)
+
+// Order 待提交订单的风控信息
+type Order struct {
+	Symbol   string          // 交易所标的，如 BTC-USDT-SWAP
+	Coin     string          // 币种名称，如 BTC
+	PosSide  string          // 持仓方向：long / short
+	Notional decimal.Decimal // 订单名义价值（USDT）
+	Lever    int64           // 杠杆倍数
+}
+
+// Rule 风控规则
+// 检查不通过时返回 *Violation，获取账户数据失败等其他错误不视为拦截
+type Rule interface {
+	Name() string
+	Check(order *Order, account *Account) error
+}
+
+// Violation 风控规则不通过的拦截原因
+type Violation struct {
+	Rule   string
+	Reason string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("风控拦截[%s]: %s", v.Rule, v.Reason)
+}
+
+// reject 创建拦截原因，规则名称由 Manager 填充
+func reject(format string, args ...interface{}) error {
+	return &Violation{Reason: fmt.Sprintf(format, args...)}
+}
+
+// Manager 风控管理器，按顺序执行所有规则，任一规则不通过即拦截订单
+type Manager struct {
+	rules []Rule
+}
+
+// NewManager 创建风控管理器
+func NewManager(rules ...Rule) *Manager {
+	return &Manager{rules: rules}
+}
+
+// AddRule 添加风控规则
+func (m *Manager) AddRule(rule Rule) {
+	m.rules = append(m.rules, rule)
+}
+
+// Rules 获取所有风控规则
+func (m *Manager) Rules() []Rule {
+	return m.rules
+}
+
+// Check 检查订单是否满足所有风控规则
+func (m *Manager) Check(order *Order, account *Account) error {
+	for _, rule := range m.rules {
+		err := rule.Check(order, account)
+		if err == nil {
+			continue
+		}
+
+		var violation *Violation
+		if errors.As(err, &violation) {
+			if violation.Rule == "" {
+				violation.Rule = rule.Name()
+			}
+			return violation
+		}
+		return fmt.Errorf("风控规则 %s 检查失败: %w", rule.Name(), err)
+	}
+	return nil
+}
+
+// Account 账户状态，按需从交易所加载并在一次检查内复用
+type Account struct {
+	ctx      context.Context
+	exchange exchange.Exchange
+	now      time.Time
+
+	positions       []exchange.Position
+	positionsLoaded bool
+	dailyPnl        decimal.Decimal
+	dailyPnlLoaded  bool
+}
+
+// NewAccount 创建账户状态，now 用于确定当日已实现盈亏的统计起点
+func NewAccount(ctx context.Context, exchangeInstance exchange.Exchange, now time.Time) *Account {
+	return &Account{
+		ctx:      ctx,
+		exchange: exchangeInstance,
+		now:      now,
+	}
+}
+
+// Positions 获取当前持仓（忽略持仓数量为 0 的仓位）
+func (a *Account) Positions() ([]exchange.Position, error) {
+	if a.positionsLoaded {
+		return a.positions, nil
+	}
+
+	positions, err := a.exchange.GetPositions(a.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("获取持仓失败: %w", err)
+	}
+
+	a.positions = make([]exchange.Position, 0, len(positions))
+	for _, position := range positions {
+		size, err := decimal.NewFromString(position.Size)
+		if err == nil && size.IsZero() {
+			continue
+		}
+		a.positions = append(a.positions, position)
+	}
+	a.positionsLoaded = true
+
+	return a.positions, nil
+}
+
+// Exposure 获取当前持仓的总名义价值
+func (a *Account) Exposure() (decimal.Decimal, error) {
+	positions, err := a.Positions()
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	exposure := decimal.Zero
+	for _, position := range positions {
+		if position.Notional == "" {
+			continue
+		}
+		notional, err := decimal.NewFromString(position.Notional)
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("持仓 %s 名义价值解析失败: %w", position.Symbol, err)
+		}
+		exposure = exposure.Add(notional.Abs())
+	}
+
+	return exposure, nil
+}
+
+// DailyRealizedPnl 获取当日（本地时区零点起）的已实现盈亏
+func (a *Account) DailyRealizedPnl() (decimal.Decimal, error) {
+	if a.dailyPnlLoaded {
+		return a.dailyPnl, nil
+	}
+
+	year, month, day := a.now.Date()
+	startOfDay := time.Date(year, month, day, 0, 0, 0, 0, a.now.Location())
+
+	value, err := a.exchange.GetRealizedPnl(a.ctx, startOfDay)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("获取已实现盈亏失败: %w", err)
+	}
+
+	pnl, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("已实现盈亏解析失败: %w", err)
+	}
+
+	a.dailyPnl = pnl
+	a.dailyPnlLoaded = true
+	return pnl, nil
+}
+
+// ParseSymbols 解析逗号分隔的标的列表（大写、去除空白）
+func ParseSymbols(value string) []string {
+	var symbols []string
+	for _, symbol := range strings.Split(value, ",") {
+		symbol = strings.ToUpper(strings.TrimSpace(symbol))
+		if symbol != "" {
+			symbols = append(symbols, symbol)
+		}
+	}
+	return symbols
+}
@@ -0,0 +1,223 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/shopspring/decimal"
+)
+
+// mockRiskExchange 模拟交易所，仅实现风控需要的持仓与盈亏方法
+type mockRiskExchange struct {
+	exchange.Exchange
+	positions      []exchange.Position
+	realizedPnl    string
+	err            error
+	positionCalls  int
+	pnlCalls       int
+	realizedPnlDay time.Time
+}
+
+func (m *mockRiskExchange) GetPositions(ctx context.Context) ([]exchange.Position, error) {
+	m.positionCalls++
+	return m.positions, m.err
+}
+
+func (m *mockRiskExchange) GetRealizedPnl(ctx context.Context, since time.Time) (string, error) {
+	m.pnlCalls++
+	m.realizedPnlDay = since
+	return m.realizedPnl, m.err
+}
+
+func newTestOrder(symbol, posSide, notional string, lever int64) *Order {
+	return &Order{
+		Symbol:   symbol,
+		Coin:     strings.TrimSuffix(symbol, "-USDT-SWAP"),
+		PosSide:  posSide,
+		Notional: decimal.RequireFromString(notional),
+		Lever:    lever,
+	}
+}
+
+func TestManager_Check(t *testing.T) {
+	now := time.Date(2025, 1, 6, 15, 30, 0, 0, time.UTC)
+	mock := &mockRiskExchange{
+		positions: []exchange.Position{
+			{Symbol: "BTC-USDT-SWAP", PosSide: "long", Size: "2", Notional: "2000"},
+			{Symbol: "ETH-USDT-SWAP", PosSide: "short", Size: "-5", Notional: "-1500"},
+			{Symbol: "SOL-USDT-SWAP", PosSide: "long", Size: "0", Notional: "0"},
+		},
+		realizedPnl: "-120.5",
+	}
+
+	config := &model.FoxRiskRule{
+		MaxOrderNotional: "1000",
+		MaxExposure:      "5000",
+		MaxLeverage:      20,
+		MaxOpenPositions: 2,
+		MaxDailyLoss:     "200",
+		DenySymbols:      "DOGE,PEPE-USDT-SWAP",
+	}
+	rules, err := RulesFromConfig(config)
+	if err != nil {
+		t.Fatalf("RulesFromConfig() error = %v", err)
+	}
+	if len(rules) != 6 {
+		t.Fatalf("RulesFromConfig() rules = %d, want 6", len(rules))
+	}
+	manager := NewManager(rules...)
+
+	tests := []struct {
+		name     string
+		order    *Order
+		wantRule string
+	}{
+		{name: "passes", order: newTestOrder("BTC-USDT-SWAP", "long", "800", 10)},
+		{name: "order notional", order: newTestOrder("BTC-USDT-SWAP", "long", "1200", 10), wantRule: "max_order_notional"},
+		{name: "leverage", order: newTestOrder("BTC-USDT-SWAP", "long", "500", 50), wantRule: "max_leverage"},
+		{name: "deny coin", order: newTestOrder("DOGE-USDT-SWAP", "long", "100", 5), wantRule: "symbols"},
+		{name: "deny symbol", order: newTestOrder("PEPE-USDT-SWAP", "long", "100", 5), wantRule: "symbols"},
+		{name: "new position over limit", order: newTestOrder("SOL-USDT-SWAP", "long", "100", 5), wantRule: "max_open_positions"},
+		{name: "add to existing position", order: newTestOrder("ETH-USDT-SWAP", "short", "100", 5)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := manager.Check(tt.order, NewAccount(context.Background(), mock, now))
+			if tt.wantRule == "" {
+				if err != nil {
+					t.Errorf("Check() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var violation *Violation
+			if !errors.As(err, &violation) {
+				t.Fatalf("Check() error = %v, want violation", err)
+			}
+			if violation.Rule != tt.wantRule {
+				t.Errorf("Check() rule = %s, want %s (%s)", violation.Rule, tt.wantRule, violation.Reason)
+			}
+		})
+	}
+
+	// 当日亏损统计从本地零点开始
+	if !mock.realizedPnlDay.Equal(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("GetRealizedPnl() since = %v, want start of day", mock.realizedPnlDay)
+	}
+}
+
+func TestManager_CheckExposureAndDailyLoss(t *testing.T) {
+	mock := &mockRiskExchange{
+		positions: []exchange.Position{
+			{Symbol: "BTC-USDT-SWAP", PosSide: "long", Size: "2", Notional: "2000"},
+			{Symbol: "ETH-USDT-SWAP", PosSide: "short", Size: "-5", Notional: "-1500"},
+		},
+		realizedPnl: "-250",
+	}
+	order := newTestOrder("BTC-USDT-SWAP", "long", "800", 10)
+
+	// 持仓总名义价值：2000 + 1500 + 800 = 4300
+	err := NewManager(&MaxExposureRule{Limit: decimal.NewFromInt(4000)}).Check(order, NewAccount(context.Background(), mock, time.Now()))
+	var violation *Violation
+	if !errors.As(err, &violation) || violation.Rule != "max_exposure" || !strings.Contains(violation.Reason, "4300.00") {
+		t.Errorf("Check() exposure error = %v", err)
+	}
+	if err := NewManager(&MaxExposureRule{Limit: decimal.NewFromInt(4300)}).Check(order, NewAccount(context.Background(), mock, time.Now())); err != nil {
+		t.Errorf("Check() exposure at limit error = %v, want nil", err)
+	}
+
+	err = NewManager(&MaxDailyLossRule{Limit: decimal.NewFromInt(200)}).Check(order, NewAccount(context.Background(), mock, time.Now()))
+	if !errors.As(err, &violation) || violation.Rule != "max_daily_loss" {
+		t.Errorf("Check() daily loss error = %v", err)
+	}
+
+	mock.realizedPnl = "300"
+	if err := NewManager(&MaxDailyLossRule{Limit: decimal.NewFromInt(200)}).Check(order, NewAccount(context.Background(), mock, time.Now())); err != nil {
+		t.Errorf("Check() daily profit error = %v, want nil", err)
+	}
+}
+
+func TestManager_CheckAccountError(t *testing.T) {
+	mock := &mockRiskExchange{err: errors.New("network error")}
+	manager := NewManager(&MaxOpenPositionsRule{Limit: 1})
+
+	// 获取账户数据失败不视为拦截
+	err := manager.Check(newTestOrder("BTC-USDT-SWAP", "long", "100", 5), NewAccount(context.Background(), mock, time.Now()))
+	var violation *Violation
+	if err == nil || errors.As(err, &violation) {
+		t.Errorf("Check() error = %v, want non-violation error", err)
+	}
+}
+
+func TestAccount_LoadsOnce(t *testing.T) {
+	mock := &mockRiskExchange{
+		positions:   []exchange.Position{{Symbol: "BTC-USDT-SWAP", PosSide: "long", Size: "1", Notional: "1000"}},
+		realizedPnl: "0",
+	}
+	manager := NewManager(
+		&MaxExposureRule{Limit: decimal.NewFromInt(10000)},
+		&MaxOpenPositionsRule{Limit: 5},
+		&MaxDailyLossRule{Limit: decimal.NewFromInt(100)},
+	)
+
+	if err := manager.Check(newTestOrder("ETH-USDT-SWAP", "long", "100", 5), NewAccount(context.Background(), mock, time.Now())); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if mock.positionCalls != 1 || mock.pnlCalls != 1 {
+		t.Errorf("exchange calls = positions %d, pnl %d, want 1, 1", mock.positionCalls, mock.pnlCalls)
+	}
+
+	// 未配置需要账户数据的规则时不查询交易所
+	mock.positionCalls, mock.pnlCalls = 0, 0
+	if err := NewManager(&MaxLeverageRule{Limit: 10}).Check(newTestOrder("ETH-USDT-SWAP", "long", "100", 5), NewAccount(context.Background(), mock, time.Now())); err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if mock.positionCalls != 0 || mock.pnlCalls != 0 {
+		t.Errorf("exchange calls = positions %d, pnl %d, want 0, 0", mock.positionCalls, mock.pnlCalls)
+	}
+}
+
+func TestSymbolListRule(t *testing.T) {
+	rule := &SymbolListRule{Allow: ParseSymbols("btc, ETH-USDT-SWAP"), Deny: ParseSymbols("eth")}
+
+	tests := []struct {
+		symbol  string
+		allowed bool
+	}{
+		{symbol: "BTC-USDT-SWAP", allowed: true},
+		{symbol: "ETH-USDT-SWAP", allowed: false}, // 黑名单优先
+		{symbol: "SOL-USDT-SWAP", allowed: false},
+	}
+
+	for _, tt := range tests {
+		err := rule.Check(newTestOrder(tt.symbol, "long", "100", 5), nil)
+		if (err == nil) != tt.allowed {
+			t.Errorf("Check(%s) error = %v, want allowed %v", tt.symbol, err, tt.allowed)
+		}
+	}
+}
+
+func TestRulesFromConfig(t *testing.T) {
+	rules, err := RulesFromConfig(nil)
+	if err != nil || len(rules) != 0 {
+		t.Errorf("RulesFromConfig(nil) = %v, %v, want no rules", rules, err)
+	}
+
+	rules, err = RulesFromConfig(&model.FoxRiskRule{MaxOrderNotional: "0", MaxExposure: ""})
+	if err != nil || len(rules) != 0 {
+		t.Errorf("RulesFromConfig(disabled) = %v, %v, want no rules", rules, err)
+	}
+
+	if _, err := RulesFromConfig(&model.FoxRiskRule{MaxDailyLoss: "abc"}); err == nil {
+		t.Error("RulesFromConfig(invalid) error = nil, want error")
+	}
+	if _, err := RulesFromConfig(&model.FoxRiskRule{MaxExposure: "-1"}); err == nil {
+		t.Error("RulesFromConfig(negative) error = nil, want error")
+	}
+}
@@ -0,0 +1,197 @@
+package risk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/shopspring/decimal"
+)
+
+// MaxOrderNotionalRule 单笔订单最大名义价值
+type MaxOrderNotionalRule struct {
+	Limit decimal.Decimal
+}
+
+func (r *MaxOrderNotionalRule) Name() string { return "max_order_notional" }
+
+func (r *MaxOrderNotionalRule) Check(order *Order, account *Account) error {
+	if order.Notional.GreaterThan(r.Limit) {
+		return reject("订单名义价值 %s 超过上限 %s", order.Notional.StringFixed(2), r.Limit.String())
+	}
+	return nil
+}
+
+// MaxExposureRule 持仓总名义价值上限（含本次订单）
+type MaxExposureRule struct {
+	Limit decimal.Decimal
+}
+
+func (r *MaxExposureRule) Name() string { return "max_exposure" }
+
+func (r *MaxExposureRule) Check(order *Order, account *Account) error {
+	exposure, err := account.Exposure()
+	if err != nil {
+		return err
+	}
+
+	total := exposure.Add(order.Notional)
+	if total.GreaterThan(r.Limit) {
+		return reject("持仓总名义价值 %s（当前 %s + 本单 %s）超过上限 %s",
+			total.StringFixed(2), exposure.StringFixed(2), order.Notional.StringFixed(2), r.Limit.String())
+	}
+	return nil
+}
+
+// MaxLeverageRule 最大杠杆倍数
+type MaxLeverageRule struct {
+	Limit int64
+}
+
+func (r *MaxLeverageRule) Name() string { return "max_leverage" }
+
+func (r *MaxLeverageRule) Check(order *Order, account *Account) error {
+	if order.Lever > r.Limit {
+		return reject("杠杆倍数 %d 超过上限 %d", order.Lever, r.Limit)
+	}
+	return nil
+}
+
+// MaxOpenPositionsRule 最大持仓数量（对已有仓位加仓不计入新增）
+type MaxOpenPositionsRule struct {
+	Limit int
+}
+
+func (r *MaxOpenPositionsRule) Name() string { return "max_open_positions" }
+
+func (r *MaxOpenPositionsRule) Check(order *Order, account *Account) error {
+	positions, err := account.Positions()
+	if err != nil {
+		return err
+	}
+
+	for _, position := range positions {
+		if position.Symbol == order.Symbol && (position.PosSide == order.PosSide || position.PosSide == "net") {
+			return nil
+		}
+	}
+
+	if len(positions)+1 > r.Limit {
+		return reject("持仓数量已达上限 %d", r.Limit)
+	}
+	return nil
+}
+
+// MaxDailyLossRule 当日最大已实现亏损，达到上限后禁止开仓
+type MaxDailyLossRule struct {
+	Limit decimal.Decimal
+}
+
+func (r *MaxDailyLossRule) Name() string { return "max_daily_loss" }
+
+func (r *MaxDailyLossRule) Check(order *Order, account *Account) error {
+	pnl, err := account.DailyRealizedPnl()
+	if err != nil {
+		return err
+	}
+
+	loss := pnl.Neg()
+	if loss.GreaterThanOrEqual(r.Limit) {
+		return reject("当日已实现亏损 %s 已达上限 %s", loss.StringFixed(2), r.Limit.String())
+	}
+	return nil
+}
+
+// SymbolListRule 标的白名单/黑名单，支持币种名称（如 BTC）或交易所标的（如 BTC-USDT-SWAP）
+type SymbolListRule struct {
+	Allow []string
+	Deny  []string
+}
+
+func (r *SymbolListRule) Name() string { return "symbols" }
+
+func (r *SymbolListRule) Check(order *Order, account *Account) error {
+	if matchSymbol(r.Deny, order) {
+		return reject("标的 %s 在禁止交易列表中", order.Symbol)
+	}
+	if len(r.Allow) > 0 && !matchSymbol(r.Allow, order) {
+		return reject("标的 %s 不在允许交易列表中", order.Symbol)
+	}
+	return nil
+}
+
+// matchSymbol 判断订单标的是否在列表中
+func matchSymbol(symbols []string, order *Order) bool {
+	for _, symbol := range symbols {
+		if strings.EqualFold(symbol, order.Symbol) || strings.EqualFold(symbol, order.Coin) {
+			return true
+		}
+	}
+	return false
+}
+
+// RulesFromConfig 根据账户风控配置构建规则，0 或空值表示不启用对应规则
+func RulesFromConfig(config *model.FoxRiskRule) ([]Rule, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	var rules []Rule
+
+	allow := ParseSymbols(config.AllowSymbols)
+	deny := ParseSymbols(config.DenySymbols)
+	if len(allow) > 0 || len(deny) > 0 {
+		rules = append(rules, &SymbolListRule{Allow: allow, Deny: deny})
+	}
+
+	if config.MaxLeverage > 0 {
+		rules = append(rules, &MaxLeverageRule{Limit: config.MaxLeverage})
+	}
+
+	limit, err := parseLimit("max_order_notional", config.MaxOrderNotional)
+	if err != nil {
+		return nil, err
+	}
+	if limit.IsPositive() {
+		rules = append(rules, &MaxOrderNotionalRule{Limit: limit})
+	}
+
+	// 以下规则需要查询交易所，放在本地规则之后
+	limit, err = parseLimit("max_exposure", config.MaxExposure)
+	if err != nil {
+		return nil, err
+	}
+	if limit.IsPositive() {
+		rules = append(rules, &MaxExposureRule{Limit: limit})
+	}
+
+	if config.MaxOpenPositions > 0 {
+		rules = append(rules, &MaxOpenPositionsRule{Limit: int(config.MaxOpenPositions)})
+	}
+
+	limit, err = parseLimit("max_daily_loss", config.MaxDailyLoss)
+	if err != nil {
+		return nil, err
+	}
+	if limit.IsPositive() {
+		rules = append(rules, &MaxDailyLossRule{Limit: limit})
+	}
+
+	return rules, nil
+}
+
+// parseLimit 解析金额类风控上限，空值表示不限制
+func parseLimit(name, value string) (decimal.Decimal, error) {
+	if value == "" {
+		return decimal.Zero, nil
+	}
+
+	limit, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("风控配置 %s 无效: %s", name, value)
+	}
+	if limit.IsNegative() {
+		return decimal.Zero, fmt.Errorf("风控配置 %s 不能为负数: %s", name, value)
+	}
+	return limit, nil
+}
@@ -2,6 +2,7 @@ package routine
 
 import (
 	"context"
+	"errors"
 	"log"
 	"strings"
 
@@ -31,6 +32,10 @@ func InitExchangeSymbols() {
 
 				// 获取交易对信息，优先获取永续合约
 				symbols, err := ex.GetAllSymbols(context.Background(), "SWAP")
+				if errors.Is(err, exchange.ErrNotSupported) {
+					// 仅提供公共行情的交易所没有可交易的标的
+					return
+				}
 				if err != nil {
 					log.Printf("获取交易所 %s 交易对信息失败: %v", name, err)
 					return
@@ -0,0 +1,205 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron 五段式 cron 表达式（分 时 日 月 周），按时间所在时区计算
+// 支持 *、列表（1,15）、范围（1-5）、步长（*/15、0-30/10）及月份、星期的英文缩写（JAN、MON），星期 0 和 7 均表示周日
+// 日和周均被限定时满足其一即可（与标准 cron 一致）
+type Cron struct {
+	expr   string
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	anyDom bool
+	anyDow bool
+}
+
+// field cron 字段的取值范围
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = field{name: "分钟", min: 0, max: 59}
+	hourField   = field{name: "小时", min: 0, max: 23}
+	domField    = field{name: "日期", min: 1, max: 31}
+	monthField  = field{name: "月份", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	dowField = field{name: "星期", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+// descriptors 预定义的 cron 表达式
+var descriptors = map[string]string{
+	"@hourly":  "0 * * * *",
+	"@daily":   "0 0 * * *",
+	"@weekly":  "0 0 * * 0",
+	"@monthly": "0 0 1 * *",
+	"@yearly":  "0 0 1 1 *",
+}
+
+// maxSearchYears 查找下次执行时间的最大年数（如 2 月 30 日永远不会执行）
+const maxSearchYears = 5
+
+// Parse 解析 cron 表达式
+func Parse(expr string) (*Cron, error) {
+	expr = strings.TrimSpace(expr)
+	spec := expr
+	if descriptor, ok := descriptors[strings.ToLower(expr)]; ok {
+		spec = descriptor
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron 表达式需包含 5 个字段（分 时 日 月 周）: %s", expr)
+	}
+
+	c := &Cron{expr: expr}
+	var err error
+	if c.minute, err = parseField(fields[0], minuteField); err != nil {
+		return nil, err
+	}
+	if c.hour, err = parseField(fields[1], hourField); err != nil {
+		return nil, err
+	}
+	if c.dom, err = parseField(fields[2], domField); err != nil {
+		return nil, err
+	}
+	if c.month, err = parseField(fields[3], monthField); err != nil {
+		return nil, err
+	}
+	if c.dow, err = parseField(fields[4], dowField); err != nil {
+		return nil, err
+	}
+
+	// 星期 7 等同于 0（周日）
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	c.anyDom = fields[2] == "*" || fields[2] == "?"
+	c.anyDow = fields[4] == "*" || fields[4] == "?"
+
+	return c, nil
+}
+
+// String 返回原始表达式
+func (c *Cron) String() string {
+	return c.expr
+}
+
+// Next 返回晚于 t 的下一次执行时间（精确到分钟），找不到时返回零值
+func (c *Cron) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	loc := t.Location()
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// matchDay 判断日期是否满足日、周字段
+func (c *Cron) matchDay(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case c.anyDom && c.anyDow:
+		return true
+	case c.anyDom:
+		return dowMatch
+	case c.anyDow:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
+
+// parseField 解析单个字段，返回按位表示的取值集合
+func parseField(value string, f field) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(value, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			parsed, err := strconv.Atoi(stepPart)
+			if err != nil || parsed <= 0 {
+				return 0, fmt.Errorf("%s字段步长无效: %s", f.name, part)
+			}
+			step = parsed
+		}
+
+		start, end := f.min, f.max
+		switch {
+		case rangePart == "*" || rangePart == "?":
+		case strings.Contains(rangePart, "-"):
+			low, high, _ := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = f.parseValue(low); err != nil {
+				return 0, err
+			}
+			if end, err = f.parseValue(high); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("%s字段范围无效: %s", f.name, part)
+			}
+		default:
+			var err error
+			if start, err = f.parseValue(rangePart); err != nil {
+				return 0, err
+			}
+			// 单个值带步长时表示从该值到最大值（如 5/15）
+			if !hasStep {
+				end = start
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+// parseValue 解析字段中的单个数值或英文缩写
+func (f field) parseValue(value string) (int, error) {
+	if n, ok := f.names[strings.ToUpper(value)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("%s字段取值无效: %s（范围 %d-%d）", f.name, value, f.min, f.max)
+	}
+	return n, nil
+}
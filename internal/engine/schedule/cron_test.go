@@ -0,0 +1,64 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr bool
+	}{
+		{expr: "0 9 * * MON"},
+		{expr: "*/15 0-6,22 1,15 JAN-MAR 1-5"},
+		{expr: "@weekly"},
+		{expr: "0 9 * *", wantErr: true},
+		{expr: "60 9 * * *", wantErr: true},
+		{expr: "0 9 * * FUN", wantErr: true},
+		{expr: "0 9 10-1 * *", wantErr: true},
+		{expr: "*/0 * * * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := Parse(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCron_Next(t *testing.T) {
+	// 2025-07-02 为周三
+	from := time.Date(2025, 7, 2, 10, 30, 20, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{expr: "0 9 * * MON", want: time.Date(2025, 7, 7, 9, 0, 0, 0, time.UTC)},
+		{expr: "0 9 * * 3", want: time.Date(2025, 7, 9, 9, 0, 0, 0, time.UTC)},
+		{expr: "*/15 * * * *", want: time.Date(2025, 7, 2, 10, 45, 0, 0, time.UTC)},
+		{expr: "30 10 * * *", want: time.Date(2025, 7, 3, 10, 30, 0, 0, time.UTC)},
+		{expr: "0 0 1 * *", want: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 8 31 * *", want: time.Date(2025, 7, 31, 8, 0, 0, 0, time.UTC)},
+		{expr: "0 12 1 * 7", want: time.Date(2025, 7, 6, 12, 0, 0, 0, time.UTC)},
+		{expr: "0 0 29 2 *", want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{expr: "@daily", want: time.Date(2025, 7, 3, 0, 0, 0, 0, time.UTC)},
+		{expr: "0 0 30 2 *", want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			c, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.expr, err)
+			}
+			if got := c.Next(from); !got.Equal(tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
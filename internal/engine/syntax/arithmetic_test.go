@@ -0,0 +1,101 @@
+package syntax
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/engine/registry"
+)
+
+// MockMultiExchangeMarket 模拟多个交易所的行情数据源
+type MockMultiExchangeMarket struct {
+	prices map[string]map[string]interface{}
+}
+
+func (m *MockMultiExchangeMarket) GetName() string {
+	return "market"
+}
+
+func (m *MockMultiExchangeMarket) GetData(ctx context.Context, dataSource, field string, params ...interface{}) (interface{}, error) {
+	if fields, exists := m.prices[dataSource]; exists {
+		if value, exists := fields[field]; exists {
+			return value, nil
+		}
+	}
+	return nil, fmt.Errorf("no data found for %s %s", dataSource, field)
+}
+
+func newArithmeticEvaluator() *Evaluator {
+	reg := registry.NewRegistry()
+	reg.RegisterProvider(&MockMultiExchangeMarket{
+		prices: map[string]map[string]interface{}{
+			"okx":     {"BTC.price": "65000.5", "BTC.volume": "1000"},
+			"binance": {"BTC.price": "65080.5"},
+		},
+	})
+	return NewEvaluator(reg)
+}
+
+func TestArithmeticExpressions(t *testing.T) {
+	evaluator := newArithmeticEvaluator()
+	parser := NewParser()
+	ctx := context.Background()
+
+	testCases := []struct {
+		expr     string
+		expected interface{}
+	}{
+		{"1 + 2 * 3", 7.0},
+		{"(1 + 2) * 3", 9.0},
+		{"10 - 4 - 3", 3.0},
+		{"12 / 4 / 3", 1.0},
+		{"-5 + 2", -3.0},
+		{"-(2 + 3) * 2", -10.0},
+		{"market.binance.BTC.price - market.okx.BTC.price", 80.0},
+		{"market.binance.BTC.price - market.okx.BTC.price > 50", true},
+		{"market.binance.BTC.price - market.okx.BTC.price > 100", false},
+		{"market.okx.BTC.price * 2 > market.binance.BTC.price + 60000 and market.okx.BTC.volume / 2 == 500", true},
+	}
+
+	for _, tc := range testCases {
+		node, err := parser.Parse(tc.expr)
+		if err != nil {
+			t.Errorf("解析 %q 失败: %v", tc.expr, err)
+			continue
+		}
+
+		if err := evaluator.Validate(node); err != nil {
+			t.Errorf("验证 %q 失败: %v", tc.expr, err)
+			continue
+		}
+
+		result, err := evaluator.Evaluate(ctx, node)
+		if err != nil {
+			t.Errorf("执行 %q 失败: %v", tc.expr, err)
+			continue
+		}
+
+		if result != tc.expected {
+			t.Errorf("%q 期望 %v，实际 %v", tc.expr, tc.expected, result)
+		}
+	}
+}
+
+func TestArithmeticErrors(t *testing.T) {
+	evaluator := newArithmeticEvaluator()
+	parser := NewParser()
+	ctx := context.Background()
+
+	for _, expr := range []string{"1 / 0 > 1", `"abc" + 1 > 0`, "market.gate.BTC.price - market.okx.BTC.price > 50"} {
+		node, err := parser.Parse(expr)
+		if err != nil {
+			t.Errorf("解析 %q 失败: %v", expr, err)
+			continue
+		}
+
+		if _, err := evaluator.Evaluate(ctx, node); err == nil {
+			t.Errorf("%q 应返回错误", expr)
+		}
+	}
+}
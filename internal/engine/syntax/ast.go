@@ -6,6 +6,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/lemconn/foxflow/internal/engine/builtin"
 )
 
 // NodeType AST节点类型
@@ -18,6 +20,7 @@ const (
 	NodeIdent
 	NodeFuncCall
 	NodeFieldAccess
+	NodeArray
 )
 
 // Node AST节点
@@ -35,9 +38,10 @@ type Node struct {
 	// 标识符
 	Ident string
 
-	// 函数调用
+	// 函数调用（数组节点的元素同样保存在 Args 中）
 	FuncName string
 	Args     []*Node
+	ArgNames []string // 与 Args 一一对应，命名参数（如 tz="UTC"）记录参数名，位置参数为空
 
 	// 字段访问
 	Module     string
@@ -70,10 +74,22 @@ func (n *Node) String() string {
 		args := make([]string, len(n.Args))
 		for i, arg := range n.Args {
 			args[i] = arg.String()
+			if name := n.argName(i); name != "" {
+				args[i] = name + "=" + args[i]
+			}
 		}
 		return fmt.Sprintf("%s(%s)", n.FuncName, strings.Join(args, ", "))
 	case NodeFieldAccess:
+		if n.Field == "" {
+			return fmt.Sprintf("%s.%s", n.Module, n.DataSource)
+		}
 		return fmt.Sprintf("%s.%s.%s", n.Module, n.DataSource, n.Field)
+	case NodeArray:
+		elements := make([]string, len(n.Args))
+		for i, element := range n.Args {
+			elements[i] = element.String()
+		}
+		return fmt.Sprintf("[%s]", strings.Join(elements, ", "))
 	default:
 		return "UNKNOWN"
 	}
@@ -135,6 +151,9 @@ func (n *Node) Evaluate(ctx context.Context, evaluator *Evaluator) (interface{},
 	case NodeFuncCall:
 		return n.evaluateFunction(ctx, evaluator)
 
+	case NodeArray:
+		return n.evaluateArray(ctx, evaluator)
+
 	default:
 		return nil, fmt.Errorf("unsupported node type: %d", n.Type)
 	}
@@ -167,8 +186,70 @@ func (n *Node) evaluateFunction(ctx context.Context, evaluator *Evaluator) (inte
 		args[i] = value
 	}
 
-	// 调用函数
-	return evaluator.CallFunction(ctx, n.FuncName, args)
+	// 按函数签名将命名参数放到对应位置
+	if n.hasNamedArgs() {
+		bound, err := evaluator.bindNamedArgs(n.FuncName, args, n.ArgNames)
+		if err != nil {
+			return nil, err
+		}
+		args = bound
+	}
+
+	// 调用函数，以函数调用表达式作为跨周期状态的键
+	return evaluator.CallFunction(builtin.WithCallKey(ctx, n.String()), n.FuncName, args)
+}
+
+// evaluateArray 评估数组中的每个元素
+func (n *Node) evaluateArray(ctx context.Context, evaluator *Evaluator) (interface{}, error) {
+	values := make([]interface{}, len(n.Args))
+	for i, element := range n.Args {
+		value, err := element.Evaluate(ctx, evaluator)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate array element %d: %w", i, err)
+		}
+		values[i] = value
+	}
+
+	return values, nil
+}
+
+// argName 获取第 i 个参数的参数名，位置参数返回空字符串
+func (n *Node) argName(i int) string {
+	if i < len(n.ArgNames) {
+		return n.ArgNames[i]
+	}
+	return ""
+}
+
+// HasFuncCall 检查表达式中（含嵌套参数）是否调用了指定函数，返回第一个匹配的函数名
+func (n *Node) HasFuncCall(names ...string) (string, bool) {
+	if n == nil {
+		return "", false
+	}
+	if n.Type == NodeFuncCall {
+		for _, name := range names {
+			if strings.EqualFold(n.FuncName, name) {
+				return n.FuncName, true
+			}
+		}
+	}
+
+	for _, child := range append([]*Node{n.Left, n.Right}, n.Args...) {
+		if name, ok := child.HasFuncCall(names...); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// hasNamedArgs 检查函数调用是否包含命名参数
+func (n *Node) hasNamedArgs() bool {
+	for _, name := range n.ArgNames {
+		if name != "" {
+			return true
+		}
+	}
+	return false
 }
 
 // 辅助函数
@@ -318,23 +399,31 @@ func (n *Node) getFunctionCallNode() *Node {
 
 // extractDataSourceParams 从函数调用中提取数据源参数
 func (n *Node) extractDataSourceParams(funcNode *Node) []interface{} {
-	// 提取除了第一个参数（数据源路径）之外的所有参数
-	// 第一个参数是数据源路径，后面的参数是传递给数据源的参数
-	if len(funcNode.Args) <= 1 {
+	// 数据源路径之后的参数是传递给数据源的参数
+	// 多个数据参数时（如 corr(kline.okx.BTC.close, kline.okx.ETH.close, "1h", 50)），
+	// 以最后一个直接作为参数的数据路径为界
+	last := 0
+	for i, arg := range funcNode.Args {
+		if arg.Type == NodeFieldAccess || arg.Type == NodeIdent {
+			last = i
+		}
+	}
+
+	if len(funcNode.Args) <= last+1 {
 		return []interface{}{}
 	}
-	
-	// 提取从第二个参数开始的所有参数
-	params := make([]interface{}, len(funcNode.Args)-1)
-	for i := 1; i < len(funcNode.Args); i++ {
+
+	// 提取数据路径之后的所有参数
+	params := make([]interface{}, len(funcNode.Args)-last-1)
+	for i := last + 1; i < len(funcNode.Args); i++ {
 		// 对于字面量节点，直接使用值
 		if funcNode.Args[i].Type == NodeLiteral {
-			params[i-1] = funcNode.Args[i].Value
+			params[i-last-1] = funcNode.Args[i].Value
 		} else {
 			// 对于其他类型的节点，返回节点本身，让调用方处理
-			params[i-1] = funcNode.Args[i]
+			params[i-last-1] = funcNode.Args[i]
 		}
 	}
-	
+
 	return params
 }
@@ -0,0 +1,166 @@
+package syntax
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/engine/builtin"
+	"github.com/lemconn/foxflow/internal/engine/registry"
+)
+
+// MockNewsTitle 模拟新闻数据源，返回固定标题
+type MockNewsTitle struct {
+	title string
+}
+
+func (m *MockNewsTitle) GetName() string {
+	return "news"
+}
+
+func (m *MockNewsTitle) GetData(ctx context.Context, dataSource, field string, params ...interface{}) (interface{}, error) {
+	return m.title, nil
+}
+
+func TestCollectionExpressions(t *testing.T) {
+	reg := registry.NewRegistry()
+	reg.RegisterBuiltin(builtin.NewIfBuiltin())
+	reg.RegisterBuiltin(builtin.NewAnyBuiltin())
+	reg.RegisterBuiltin(builtin.NewAllBuiltin())
+	reg.RegisterBuiltin(builtin.NewCountBuiltin())
+	reg.RegisterProvider(&MockNewsTitle{title: "SEC approves spot ETF"})
+	reg.RegisterProvider(&MockMultiExchangeMarket{
+		prices: map[string]map[string]interface{}{
+			"okx":     {"BTC.price": "65000", "BTC.volume": "1000"},
+			"binance": {"BTC.price": "65100"},
+		},
+	})
+
+	evaluator := NewEvaluator(reg)
+	parser := NewParser()
+
+	testCases := []struct {
+		expr     string
+		expected interface{}
+	}{
+		{`if(market.okx.BTC.price > 60000, 1, 2)`, 1.0},
+		{`if(market.okx.BTC.price > 70000, "long", "short")`, "short"},
+		{`if(market.okx.BTC.price > 60000, market.binance.BTC.price, 0) - market.okx.BTC.price`, 100.0},
+		{`any([market.okx.BTC.price > 70000, market.okx.BTC.volume > 500])`, true},
+		{`any([market.okx.BTC.price > 70000, market.okx.BTC.volume > 5000])`, false},
+		{`all([market.okx.BTC.price > 60000, market.okx.BTC.volume > 500])`, true},
+		{`all([market.okx.BTC.price > 60000, market.okx.BTC.volume > 5000])`, false},
+		{`count(news.blockbeats.title, ["ETF", "SEC", "Bitcoin"])`, 2.0},
+		{`count([market.okx.BTC.price > 60000, market.okx.BTC.volume > 500, has_etf == 1, market.binance.BTC.price < 60000]) >= 2`, nil},
+		{`count([market.okx.BTC.price > 60000, market.okx.BTC.volume > 500, market.binance.BTC.price > 70000, market.binance.BTC.price < 60000]) >= 2`, true},
+		{`market.okx.BTC.price in [65000, 66000]`, true},
+	}
+
+	for _, tc := range testCases {
+		node, err := parser.Parse(tc.expr)
+		if err != nil {
+			t.Errorf("解析表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+
+		result, err := evaluator.Evaluate(context.Background(), node)
+		if tc.expected == nil {
+			if err == nil {
+				t.Errorf("表达式 %s 期望执行失败", tc.expr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("执行表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+
+		if result != tc.expected {
+			t.Errorf("表达式 %s 期望 %v，实际 %v", tc.expr, tc.expected, result)
+		}
+	}
+}
+
+func TestArrayLiteralParsing(t *testing.T) {
+	parser := NewParser()
+
+	// 纯字符串数组保持为字面量
+	node, err := parser.Parse(`news.blockbeats.title in ["ETF", "SEC"]`)
+	if err != nil {
+		t.Fatalf("解析字符串数组失败: %v", err)
+	}
+	if _, ok := node.Right.Value.([]string); !ok || node.Right.Type != NodeLiteral {
+		t.Errorf("期望字符串数组字面量，实际 %v", node.Right)
+	}
+
+	// 包含表达式的数组解析为数组节点
+	node, err = parser.Parse(`any([market.okx.BTC.price > 1, 2])`)
+	if err != nil {
+		t.Fatalf("解析表达式数组失败: %v", err)
+	}
+	array := node.Args[0]
+	if array.Type != NodeArray || len(array.Args) != 2 {
+		t.Fatalf("期望包含 2 个元素的数组节点，实际 %v", array)
+	}
+	if node.String() != "any([(market.okx.BTC.price > 1), 2])" {
+		t.Errorf("数组字符串表示错误: %s", node.String())
+	}
+}
+
+func TestStringEscapeTokens(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{`"a\nb"`, "a\nb"},
+		{`"say \"hi\""`, `say "hi"`},
+		{`"C:\\temp"`, `C:\temp`},
+		// 未知的转义序列原样保留（含反斜杠），正则表达式中的 \s、\b 无需双写反斜杠
+		{`"spot\s+etf"`, `spot\s+etf`},
+		{`"\x"`, `\x`},
+	}
+
+	for _, tc := range testCases {
+		token := NewTokenizer(tc.input).NextToken()
+		if token.Type != TokenString || token.Value != tc.expected {
+			t.Errorf("%s 期望字符串 %q，实际 %s", tc.input, tc.expected, token)
+		}
+	}
+}
+
+func TestRegexExpressions(t *testing.T) {
+	reg := registry.NewRegistry()
+	reg.RegisterBuiltin(builtin.NewMatchBuiltin())
+	reg.RegisterBuiltin(builtin.NewHasAnyBuiltin())
+	reg.RegisterProvider(&MockNewsTitle{title: "SEC Spot ETF approval expected this week"})
+
+	evaluator := NewEvaluator(reg)
+	parser := NewParser()
+
+	testCases := []struct {
+		expr     string
+		expected bool
+	}{
+		{`match(news.blockbeats.title, "(?i)spot\s+etf\s+approv")`, true},
+		{`match(news.blockbeats.title, "^spot")`, false},
+		{`has_any(news.blockbeats.title, ["etf", "halving"])`, true},
+		{`has_any(news.blockbeats.title, ["approv"], word=true)`, false},
+	}
+
+	for _, tc := range testCases {
+		node, err := parser.Parse(tc.expr)
+		if err != nil {
+			t.Errorf("解析表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+
+		result, err := evaluator.EvaluateToBool(context.Background(), node)
+		if err != nil {
+			t.Errorf("执行表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+
+		if result != tc.expected {
+			t.Errorf("表达式 %s 期望 %v，实际 %v", tc.expr, tc.expected, result)
+		}
+	}
+}
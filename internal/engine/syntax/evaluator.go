@@ -69,6 +69,43 @@ func (e *Evaluator) CallFunction(ctx context.Context, name string, args []interf
 	return fn.Execute(ctx, args, e)
 }
 
+// bindNamedArgs 按函数签名将命名参数放到对应位置，未提供的可选参数以 nil 占位
+func (e *Evaluator) bindNamedArgs(name string, args []interface{}, names []string) ([]interface{}, error) {
+	fn, exists := e.registry.GetBuiltin(name)
+	if !exists {
+		return nil, fmt.Errorf("unknown function: %s", name)
+	}
+
+	sigArgs := fn.GetSignature().Args
+	bound := make([]interface{}, 0, len(sigArgs))
+	for i, value := range args {
+		if i >= len(names) || names[i] == "" {
+			bound = append(bound, value)
+			continue
+		}
+
+		index := -1
+		for j, arg := range sigArgs {
+			if arg.Name == names[i] {
+				index = j
+				break
+			}
+		}
+		if index < 0 {
+			return nil, fmt.Errorf("function %s has no argument named %s", name, names[i])
+		}
+		if index < len(bound) {
+			return nil, fmt.Errorf("argument %s of function %s is already set", names[i], name)
+		}
+		for len(bound) < index {
+			bound = append(bound, nil)
+		}
+		bound = append(bound, value)
+	}
+
+	return bound, nil
+}
+
 // CallBuiltin 调用内置函数（实现 builtin.Evaluator 接口）
 func (e *Evaluator) CallBuiltin(ctx context.Context, name string, args []interface{}) (interface{}, error) {
 	return e.CallFunction(ctx, name, args)
@@ -109,11 +146,42 @@ func (e *Evaluator) EvaluateBinary(op string, left, right interface{}) (interfac
 		return e.evaluateMembership(left, right, false)
 	case "has":
 		return e.evaluateContains(left, right)
+	case "+", "-", "*", "/":
+		return e.evaluateArithmetic(op, left, right)
 	default:
 		return nil, fmt.Errorf("unsupported operator: %s", op)
 	}
 }
 
+// evaluateArithmetic 评估算术运算
+func (e *Evaluator) evaluateArithmetic(op string, left, right interface{}) (float64, error) {
+	leftNum, err := toFloat64(left)
+	if err != nil {
+		return 0, fmt.Errorf("left operand of %s is not a number: %w", op, err)
+	}
+
+	rightNum, err := toFloat64(right)
+	if err != nil {
+		return 0, fmt.Errorf("right operand of %s is not a number: %w", op, err)
+	}
+
+	switch op {
+	case "+":
+		return leftNum + rightNum, nil
+	case "-":
+		return leftNum - rightNum, nil
+	case "*":
+		return leftNum * rightNum, nil
+	case "/":
+		if rightNum == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return leftNum / rightNum, nil
+	default:
+		return 0, fmt.Errorf("unsupported arithmetic operator: %s", op)
+	}
+}
+
 // evaluateLogicalAnd 评估逻辑AND
 func (e *Evaluator) evaluateLogicalAnd(left, right interface{}) (bool, error) {
 	leftBool, err := toBool(left)
@@ -217,7 +285,20 @@ func (e *Evaluator) evaluateMembership(left, right interface{}, isIn bool) (bool
 }
 
 // evaluateContains 评估包含关系
+// 左侧为列表（如 news.blockbeats.symbols）时判断列表中是否有与右侧相同的元素（忽略大小写）
 func (e *Evaluator) evaluateContains(left, right interface{}) (bool, error) {
+	switch left.(type) {
+	case []string, []interface{}:
+		items, _ := toStringArray(left)
+		rightStr := toString(right)
+		for _, item := range items {
+			if strings.EqualFold(item, rightStr) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
 	leftStr := toString(left)
 	rightStr := toString(right)
 
@@ -243,6 +324,13 @@ func (e *Evaluator) validateNode(node *Node) error {
 		return e.validateLiteral(node)
 	case NodeFieldAccess:
 		return e.validateFieldAccess(node)
+	case NodeArray:
+		for i, element := range node.Args {
+			if err := e.validateNode(element); err != nil {
+				return fmt.Errorf("invalid array element %d: %w", i, err)
+			}
+		}
+		return nil
 	default:
 		return fmt.Errorf("unknown node type: %d", node.Type)
 	}
@@ -271,11 +359,28 @@ func (e *Evaluator) validateBinaryExpression(node *Node) error {
 // validateFunctionCall 验证函数调用
 func (e *Evaluator) validateFunctionCall(node *Node) error {
 	// 验证函数是否存在
-	_, exists := e.registry.GetBuiltin(node.FuncName)
+	fn, exists := e.registry.GetBuiltin(node.FuncName)
 	if !exists {
 		return fmt.Errorf("unknown function: %s", node.FuncName)
 	}
 
+	// 验证命名参数是否存在于函数签名中
+	for _, name := range node.ArgNames {
+		if name == "" {
+			continue
+		}
+		found := false
+		for _, arg := range fn.GetSignature().Args {
+			if arg.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("function %s has no argument named %s", node.FuncName, name)
+		}
+	}
+
 	// 验证每个参数
 	for i, arg := range node.Args {
 		if err := e.validateNode(arg); err != nil {
@@ -323,8 +428,8 @@ func (e *Evaluator) validateFieldAccess(node *Node) error {
 		return fmt.Errorf("data source name cannot be empty")
 	}
 
-	// 验证字段名不为空
-	if node.Field == "" {
+	// 验证字段名不为空，整个数据源（如 news.blockbeats）只能直接作为函数参数
+	if node.Field == "" && (node.Parent == nil || node.Parent.Type != NodeFuncCall) {
 		return fmt.Errorf("field name cannot be empty")
 	}
 
@@ -336,6 +441,7 @@ func (e *Evaluator) isValidOperator(op string) bool {
 	validOps := []string{
 		"and", "or",
 		">", "<", ">=", "<=", "==", "!=",
+		"+", "-", "*", "/",
 		"in", "not_in", "has",
 	}
 
@@ -0,0 +1,136 @@
+package syntax
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/engine/builtin"
+	"github.com/lemconn/foxflow/internal/engine/registry"
+	"github.com/lemconn/foxflow/internal/news"
+)
+
+// MockNewsBuffer 模拟新闻数据源，不指定字段时返回新闻缓冲区
+type MockNewsBuffer struct {
+	items []news.NewsItem
+}
+
+func (m *MockNewsBuffer) GetName() string {
+	return "news"
+}
+
+func (m *MockNewsBuffer) GetData(ctx context.Context, dataSource, field string, params ...interface{}) (interface{}, error) {
+	switch field {
+	case "":
+		return m.items, nil
+	case "sentiment":
+		return m.items[0].Sentiment, nil
+	case "symbols":
+		return m.items[0].Symbols, nil
+	default:
+		return m.items[0].Title, nil
+	}
+}
+
+func TestNewsCountExpressions(t *testing.T) {
+	now := time.Date(2025, 1, 6, 12, 0, 0, 0, time.UTC)
+
+	reg := registry.NewRegistry()
+	reg.RegisterBuiltin(builtin.NewNewsCountBuiltin())
+	reg.RegisterProvider(&MockNewsBuffer{items: []news.NewsItem{
+		{ID: "3", Title: "Protocol HACK post-mortem", PublishedAt: now.Add(-1 * time.Minute)},
+		{ID: "2", Title: "Exchange hot wallet drained", Content: "Attackers used a hack of the signer", PublishedAt: now.Add(-10 * time.Minute)},
+		{ID: "1", Title: "Old hack recap", PublishedAt: now.Add(-2 * time.Hour)},
+	}})
+
+	evaluator := NewEvaluator(reg)
+	parser := NewParser()
+	ctx := builtin.WithNow(context.Background(), now)
+
+	testCases := []struct {
+		expr     string
+		expected float64
+	}{
+		{`news_count(news.blockbeats, "hack", "30m")`, 2},
+		{`news_count(news.blockbeats, "hack", 3h)`, 3},
+		{`news_count(news.blockbeats, "", 5m)`, 1},
+		{`news_count(news.blockbeats, "etf", 1d)`, 0},
+	}
+
+	for _, tc := range testCases {
+		node, err := parser.Parse(tc.expr)
+		if err != nil {
+			t.Errorf("解析表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+		if err := evaluator.Validate(node); err != nil {
+			t.Errorf("校验表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+
+		result, err := evaluator.Evaluate(ctx, node)
+		if err != nil {
+			t.Errorf("执行表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+
+		if result != tc.expected {
+			t.Errorf("表达式 %s 期望 %v，实际 %v", tc.expr, tc.expected, result)
+		}
+	}
+
+	// 整个数据源只能作为函数参数使用
+	node, err := parser.Parse(`news.blockbeats == "x"`)
+	if err != nil {
+		t.Fatalf("解析失败: %v", err)
+	}
+	if err := evaluator.Validate(node); err == nil {
+		t.Error("期望在函数参数之外引用整个数据源时校验失败")
+	}
+}
+
+func TestNewsSentimentExpressions(t *testing.T) {
+	reg := registry.NewRegistry()
+	reg.RegisterBuiltin(builtin.NewHasBuiltin())
+	reg.RegisterProvider(&MockNewsBuffer{items: []news.NewsItem{
+		{ID: "1", Title: "SEC approves spot bitcoin ETF", Sentiment: 0.8, Symbols: []string{"BTC", "ETH"}},
+	}})
+
+	evaluator := NewEvaluator(reg)
+	parser := NewParser()
+
+	testCases := []struct {
+		expr     string
+		expected bool
+	}{
+		{`news.blockbeats.sentiment > 0.6`, true},
+		{`news.blockbeats.sentiment < 0`, false},
+		{`news.blockbeats.symbols has "BTC"`, true},
+		{`news.blockbeats.symbols has "eth"`, true},
+		{`news.blockbeats.symbols has "ET"`, false},
+		{`news.blockbeats.symbols has "SOL"`, false},
+		{`has(news.blockbeats.symbols, "BTC") and news.blockbeats.sentiment > 0.6`, true},
+	}
+
+	for _, tc := range testCases {
+		node, err := parser.Parse(tc.expr)
+		if err != nil {
+			t.Errorf("解析表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+		if err := evaluator.Validate(node); err != nil {
+			t.Errorf("校验表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+
+		result, err := evaluator.Evaluate(context.Background(), node)
+		if err != nil {
+			t.Errorf("执行表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+
+		if result != tc.expected {
+			t.Errorf("表达式 %s 期望 %v，实际 %v", tc.expr, tc.expected, result)
+		}
+	}
+}
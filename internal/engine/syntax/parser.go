@@ -47,6 +47,14 @@ func (p *Parser) nextToken() {
 	p.curToken = p.tokenizer.NextToken()
 }
 
+// peekToken 预读下一个词法单元，不移动解析位置
+func (p *Parser) peekToken() Token {
+	pos := p.tokenizer.pos
+	token := p.tokenizer.NextToken()
+	p.tokenizer.pos = pos
+	return token
+}
+
 // parseExpression 解析表达式（最高优先级）
 func (p *Parser) parseExpression() *Node {
 	return p.parseOr()
@@ -92,13 +100,13 @@ func (p *Parser) parseAnd() *Node {
 
 // parseComparison 解析比较表达式
 func (p *Parser) parseComparison() *Node {
-	node := p.parsePrimary()
+	node := p.parseAdditive()
 
 	// 处理比较操作符
-	for p.curToken.Type == TokenOp {
+	for p.curToken.Type == TokenOp && isComparisonOp(p.curToken.Value) {
 		op := p.curToken.Value
 		p.nextToken()
-		right := p.parsePrimary()
+		right := p.parseAdditive()
 		node = &Node{
 			Type:  NodeBinary,
 			Op:    op,
@@ -111,7 +119,26 @@ func (p *Parser) parseComparison() *Node {
 	if p.curToken.Type == TokenIn || p.curToken.Type == TokenNotIn || p.curToken.Type == TokenContains {
 		op := p.curToken.Value
 		p.nextToken()
-		right := p.parsePrimary()
+		right := p.parseAdditive()
+		node = &Node{
+			Type:  NodeBinary,
+			Op:    op,
+			Left:  node,
+			Right: right,
+		}
+	}
+
+	return node
+}
+
+// parseAdditive 解析加减表达式
+func (p *Parser) parseAdditive() *Node {
+	node := p.parseMultiplicative()
+
+	for p.curToken.Type == TokenOp && (p.curToken.Value == "+" || p.curToken.Value == "-") {
+		op := p.curToken.Value
+		p.nextToken()
+		right := p.parseMultiplicative()
 		node = &Node{
 			Type:  NodeBinary,
 			Op:    op,
@@ -123,6 +150,62 @@ func (p *Parser) parseComparison() *Node {
 	return node
 }
 
+// parseMultiplicative 解析乘除表达式
+func (p *Parser) parseMultiplicative() *Node {
+	node := p.parseUnary()
+
+	for p.curToken.Type == TokenOp && (p.curToken.Value == "*" || p.curToken.Value == "/") {
+		op := p.curToken.Value
+		p.nextToken()
+		right := p.parseUnary()
+		node = &Node{
+			Type:  NodeBinary,
+			Op:    op,
+			Left:  node,
+			Right: right,
+		}
+	}
+
+	return node
+}
+
+// parseUnary 解析一元正负号
+func (p *Parser) parseUnary() *Node {
+	if p.curToken.Type == TokenOp && (p.curToken.Value == "-" || p.curToken.Value == "+") {
+		op := p.curToken.Value
+		p.nextToken()
+		operand := p.parseUnary()
+		if op == "+" {
+			return operand
+		}
+
+		// 数字字面量直接取负，其他表达式转换为 0 - x
+		if operand.Type == NodeLiteral {
+			if value, ok := operand.Value.(float64); ok {
+				operand.Value = -value
+				return operand
+			}
+		}
+		return &Node{
+			Type:  NodeBinary,
+			Op:    "-",
+			Left:  &Node{Type: NodeLiteral, Value: 0.0},
+			Right: operand,
+		}
+	}
+
+	return p.parsePrimary()
+}
+
+// isComparisonOp 检查是否为比较操作符
+func isComparisonOp(op string) bool {
+	switch op {
+	case ">", "<", ">=", "<=", "==", "!=":
+		return true
+	}
+	return false
+}
+
 // parsePrimary 解析基本表达式
 func (p *Parser) parsePrimary() *Node {
 	switch p.curToken.Type {
@@ -156,6 +239,14 @@ func (p *Parser) parsePrimary() *Node {
 			return p.parseFunctionCall(name)
 		}
 
+		// 布尔字面量
+		if name == "true" || name == "false" {
+			return &Node{
+				Type:  NodeLiteral,
+				Value: name == "true",
+			}
+		}
+
 		// 普通标识符
 		return &Node{
 			Type:  NodeIdent,
@@ -191,6 +282,19 @@ func (p *Parser) parsePrimary() *Node {
 		p.nextToken()
 		return node
 
+	case TokenDuration:
+		// 时长，统一转换为秒数，便于与 ago() 等返回秒数的函数比较
+		seconds, err := parseDurationLiteral(p.curToken.Value)
+		if err != nil {
+			panic(fmt.Sprintf("invalid duration %s at position %d: %v", p.curToken.Value, p.curToken.Pos, err))
+		}
+		node := &Node{
+			Type:  NodeLiteral,
+			Value: seconds,
+		}
+		p.nextToken()
+		return node
+
 	case TokenString:
 		// 字符串
 		value := p.curToken.Value
@@ -213,16 +317,50 @@ func (p *Parser) parsePrimary() *Node {
 	}
 }
 
+// parseDurationLiteral 将时长字面量转换为秒数
+func parseDurationLiteral(literal string) (float64, error) {
+	unit := literal[len(literal)-1]
+	value, err := strconv.ParseFloat(literal[:len(literal)-1], 64)
+	if err != nil {
+		return 0, err
+	}
+
+	switch unit {
+	case 's':
+		return value, nil
+	case 'm':
+		return value * 60, nil
+	case 'h':
+		return value * 3600, nil
+	case 'd':
+		return value * 86400, nil
+	default:
+		return 0, fmt.Errorf("unknown duration unit %q", unit)
+	}
+}
+
 // parseFunctionCall 解析函数调用
 func (p *Parser) parseFunctionCall(name string) *Node {
 	p.nextToken() // 跳过 '('
 
 	var args []*Node
+	var argNames []string
+	named := false
 	if p.curToken.Type != TokenRParen {
-		args = append(args, p.parseExpression())
-		for p.curToken.Type == TokenComma {
-			p.nextToken()
+		for {
+			argName := p.parseArgName()
+			if argName != "" {
+				named = true
+			} else if named {
+				panic(fmt.Sprintf("positional argument after named argument at position %d", p.curToken.Pos))
+			}
 			args = append(args, p.parseExpression())
+			argNames = append(argNames, argName)
+
+			if p.curToken.Type != TokenComma {
+				break
+			}
+			p.nextToken()
 		}
 	}
 
@@ -236,6 +374,9 @@ func (p *Parser) parseFunctionCall(name string) *Node {
 		FuncName: name,
 		Args:     args,
 	}
+	if named {
+		funcNode.ArgNames = argNames
+	}
 
 	// 设置子节点的 Parent 关系
 	for _, arg := range args {
@@ -245,6 +386,23 @@ func (p *Parser) parseFunctionCall(name string) *Node {
 	return funcNode
 }
 
+// parseArgName 解析命名参数的参数名（如 tz="UTC" 中的 tz），不是命名参数时返回空字符串
+func (p *Parser) parseArgName() string {
+	if p.curToken.Type != TokenIdent {
+		return ""
+	}
+
+	next := p.peekToken()
+	if next.Type != TokenOp || next.Value != "=" {
+		return ""
+	}
+
+	name := p.curToken.Value
+	p.nextToken() // 跳过参数名
+	p.nextToken() // 跳过 '='
+	return name
+}
+
 // parseFieldAccess 解析字段访问
 func (p *Parser) parseFieldAccess(module string) (*Node, error) {
 	// 跳过第一个点
@@ -257,9 +415,13 @@ func (p *Parser) parseFieldAccess(module string) (*Node, error) {
 	dataSource := p.curToken.Value
 	p.nextToken()
 
-	// 检查是否有第二个点
+	// 没有第二个点时引用整个数据源（如 news.blockbeats），只能作为函数参数使用
 	if p.curToken.Type != TokenDot {
-		return nil, fmt.Errorf("expected second dot but got %s at position %d", p.curToken.Value, p.curToken.Pos)
+		return &Node{
+			Type:       NodeFieldAccess,
+			Module:     module,
+			DataSource: dataSource,
+		}, nil
 	}
 	p.nextToken()
 
@@ -293,28 +455,51 @@ func (p *Parser) parseFieldAccess(module string) (*Node, error) {
 }
 
 // parseArray 解析数组
+// 元素全部为字符串时解析为字符串数组字面量，否则解析为数组节点，元素在执行时求值
 func (p *Parser) parseArray() *Node {
 	p.nextToken() // 跳过 '['
 
-	var arr []string
+	var elements []*Node
 	for p.curToken.Type != TokenRBracket {
-		if p.curToken.Type == TokenString {
-			arr = append(arr, p.curToken.Value)
+		if p.curToken.Type == TokenEOF {
+			panic(fmt.Sprintf("expected ']' but got end of input at position %d", p.curToken.Pos))
+		}
+
+		elements = append(elements, p.parseExpression())
+		if p.curToken.Type == TokenComma {
 			p.nextToken()
-			if p.curToken.Type == TokenComma {
-				p.nextToken()
-			}
-		} else {
-			panic(fmt.Sprintf("expected string in array but got %s at position %d", p.curToken.Value, p.curToken.Pos))
+		} else if p.curToken.Type != TokenRBracket {
+			panic(fmt.Sprintf("expected ',' or ']' in array but got %s at position %d", p.curToken.Value, p.curToken.Pos))
 		}
 	}
 
 	p.nextToken() // 跳过 ']'
 
-	return &Node{
-		Type:  NodeLiteral,
-		Value: arr,
+	arr := make([]string, 0, len(elements))
+	for _, element := range elements {
+		value, ok := element.Value.(string)
+		if element.Type != NodeLiteral || !ok {
+			arr = nil
+			break
+		}
+		arr = append(arr, value)
 	}
+	if arr != nil {
+		return &Node{
+			Type:  NodeLiteral,
+			Value: arr,
+		}
+	}
+
+	arrayNode := &Node{
+		Type: NodeArray,
+		Args: elements,
+	}
+	for _, element := range elements {
+		element.Parent = arrayNode
+	}
+
+	return arrayNode
 }
 
 // Validate 验证语法表达式
@@ -0,0 +1,82 @@
+package syntax
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/engine/builtin"
+	"github.com/lemconn/foxflow/internal/engine/registry"
+)
+
+// MockKlineSeries 模拟K线数据源，校验传入的 interval 和 limit 参数
+type MockKlineSeries struct {
+	series map[string][]interface{}
+}
+
+func (m *MockKlineSeries) GetName() string {
+	return "kline"
+}
+
+func (m *MockKlineSeries) GetData(ctx context.Context, dataSource, field string, params ...interface{}) (interface{}, error) {
+	if len(params) < 2 || params[0] != "1h" || params[1] != 4.0 {
+		return nil, fmt.Errorf("unexpected kline params: %v", params)
+	}
+	if series, exists := m.series[field]; exists {
+		return series, nil
+	}
+	return nil, fmt.Errorf("no kline data for %s", field)
+}
+
+func TestStatisticalExpressions(t *testing.T) {
+	reg := registry.NewRegistry()
+	reg.RegisterBuiltin(builtin.NewStddevBuiltin())
+	reg.RegisterBuiltin(builtin.NewZscoreBuiltin())
+	reg.RegisterBuiltin(builtin.NewPercentileBuiltin())
+	reg.RegisterBuiltin(builtin.NewRocBuiltin())
+	reg.RegisterBuiltin(builtin.NewCorrBuiltin())
+	reg.RegisterProvider(&MockKlineSeries{
+		series: map[string][]interface{}{
+			"BTC.close":  {"100", "102", "101", "105"},
+			"ETH.close":  {"10", "10.2", "10.1", "10.5"},
+			"BTC.volume": {10.0, 20.0, 30.0, 40.0},
+		},
+	})
+	reg.RegisterProvider(&MockMultiExchangeMarket{
+		prices: map[string]map[string]interface{}{
+			"okx": {"BTC.price": "110"},
+		},
+	})
+
+	evaluator := NewEvaluator(reg)
+	parser := NewParser()
+
+	testCases := []struct {
+		expr     string
+		expected bool
+	}{
+		{`stddev(kline.okx.BTC.volume, "1h", 4) > 11`, true},
+		{`zscore(market.okx.BTC.price, kline.okx.BTC.close, "1h", 4) > 2`, true},
+		{`percentile(kline.okx.BTC.volume, "1h", 4, 50) == 25`, true},
+		{`roc(kline.okx.BTC.close, "1h", 4) == 5`, true},
+		{`corr(kline.okx.BTC.close, kline.okx.ETH.close, "1h", 4) > 0.99`, true},
+	}
+
+	for _, tc := range testCases {
+		node, err := parser.Parse(tc.expr)
+		if err != nil {
+			t.Errorf("解析表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+
+		result, err := evaluator.EvaluateToBool(context.Background(), node)
+		if err != nil {
+			t.Errorf("执行表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+
+		if result != tc.expected {
+			t.Errorf("表达式 %s 期望 %v，实际 %v", tc.expr, tc.expected, result)
+		}
+	}
+}
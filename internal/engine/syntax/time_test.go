@@ -0,0 +1,137 @@
+package syntax
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/engine/builtin"
+	"github.com/lemconn/foxflow/internal/engine/registry"
+)
+
+// MockNewsClock 模拟新闻数据源，返回固定发布时间
+type MockNewsClock struct {
+	datetime time.Time
+}
+
+func (m *MockNewsClock) GetName() string {
+	return "news"
+}
+
+func (m *MockNewsClock) GetData(ctx context.Context, dataSource, field string, params ...interface{}) (interface{}, error) {
+	return m.datetime, nil
+}
+
+func TestDurationLiteralTokens(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected []Token
+	}{
+		{"5m", []Token{{Type: TokenDuration, Value: "5m"}}},
+		{"1.5h", []Token{{Type: TokenDuration, Value: "1.5h"}}},
+		{"30s < 1d", []Token{{Type: TokenDuration, Value: "30s"}, {Type: TokenOp, Value: "<"}, {Type: TokenDuration, Value: "1d"}}},
+		{"5min", []Token{{Type: TokenNumber, Value: "5"}, {Type: TokenIdent, Value: "min"}}},
+	}
+
+	for _, tc := range testCases {
+		tokenizer := NewTokenizer(tc.input)
+		for i, expected := range tc.expected {
+			token := tokenizer.NextToken()
+			if token.Type != expected.Type || token.Value != expected.Value {
+				t.Errorf("%s 第 %d 个词法单元期望 %s，实际 %s", tc.input, i, expected, token)
+			}
+		}
+		if token := tokenizer.NextToken(); token.Type != TokenEOF {
+			t.Errorf("%s 期望结束，实际 %s", tc.input, token)
+		}
+	}
+}
+
+func TestTimeExpressions(t *testing.T) {
+	reg := registry.NewRegistry()
+	reg.RegisterBuiltin(builtin.NewAgoBuiltin())
+	reg.RegisterBuiltin(builtin.NewNowBuiltin())
+	reg.RegisterBuiltin(builtin.NewHourBuiltin())
+	reg.RegisterBuiltin(builtin.NewWeekdayBuiltin())
+	reg.RegisterBuiltin(builtin.NewBetweenBuiltin())
+
+	// 2025-01-06 为周一，UTC 13:45 即北京时间 21:45
+	now := time.Date(2025, 1, 6, 13, 45, 0, 0, time.UTC)
+	reg.RegisterProvider(&MockNewsClock{datetime: now.Add(-7 * time.Minute)})
+
+	evaluator := NewEvaluator(reg)
+	parser := NewParser()
+	ctx := builtin.WithNow(context.Background(), now)
+
+	testCases := []struct {
+		expr     string
+		expected interface{}
+	}{
+		{"5m", 300.0},
+		{"2h + 30s", 7230.0},
+		{`ago(news.blockbeats.datetime) < 10m and between("13:30", "14:30", tz="UTC")`, true},
+		{`ago(news.blockbeats.datetime) < 5m`, false},
+		{`between("13:30", "14:30", tz="Asia/Shanghai")`, false},
+		{`between("21:00", "22:00", tz="Asia/Shanghai")`, true},
+		{`between("22:00", "02:00", "UTC")`, false},
+		{`hour(tz="UTC")`, 13.0},
+		{`hour(tz="Asia/Shanghai")`, 21.0},
+		{`weekday("UTC") >= 1 and weekday("UTC") <= 5`, true},
+		{`now() == now()`, true},
+	}
+
+	for _, tc := range testCases {
+		node, err := parser.Parse(tc.expr)
+		if err != nil {
+			t.Errorf("解析表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+
+		result, err := evaluator.Evaluate(ctx, node)
+		if err != nil {
+			t.Errorf("执行表达式失败 %s: %v", tc.expr, err)
+			continue
+		}
+
+		if result != tc.expected {
+			t.Errorf("表达式 %s 期望 %v，实际 %v", tc.expr, tc.expected, result)
+		}
+	}
+}
+
+func TestNamedArguments(t *testing.T) {
+	reg := registry.NewRegistry()
+	reg.RegisterBuiltin(builtin.NewBetweenBuiltin())
+	evaluator := NewEvaluator(reg)
+	parser := NewParser()
+
+	node, err := parser.Parse(`between("08:00", "16:00", tz="Asia/Shanghai")`)
+	if err != nil {
+		t.Fatalf("解析命名参数失败: %v", err)
+	}
+	if node.String() != `between("08:00", "16:00", tz="Asia/Shanghai")` {
+		t.Errorf("命名参数字符串表示错误: %s", node.String())
+	}
+
+	// 位置参数不能出现在命名参数之后（解析器以 panic 报告语法错误）
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("期望位置参数位于命名参数之后时报错")
+			}
+		}()
+		_, _ = parser.Parse(`between(tz="UTC", "08:00", "16:00")`)
+	}()
+
+	// 未知的参数名在校验和执行时均应报错
+	node, err = parser.Parse(`between("08:00", "16:00", zone="UTC")`)
+	if err != nil {
+		t.Fatalf("解析失败: %v", err)
+	}
+	if err := evaluator.Validate(node); err == nil {
+		t.Error("期望未知参数名校验失败")
+	}
+	if _, err := evaluator.Evaluate(context.Background(), node); err == nil {
+		t.Error("期望未知参数名执行失败")
+	}
+}
@@ -27,6 +27,7 @@ const (
 	TokenIn                 // in
 	TokenNotIn              // not_in
 	TokenContains           // contains
+	TokenDuration           // 时长，如 30s、5m、2h、1d
 )
 
 // Token 词法单元
@@ -161,6 +162,15 @@ func (t *Tokenizer) readNumber() Token {
 		}
 	}
 
+	// 紧跟时间单位的数字为时长字面量，如 5m、2h
+	if t.pos < t.len && isDurationUnit(t.input[t.pos]) {
+		next := t.pos + 1
+		if next >= t.len || !(isLetter(t.input[next]) || isDigit(t.input[next]) || t.input[next] == '_') {
+			t.pos = next
+			return Token{Type: TokenDuration, Value: t.input[start:t.pos], Pos: start}
+		}
+	}
+
 	value := t.input[start:t.pos]
 	return Token{Type: TokenNumber, Value: value, Pos: start}
 }
@@ -188,7 +198,9 @@ func (t *Tokenizer) readString() Token {
 			case '"':
 				value.WriteRune('"')
 			default:
-				value.WriteRune(rune(next))
+				// 保留未知的转义序列，便于在正则表达式中使用 \s、\b 等
+				value.WriteByte('\\')
+				value.WriteByte(next)
 			}
 		} else {
 			// 正确处理UTF-8字符
@@ -251,6 +263,10 @@ func isDigit(ch byte) bool {
 	return ch >= '0' && ch <= '9'
 }
 
+func isDurationUnit(ch byte) bool {
+	return ch == 's' || ch == 'm' || ch == 'h' || ch == 'd'
+}
+
 func isOperator(ch byte) bool {
 	return ch == '>' || ch == '<' || ch == '=' || ch == '!' || ch == '+' || ch == '-' || ch == '*' || ch == '/'
 }
@@ -290,6 +306,8 @@ func (t Token) String() string {
 		return "NOT_IN"
 	case TokenContains:
 		return "CONTAINS"
+	case TokenDuration:
+		return fmt.Sprintf("DURATION(%s)", t.Value)
 	default:
 		return fmt.Sprintf("UNKNOWN(%s)", t.Value)
 	}
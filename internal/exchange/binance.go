@@ -0,0 +1,490 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+// ErrNotSupported 交易所不支持该操作
+var ErrNotSupported = errors.New("operation not supported")
+
+const (
+	binanceUriTicker24hr    = "/fapi/v1/ticker/24hr"
+	binanceUriPremiumIndex  = "/fapi/v1/premiumIndex"
+	binanceUriOpenInterest  = "/fapi/v1/openInterest"
+	binanceUriDepth         = "/fapi/v1/depth"
+	binanceUriKlines        = "/fapi/v1/klines"
+	binanceDefaultFutureURL = "https://fapi.binance.com"
+)
+
+// binanceDepthLimits Binance 深度接口支持的档位数量
+var binanceDepthLimits = []int{5, 10, 20, 50, 100, 500, 1000}
+
+type binanceErrorResp struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+type binanceTickerData struct {
+	Symbol      string `json:"symbol"`      // 交易对，如 BTCUSDT
+	LastPrice   string `json:"lastPrice"`   // 最新成交价
+	HighPrice   string `json:"highPrice"`   // 24小时最高价
+	LowPrice    string `json:"lowPrice"`    // 24小时最低价
+	Volume      string `json:"volume"`      // 24小时成交量，以币为单位
+	QuoteVolume string `json:"quoteVolume"` // 24小时成交额
+	CloseTime   int64  `json:"closeTime"`   // 统计结束时间，Unix时间戳的毫秒数格式
+}
+
+type binancePremiumIndexData struct {
+	Symbol          string `json:"symbol"`          // 交易对
+	MarkPrice       string `json:"markPrice"`       // 标记价格
+	IndexPrice      string `json:"indexPrice"`      // 指数价格
+	LastFundingRate string `json:"lastFundingRate"` // 最近更新的资金费率
+	NextFundingTime int64  `json:"nextFundingTime"` // 下次资金费时间，Unix时间戳的毫秒数格式
+	Time            int64  `json:"time"`            // 数据更新时间，Unix时间戳的毫秒数格式
+}
+
+type binanceOpenInterestData struct {
+	Symbol       string `json:"symbol"`       // 交易对
+	OpenInterest string `json:"openInterest"` // 持仓量，以币为单位
+	Time         int64  `json:"time"`         // 数据更新时间，Unix时间戳的毫秒数格式
+}
+
+type binanceDepthData struct {
+	LastUpdateId int64      `json:"lastUpdateId"`
+	E            int64      `json:"E"`    // 消息时间，Unix时间戳的毫秒数格式
+	Bids         [][]string `json:"bids"` // 买方深度 [价格, 数量]
+	Asks         [][]string `json:"asks"` // 卖方深度 [价格, 数量]
+}
+
+// BinanceExchange Binance U本位合约交易所实现
+// 目前仅支持公共行情数据，用于跨交易所行情比较，交易相关接口返回 ErrNotSupported
+type BinanceExchange struct {
+	name     string
+	apiURL   string
+	proxyURL string
+	client   *http.Client
+	account  *model.FoxAccount
+}
+
+// NewBinanceExchange 创建Binance交易所实例
+func NewBinanceExchange(apiURL, proxyURL string) *BinanceExchange {
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+	}
+
+	// 如果设置了代理
+	if proxyURL != "" {
+		proxyURLParsed, err := url.Parse(proxyURL)
+		if err == nil {
+			client.Transport = &http.Transport{
+				Proxy: http.ProxyURL(proxyURLParsed),
+			}
+		}
+	}
+
+	return &BinanceExchange{
+		name:     "binance",
+		apiURL:   apiURL,
+		proxyURL: proxyURL,
+		client:   client,
+	}
+}
+
+func (e *BinanceExchange) GetName() string {
+	return e.name
+}
+
+func (e *BinanceExchange) GetAPIURL() string {
+	return e.apiURL
+}
+
+func (e *BinanceExchange) GetProxyURL() string {
+	return e.proxyURL
+}
+
+func (e *BinanceExchange) Connect(ctx context.Context, account *model.FoxAccount) error {
+	return e.notSupported("Connect")
+}
+
+func (e *BinanceExchange) Disconnect() error {
+	e.account = nil
+	return nil
+}
+
+func (e *BinanceExchange) SetAccount(ctx context.Context, account *model.FoxAccount) error {
+	e.account = account
+	return nil
+}
+
+func (e *BinanceExchange) GetAccount(ctx context.Context) (*model.FoxAccount, error) {
+	return e.account, nil
+}
+
+func (e *BinanceExchange) GetAccountConfig(ctx context.Context) (*AccountConfig, error) {
+	return nil, e.notSupported("GetAccountConfig")
+}
+
+func (e *BinanceExchange) GetBalance(ctx context.Context) ([]Asset, error) {
+	return nil, e.notSupported("GetBalance")
+}
+
+func (e *BinanceExchange) GetPositions(ctx context.Context) ([]Position, error) {
+	return nil, e.notSupported("GetPositions")
+}
+
+func (e *BinanceExchange) GetRealizedPnl(ctx context.Context, since time.Time) (string, error) {
+	return "", e.notSupported("GetRealizedPnl")
+}
+
+func (e *BinanceExchange) ClosePosition(ctx context.Context, closePosition *ClosePosition) error {
+	return e.notSupported("ClosePosition")
+}
+
+func (e *BinanceExchange) SetPositionMode(ctx context.Context, positionMode string) error {
+	return e.notSupported("SetPositionMode")
+}
+
+func (e *BinanceExchange) GetClientOrderId(ctx context.Context) string {
+	return ""
+}
+
+func (e *BinanceExchange) GetOrders(ctx context.Context, symbol string, status string) ([]Order, error) {
+	return nil, e.notSupported("GetOrders")
+}
+
+func (e *BinanceExchange) GetOrder(ctx context.Context, symbol string, clientOrderID string) (*Order, error) {
+	return nil, e.notSupported("GetOrder")
+}
+
+func (e *BinanceExchange) CreateOrder(ctx context.Context, order *Order) (*Order, error) {
+	return nil, e.notSupported("CreateOrder")
+}
+
+func (e *BinanceExchange) CancelOrder(ctx context.Context, order *Order) error {
+	return e.notSupported("CancelOrder")
+}
+
+func (e *BinanceExchange) CalcOrderCost(ctx context.Context, req *OrderCostReq) (*OrderCostResp, error) {
+	return nil, e.notSupported("CalcOrderCost")
+}
+
+func (e *BinanceExchange) GetSymbols(ctx context.Context, symbol string) (*Symbol, error) {
+	return nil, e.notSupported("GetSymbols")
+}
+
+func (e *BinanceExchange) GetAllSymbols(ctx context.Context, instType string) ([]Symbol, error) {
+	return nil, e.notSupported("GetAllSymbols")
+}
+
+func (e *BinanceExchange) SetLeverage(ctx context.Context, symbol string, leverage int64, marginType string) error {
+	return e.notSupported("SetLeverage")
+}
+
+func (e *BinanceExchange) SetMarginType(ctx context.Context, symbol string, marginType string) error {
+	return e.notSupported("SetMarginType")
+}
+
+func (e *BinanceExchange) GetLeverageMarginType(ctx context.Context, margin, symbol string) ([]SymbolLeverageMarginType, error) {
+	return nil, e.notSupported("GetLeverageMarginType")
+}
+
+// GetTicker 获取行情数据
+// symbol: 交易对，如 BTCUSDT
+func (e *BinanceExchange) GetTicker(ctx context.Context, symbol string) (*Ticker, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+
+	var data binanceTickerData
+	if err := e.getPublicData(ctx, binanceUriTicker24hr, params, &data); err != nil {
+		return nil, fmt.Errorf("binance GetTicker error for %s: %w", symbol, err)
+	}
+
+	return convertBinanceTicker(data), nil
+}
+
+// GetTickers 获取全部交易对行情数据
+func (e *BinanceExchange) GetTickers(ctx context.Context) ([]Ticker, error) {
+	var data []binanceTickerData
+	if err := e.getPublicData(ctx, binanceUriTicker24hr, url.Values{}, &data); err != nil {
+		return nil, fmt.Errorf("binance GetTickers error: %w", err)
+	}
+
+	tickers := make([]Ticker, 0, len(data))
+	for _, item := range data {
+		tickers = append(tickers, *convertBinanceTicker(item))
+	}
+
+	return tickers, nil
+}
+
+// GetFundingRate 获取永续合约当前资金费率
+// Binance 不提供下一期预测资金费率，NextFundingRate 为空
+func (e *BinanceExchange) GetFundingRate(ctx context.Context, symbol string) (*FundingRate, error) {
+	data, err := e.getPremiumIndex(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("binance GetFundingRate error for %s: %w", symbol, err)
+	}
+
+	return &FundingRate{
+		Symbol:      data.Symbol,
+		FundingRate: data.LastFundingRate,
+		FundingTime: time.UnixMilli(data.NextFundingTime),
+		Timestamp:   time.UnixMilli(data.Time),
+	}, nil
+}
+
+// GetMarkPrice 获取标记价格
+func (e *BinanceExchange) GetMarkPrice(ctx context.Context, symbol string) (*MarkPrice, error) {
+	data, err := e.getPremiumIndex(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("binance GetMarkPrice error for %s: %w", symbol, err)
+	}
+
+	return &MarkPrice{
+		Symbol:    data.Symbol,
+		MarkPrice: data.MarkPrice,
+		Timestamp: time.UnixMilli(data.Time),
+	}, nil
+}
+
+// GetIndexPrice 获取指数价格
+func (e *BinanceExchange) GetIndexPrice(ctx context.Context, symbol string) (*IndexPrice, error) {
+	data, err := e.getPremiumIndex(ctx, symbol)
+	if err != nil {
+		return nil, fmt.Errorf("binance GetIndexPrice error for %s: %w", symbol, err)
+	}
+
+	return &IndexPrice{
+		Symbol:     data.Symbol,
+		IndexPrice: data.IndexPrice,
+		Timestamp:  time.UnixMilli(data.Time),
+	}, nil
+}
+
+// GetOpenInterest 获取永续合约持仓总量
+// Binance 接口仅返回以币为单位的持仓量，OpenInterestUsd 为空
+func (e *BinanceExchange) GetOpenInterest(ctx context.Context, symbol string) (*OpenInterest, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+
+	var data binanceOpenInterestData
+	if err := e.getPublicData(ctx, binanceUriOpenInterest, params, &data); err != nil {
+		return nil, fmt.Errorf("binance GetOpenInterest error for %s: %w", symbol, err)
+	}
+
+	return &OpenInterest{
+		Symbol:       data.Symbol,
+		OpenInterest: data.OpenInterest,
+		Timestamp:    time.UnixMilli(data.Time),
+	}, nil
+}
+
+// GetOrderBook 获取订单簿深度
+// depth 会向上取整到 Binance 支持的档位数量
+func (e *BinanceExchange) GetOrderBook(ctx context.Context, symbol string, depth int) (*OrderBook, error) {
+	limit := binanceDepthLimits[len(binanceDepthLimits)-1]
+	for _, l := range binanceDepthLimits {
+		if l >= depth {
+			limit = l
+			break
+		}
+	}
+
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	params.Set("limit", strconv.Itoa(limit))
+
+	var data binanceDepthData
+	if err := e.getPublicData(ctx, binanceUriDepth, params, &data); err != nil {
+		return nil, fmt.Errorf("binance GetOrderBook error for %s: %w", symbol, err)
+	}
+
+	book := &OrderBook{
+		Symbol:    symbol,
+		Bids:      convertBinanceBookLevels(data.Bids, depth),
+		Asks:      convertBinanceBookLevels(data.Asks, depth),
+		Timestamp: time.UnixMilli(data.E),
+	}
+
+	return book, nil
+}
+
+// GetKlineData 获取K线数据，按时间从新到旧排列（与OKX保持一致）
+// symbol: 交易对，如 BTCUSDT
+// interval: K线周期，如 1m, 15m, 1h, 1d
+// limit: 返回的K线数据条数，最大为1500
+func (e *BinanceExchange) GetKlineData(ctx context.Context, symbol, interval string, limit int) ([]KlineData, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	params.Set("interval", interval)
+	params.Set("limit", strconv.Itoa(limit))
+
+	var rawData [][]interface{}
+	if err := e.getPublicData(ctx, binanceUriKlines, params, &rawData); err != nil {
+		return nil, fmt.Errorf("binance GetKlineData error for %s: %w", symbol, err)
+	}
+
+	klineData := make([]KlineData, 0, len(rawData))
+	for i := len(rawData) - 1; i >= 0; i-- {
+		item := rawData[i]
+		if len(item) < 6 {
+			continue // 跳过数据不完整的项
+		}
+
+		openTime, ok := item[0].(float64)
+		if !ok {
+			continue
+		}
+
+		open, _ := item[1].(string)
+		high, _ := item[2].(string)
+		low, _ := item[3].(string)
+		closePrice, _ := item[4].(string)
+		volumeStr, _ := item[5].(string)
+
+		volume, err := strconv.ParseFloat(volumeStr, 64)
+		if err != nil || open == "" || high == "" || low == "" || closePrice == "" {
+			continue
+		}
+
+		klineData = append(klineData, KlineData{
+			Open:      open,
+			High:      high,
+			Low:       low,
+			Close:     closePrice,
+			Volume:    volume,
+			Timestamp: time.UnixMilli(int64(openTime)),
+		})
+	}
+
+	return klineData, nil
+}
+
+// ConvertToExchangeSymbol 将用户格式的币种名称转换为Binance交易所格式
+// 例如：BTC -> BTCUSDT
+func (e *BinanceExchange) ConvertToExchangeSymbol(accountSymbol string) string {
+	return accountSymbol + "USDT"
+}
+
+// ConvertFromExchangeSymbol 将Binance交易所格式的币种名称转换为用户格式
+// 例如：BTCUSDT -> BTC
+func (e *BinanceExchange) ConvertFromExchangeSymbol(exchangeSymbol string) string {
+	return strings.TrimSuffix(exchangeSymbol, "USDT")
+}
+
+// GetSwapSymbolByName 获取永续合约交易对
+func (e *BinanceExchange) GetSwapSymbolByName(ctx context.Context, coinName string) string {
+	return coinName + "USDT"
+}
+
+// ConvertIntervalFormat 转换时间间隔格式以适配Binance交易所
+// Binance 使用小写单位，仅月线为大写 M
+func (e *BinanceExchange) ConvertIntervalFormat(interval string) string {
+	switch interval {
+	case "1M":
+		return "1M"
+	case "1W":
+		return "1w"
+	case "1D":
+		return "1d"
+	}
+
+	return strings.ToLower(interval)
+}
+
+// getPremiumIndex 获取标记价格、指数价格和资金费率
+func (e *BinanceExchange) getPremiumIndex(ctx context.Context, symbol string) (*binancePremiumIndexData, error) {
+	params := url.Values{}
+	params.Set("symbol", symbol)
+
+	var data binancePremiumIndexData
+	if err := e.getPublicData(ctx, binanceUriPremiumIndex, params, &data); err != nil {
+		return nil, err
+	}
+
+	return &data, nil
+}
+
+// getPublicData 请求公共行情接口并将响应解析到 out 中
+func (e *BinanceExchange) getPublicData(ctx context.Context, uri string, params url.Values, out interface{}) error {
+	fullURL := e.apiURL + uri
+	if len(params) > 0 {
+		fullURL = fmt.Sprintf("%s?%s", fullURL, params.Encode())
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fullURL, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp binanceErrorResp
+		if err := json.Unmarshal(respBody, &errResp); err == nil && errResp.Msg != "" {
+			return fmt.Errorf("msg: %s, code: %d", errResp.Msg, errResp.Code)
+		}
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to unmarshal result data: %w", err)
+	}
+
+	return nil
+}
+
+// notSupported 返回交易相关接口不支持的错误
+func (e *BinanceExchange) notSupported(method string) error {
+	return fmt.Errorf("binance %s: %w", method, ErrNotSupported)
+}
+
+// convertBinanceTicker 转换Binance行情数据
+func convertBinanceTicker(data binanceTickerData) *Ticker {
+	return &Ticker{
+		Symbol:    data.Symbol,
+		Price:     data.LastPrice,
+		High:      data.HighPrice,
+		Low:       data.LowPrice,
+		Volume:    data.Volume,
+		Timestamp: time.UnixMilli(data.CloseTime),
+	}
+}
+
+// convertBinanceBookLevels 转换Binance深度档位，最多保留 depth 档
+func convertBinanceBookLevels(levels [][]string, depth int) []OrderBookLevel {
+	res := make([]OrderBookLevel, 0, len(levels))
+	for _, level := range levels {
+		if len(level) < 2 {
+			continue
+		}
+		if depth > 0 && len(res) >= depth {
+			break
+		}
+		res = append(res, OrderBookLevel{
+			Price: level[0],
+			Size:  level[1],
+		})
+	}
+	return res
+}
@@ -0,0 +1,105 @@
+package exchange
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBinanceExchange_MarketData(t *testing.T) {
+	responses := map[string]string{
+		binanceUriTicker24hr:   `{"symbol":"BTCUSDT","lastPrice":"65080.5","highPrice":"66000","lowPrice":"64000","volume":"12345.6","quoteVolume":"800000000","closeTime":1699999990000}`,
+		binanceUriPremiumIndex: `{"symbol":"BTCUSDT","markPrice":"65079.1","indexPrice":"65070.2","lastFundingRate":"0.0001","nextFundingTime":1700006400000,"time":1699999991000}`,
+		binanceUriOpenInterest: `{"symbol":"BTCUSDT","openInterest":"80000.5","time":1699999992000}`,
+		binanceUriDepth:        `{"lastUpdateId":1,"E":1699999993000,"T":1699999993000,"bids":[["65080.4","3"],["65080.3","2"]],"asks":[["65080.6","1"],["65080.7","4"]]}`,
+		binanceUriKlines:       `[[1699999800000,"65000","65100","64900","65050","100.5",1699999859999],[1699999860000,"65050","65150","65000","65080","80.2",1699999919999]]`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":-1121,"msg":"Invalid symbol."}`))
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	ex := NewBinanceExchange(server.URL, "")
+	ctx := context.Background()
+
+	symbol := ex.GetSwapSymbolByName(ctx, "BTC")
+	if symbol != "BTCUSDT" {
+		t.Fatalf("交易对转换错误: %s", symbol)
+	}
+
+	ticker, err := ex.GetTicker(ctx, symbol)
+	if err != nil {
+		t.Fatalf("获取行情失败: %v", err)
+	}
+	if ticker.Symbol != "BTCUSDT" || ticker.Price != "65080.5" || ticker.Timestamp.UnixMilli() != 1699999990000 {
+		t.Errorf("行情解析错误: %+v", ticker)
+	}
+
+	fundingRate, err := ex.GetFundingRate(ctx, symbol)
+	if err != nil || fundingRate.FundingRate != "0.0001" {
+		t.Errorf("资金费率解析错误: %+v, %v", fundingRate, err)
+	}
+
+	markPrice, err := ex.GetMarkPrice(ctx, symbol)
+	if err != nil || markPrice.MarkPrice != "65079.1" {
+		t.Errorf("标记价格解析错误: %+v, %v", markPrice, err)
+	}
+
+	indexPrice, err := ex.GetIndexPrice(ctx, symbol)
+	if err != nil || indexPrice.IndexPrice != "65070.2" {
+		t.Errorf("指数价格解析错误: %+v, %v", indexPrice, err)
+	}
+
+	openInterest, err := ex.GetOpenInterest(ctx, symbol)
+	if err != nil || openInterest.OpenInterest != "80000.5" {
+		t.Errorf("持仓总量解析错误: %+v, %v", openInterest, err)
+	}
+
+	book, err := ex.GetOrderBook(ctx, symbol, 1)
+	if err != nil {
+		t.Fatalf("获取订单簿失败: %v", err)
+	}
+	if len(book.Bids) != 1 || len(book.Asks) != 1 || book.Bids[0].Price != "65080.4" || book.Asks[0].Price != "65080.6" {
+		t.Errorf("订单簿解析错误: %+v", book)
+	}
+
+	klines, err := ex.GetKlineData(ctx, symbol, ex.ConvertIntervalFormat("1m"), 2)
+	if err != nil {
+		t.Fatalf("获取K线失败: %v", err)
+	}
+	// 与OKX一致，最新的K线在前
+	if len(klines) != 2 || klines[0].Close != "65080" || klines[1].Close != "65050" || klines[0].Volume != 80.2 {
+		t.Errorf("K线解析错误: %+v", klines)
+	}
+}
+
+func TestBinanceExchange_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":-1121,"msg":"Invalid symbol."}`))
+	}))
+	defer server.Close()
+
+	ex := NewBinanceExchange(server.URL, "")
+	ctx := context.Background()
+
+	if _, err := ex.GetTicker(ctx, "UNKNOWNUSDT"); err == nil {
+		t.Error("接口返回错误时应返回错误")
+	}
+
+	if _, err := ex.CreateOrder(ctx, &Order{}); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("交易接口应返回 ErrNotSupported，实际 %v", err)
+	}
+
+	if _, err := ex.GetAllSymbols(ctx, "SWAP"); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("GetAllSymbols 应返回 ErrNotSupported，实际 %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package exchange
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/lemconn/foxflow/internal/pkg/dao/model"
@@ -13,6 +14,17 @@ const (
 	MarginTypeIsolated = "isolated" // 逐仓
 )
 
+// ErrOrderNotFound 交易所查询不到该订单（如未成交即撤销且已超过交易所保留期限的订单）
+var ErrOrderNotFound = errors.New("order not found")
+
+// 订单状态
+const (
+	OrderStatusLive            = "live"             // 等待成交
+	OrderStatusPartiallyFilled = "partially_filled" // 部分成交
+	OrderStatusFilled          = "filled"           // 完全成交
+	OrderStatusCanceled        = "canceled"         // 已撤销
+)
+
 // Order 订单信息
 type Order struct {
 	ID             string           `json:"id"`
@@ -27,6 +39,7 @@ type Order struct {
 	Status         string           `json:"status"`
 	Filled         float64          `json:"filled"`
 	Remain         float64          `json:"remain"`
+	ReduceOnly     bool             `json:"reduce_only"` // 是否只减仓（部分平仓）
 	OrderCondition []OrderCondition `json:"order_condition"`
 }
 
@@ -48,7 +61,7 @@ type OrderCostReq struct {
 	Amount     string `json:"amount"`      // 购买数量（标的数量）
 	AmountType string `json:"amount_type"` // 数量类型：coin(标的数量) / usdt(USDT数量)
 	MarginType string `json:"margin_type"` // 保证金模式：isolated(逐仓) / cross(全仓)
-	LimitPrice string `json:"limit_price"` // 限价（目前不需要传递）
+	LimitPrice string `json:"limit_price"` // 限价（为空或 0 时按市价单计算手续费）
 }
 
 type OrderCostResp struct {
@@ -72,6 +85,7 @@ type Position struct {
 	Size       string `json:"size"`
 	AvgPrice   string `json:"avg_price"`
 	UnrealPnl  string `json:"unreal_pnl"`
+	Notional   string `json:"notional"` // 持仓名义价值（USD）
 }
 
 // Asset 资产信息
@@ -89,16 +103,64 @@ type Symbol struct {
 	Quote         string `json:"quote"`
 	MaxLever      int64  `json:"max_lever"`
 	MinSize       string `json:"min_size"`       // 最小下单（合约：张，现货：交易货币）
+	LotSize       string `json:"lot_size"`       // 下单数量精度（合约：张，现货：交易货币），如 0.1
+	TickSize      string `json:"tick_size"`      // 下单价格精度，如 0.1
 	ContractValue string `json:"contract_value"` // 张/标的的换算单位（1张=0.01个BTC，这里是0.01）
 }
 
 // Ticker 行情信息
 type Ticker struct {
-	Symbol string `json:"symbol"`
-	Price  string `json:"price"`
-	Volume string `json:"volume"`
-	High   string `json:"high"`
-	Low    string `json:"low"`
+	Symbol    string    `json:"symbol"`
+	Price     string    `json:"price"`
+	Volume    string    `json:"volume"`
+	High      string    `json:"high"`
+	Low       string    `json:"low"`
+	Timestamp time.Time `json:"timestamp"` // 行情数据产生时间
+}
+
+// FundingRate 永续合约资金费率
+type FundingRate struct {
+	Symbol          string    `json:"symbol"`
+	FundingRate     string    `json:"funding_rate"`      // 当前资金费率
+	NextFundingRate string    `json:"next_funding_rate"` // 下一期预测资金费率
+	FundingTime     time.Time `json:"funding_time"`      // 资金费时间
+	Timestamp       time.Time `json:"timestamp"`
+}
+
+// MarkPrice 标记价格
+type MarkPrice struct {
+	Symbol    string    `json:"symbol"`
+	MarkPrice string    `json:"mark_price"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// IndexPrice 指数价格
+type IndexPrice struct {
+	Symbol     string    `json:"symbol"` // 指数名称，如 BTC-USDT
+	IndexPrice string    `json:"index_price"`
+	Timestamp  time.Time `json:"timestamp"`
+}
+
+// OpenInterest 持仓总量
+type OpenInterest struct {
+	Symbol          string    `json:"symbol"`
+	OpenInterest    string    `json:"open_interest"`     // 持仓量，以币为单位
+	OpenInterestUsd string    `json:"open_interest_usd"` // 持仓量，以美元为单位
+	Timestamp       time.Time `json:"timestamp"`
+}
+
+// OrderBookLevel 深度档位
+type OrderBookLevel struct {
+	Price string `json:"price"`
+	Size  string `json:"size"` // 挂单数量（合约：张，现货：交易货币）
+}
+
+// OrderBook 订单簿深度
+type OrderBook struct {
+	Symbol    string           `json:"symbol"`
+	Bids      []OrderBookLevel `json:"bids"` // 买盘，价格从高到低
+	Asks      []OrderBookLevel `json:"asks"` // 卖盘，价格从低到高
+	Timestamp time.Time        `json:"timestamp"`
 }
 
 // KlineData K线数据
@@ -152,10 +214,12 @@ type Exchange interface {
 	GetPositions(ctx context.Context) ([]Position, error)
 	ClosePosition(ctx context.Context, closePosition *ClosePosition) error
 	SetPositionMode(ctx context.Context, positionMode string) error
+	GetRealizedPnl(ctx context.Context, since time.Time) (string, error) // 获取 since 之后平仓的已实现盈亏（USDT）
 
 	// 订单管理
 	GetClientOrderId(ctx context.Context) string
 	GetOrders(ctx context.Context, symbol string, status string) ([]Order, error)
+	GetOrder(ctx context.Context, symbol string, clientOrderID string) (*Order, error) // 按客户自定义订单ID获取订单（含已完成订单），用于确认最终成交状态
 	CreateOrder(ctx context.Context, order *Order) (*Order, error)
 	CancelOrder(ctx context.Context, order *Order) error
 	CalcOrderCost(ctx context.Context, req *OrderCostReq) (*OrderCostResp, error) // 计算order成本（手续费+可买价格，是否可成交等等）
@@ -164,6 +228,15 @@ type Exchange interface {
 	GetTicker(ctx context.Context, symbol string) (*Ticker, error)
 	GetTickers(ctx context.Context) ([]Ticker, error)
 
+	// 衍生品行情数据
+	GetFundingRate(ctx context.Context, symbol string) (*FundingRate, error)
+	GetMarkPrice(ctx context.Context, symbol string) (*MarkPrice, error)
+	GetIndexPrice(ctx context.Context, symbol string) (*IndexPrice, error)
+	GetOpenInterest(ctx context.Context, symbol string) (*OpenInterest, error)
+
+	// 订单簿深度
+	GetOrderBook(ctx context.Context, symbol string, depth int) (*OrderBook, error)
+
 	// 标的配置
 	GetSymbols(ctx context.Context, symbol string) (*Symbol, error)
 	GetAllSymbols(ctx context.Context, instType string) ([]Symbol, error)
@@ -54,15 +54,27 @@ func (m *Manager) initExchanges() {
 		switch exchange.Name {
 		case "okx":
 			m.exchanges[exchange.Name] = NewOKXExchange(exchange.APIURL, exchange.ProxyURL)
+		case "binance":
+			m.exchanges[exchange.Name] = NewBinanceExchange(exchange.APIURL, exchange.ProxyURL)
 		}
 	}
+
+	m.initMarketDataExchanges()
 }
 
 // initDefaultExchanges 初始化默认交易所
 func (m *Manager) initDefaultExchanges() {
 	m.exchanges["okx"] = NewOKXExchange("https://www.okx.com", "")
-	//m.exchanges["binance"] = NewBinanceExchange("https://api.binance.com", "")
 	//m.exchanges["gate"] = NewGateExchange("https://api.gateio.ws", "")
+	m.initMarketDataExchanges()
+}
+
+// initMarketDataExchanges 初始化仅提供公共行情的交易所
+// 这些交易所不需要账户配置，用于策略中跨交易所的行情比较
+func (m *Manager) initMarketDataExchanges() {
+	if _, exists := m.exchanges["binance"]; !exists {
+		m.exchanges["binance"] = NewBinanceExchange(binanceDefaultFutureURL, "")
+	}
 }
 
 // GetExchange 获取交易所实例
@@ -78,6 +90,31 @@ func (m *Manager) GetExchange(name string) (Exchange, error) {
 	return exchange, nil
 }
 
+// NewAccountExchange 创建绑定指定账户的交易所实例
+// 新实例沿用共享实例的接口地址与代理配置，账户仅属于本次调用，不会修改共享实例上的账户
+func (m *Manager) NewAccountExchange(ctx context.Context, name string, account *model.FoxAccount) (Exchange, error) {
+	shared, err := m.GetExchange(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var exchange Exchange
+	switch name {
+	case "okx":
+		exchange = NewOKXExchange(shared.GetAPIURL(), shared.GetProxyURL())
+	case "binance":
+		exchange = NewBinanceExchange(shared.GetAPIURL(), shared.GetProxyURL())
+	default:
+		return nil, fmt.Errorf("exchange %s not supported", name)
+	}
+
+	if err := exchange.SetAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	return exchange, nil
+}
+
 // GetAvailableExchanges 获取可用的交易所列表
 func (m *Manager) GetAvailableExchanges() []string {
 	m.mu.RLock()
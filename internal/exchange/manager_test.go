@@ -0,0 +1,41 @@
+package exchange
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+func TestManager_NewAccountExchange(t *testing.T) {
+	shared := NewOKXExchange("https://okx.example.com", "http://127.0.0.1:7890")
+	m := &Manager{exchanges: map[string]Exchange{"okx": shared}}
+
+	sharedAccount := &model.FoxAccount{ID: 1, Name: "shared", Exchange: "okx"}
+	if err := shared.SetAccount(context.Background(), sharedAccount); err != nil {
+		t.Fatalf("SetAccount() error = %v", err)
+	}
+
+	account := &model.FoxAccount{ID: 2, Name: "strategy", Exchange: "okx"}
+	instance, err := m.NewAccountExchange(context.Background(), "okx", account)
+	if err != nil {
+		t.Fatalf("NewAccountExchange() error = %v", err)
+	}
+
+	if instance == Exchange(shared) {
+		t.Fatal("应创建新的交易所实例，而不是返回共享实例")
+	}
+	if instance.GetAPIURL() != shared.GetAPIURL() || instance.GetProxyURL() != shared.GetProxyURL() {
+		t.Errorf("新实例应沿用共享实例配置，got %s %s", instance.GetAPIURL(), instance.GetProxyURL())
+	}
+	if got, _ := instance.GetAccount(context.Background()); got != account {
+		t.Errorf("新实例账户 = %+v, want %+v", got, account)
+	}
+	if got, _ := shared.GetAccount(context.Background()); got != sharedAccount {
+		t.Errorf("共享实例账户被修改为 %+v", got)
+	}
+
+	if _, err := m.NewAccountExchange(context.Background(), "gate", account); err == nil {
+		t.Error("未配置的交易所应返回错误")
+	}
+}
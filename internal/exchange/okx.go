@@ -21,6 +21,9 @@ import (
 const (
 	okxPublicUriInstruments         = "/api/v5/public/instruments"
 	okxPublicUriConvertContractCoin = "/api/v5/public/convert-contract-coin"
+	okxPublicUriFundingRate         = "/api/v5/public/funding-rate"
+	okxPublicUriMarkPrice           = "/api/v5/public/mark-price"
+	okxPublicUriOpenInterest        = "/api/v5/public/open-interest"
 	okxUriSetLeverage               = "/api/v5/account/set-leverage"
 	okxUriGetLeverageInfo           = "/api/v5/account/leverage-info"
 	okxUriGetTradeFee               = "/api/v5/account/trade-fee"
@@ -30,14 +33,21 @@ const (
 	okxUriUserAssetValuation   = "/api/v5/asset/asset-valuation"
 	okxUriUserBalance          = "/api/v5/account/balance"
 	okxUriUserPositions        = "/api/v5/account/positions"
+	okxUriUserPositionsHistory = "/api/v5/account/positions-history"
 	okxUriUserTradeOrder       = "/api/v5/trade/order"
+	okxUriUserOrdersPending    = "/api/v5/trade/orders-pending"
 	okxUriUserTradeCancelOrder = "/api/v5/trade/cancel-order"
 	okxUriUserClosePositions   = "/api/v5/trade/close-position"
 
-	okxUriMarkPriceCandles = "/priapi/v5/market/candles"
-	okxUriMarketTicker     = "/api/v5/market/ticker"
+	okxUriMarkPriceCandles   = "/priapi/v5/market/candles"
+	okxUriMarketTicker       = "/api/v5/market/ticker"
+	okxUriMarketIndexTickers = "/api/v5/market/index-tickers"
+	okxUriMarketBooks        = "/api/v5/market/books"
 )
 
+// okxCodeOrderNotExist 订单不存在
+const okxCodeOrderNotExist = "51603"
+
 const (
 	UserTradeTypeMock = "mock"
 	UserTradeTypeLive = "live"
@@ -438,6 +448,7 @@ func (e *OKXExchange) GetPositions(ctx context.Context) ([]Position, error) {
 			Size:       positionInfo.Pos,
 			AvgPrice:   positionInfo.AvgPx,
 			UnrealPnl:  positionInfo.Upl,
+			Notional:   positionInfo.NotionalUsd,
 		}
 	
 		res = append(res, position)
@@ -446,6 +457,77 @@ func (e *OKXExchange) GetPositions(ctx context.Context) ([]Position, error) {
 	return res, nil
 }
 
+// okxPositionsHistoryPageSize 历史持仓接口单页最大条数
+const okxPositionsHistoryPageSize = 100
+
+// okxPositionsHistoryMaxPages 统计已实现盈亏时最多翻页次数
+const okxPositionsHistoryMaxPages = 10
+
+type okxPositionsHistoryResp struct {
+	InstId      string `json:"instId"`      // 产品ID
+	PosSide     string `json:"posSide"`     // 持仓方向
+	RealizedPnl string `json:"realizedPnl"` // 已实现收益（含手续费、资金费）
+	UTime       string `json:"uTime"`       // 仓位更新时间（平仓时间），Unix时间戳的毫秒数格式
+}
+
+// GetRealizedPnl 获取 since 之后平仓的永续合约已实现盈亏之和
+func (e *OKXExchange) GetRealizedPnl(ctx context.Context, since time.Time) (string, error) {
+	if e.account == nil || e.account.AccessKey == "" || e.account.SecretKey == "" || e.account.Passphrase == "" {
+		return "", fmt.Errorf("account information is missing, account: %+v ", e.account)
+	}
+
+	sinceMs := since.UnixMilli()
+	total := decimal.Zero
+	after := ""
+
+	// 接口按更新时间从新到旧返回，翻页直到早于 since
+	for page := 0; page < okxPositionsHistoryMaxPages; page++ {
+		params := url.Values{}
+		params.Set("instType", "SWAP")
+		params.Set("limit", strconv.Itoa(okxPositionsHistoryPageSize))
+		if after != "" {
+			params.Set("after", after)
+		}
+
+		result, err := e.sendRequest(ctx, "GET", fmt.Sprintf("%s?%s", okxUriUserPositionsHistory, params.Encode()), nil)
+		if err != nil {
+			return "", fmt.Errorf("okx get positions history err: %w", err)
+		}
+		if result.Code != "0" {
+			return "", fmt.Errorf("okx get positions history error: %s", result.Msg)
+		}
+
+		var histories []okxPositionsHistoryResp
+		resultBytes, _ := json.Marshal(result.Data)
+		if err := json.Unmarshal(resultBytes, &histories); err != nil {
+			return "", fmt.Errorf("okx get positions history err: %w", err)
+		}
+
+		for _, history := range histories {
+			uTime, err := strconv.ParseInt(history.UTime, 10, 64)
+			if err != nil {
+				return "", fmt.Errorf("failed to parse position history uTime: %w", err)
+			}
+			if uTime < sinceMs {
+				return total.String(), nil
+			}
+
+			pnl, err := decimal.NewFromString(history.RealizedPnl)
+			if err != nil {
+				return "", fmt.Errorf("failed to parse position history realizedPnl: %w", err)
+			}
+			total = total.Add(pnl)
+			after = history.UTime
+		}
+
+		if len(histories) < okxPositionsHistoryPageSize {
+			break
+		}
+	}
+
+	return total.String(), nil
+}
+
 type oxkClosePositionsRequest struct {
 	InstId  string `json:"instId"`            // 产品ID，如 BTC-USDT-SWAP
 	PosSide string `json:"posSide,omitempty"` // 持仓方向: long, short, net
@@ -533,12 +615,142 @@ func (e *OKXExchange) getConvertContractCoin(ctx context.Context, req *okxConver
 	return &okxConvertInfos[0], nil
 }
 
+type okxPendingOrderResp struct {
+	OrdId     string `json:"ordId"`     // 订单ID
+	ClOrdId   string `json:"clOrdId"`   // 客户自定义订单ID
+	InstId    string `json:"instId"`    // 产品ID
+	Side      string `json:"side"`      // 订单方向
+	PosSide   string `json:"posSide"`   // 持仓方向
+	TdMode    string `json:"tdMode"`    // 交易模式
+	Px        string `json:"px"`        // 委托价格
+	Sz        string `json:"sz"`        // 委托数量
+	OrdType   string `json:"ordType"`   // 订单类型
+	State     string `json:"state"`     // 订单状态 live：等待成交 partially_filled：部分成交
+	AccFillSz string `json:"accFillSz"` // 累计成交数量
+}
+
+// okxOrdersPendingLimit 未成交订单列表单页返回的最大数量
+const okxOrdersPendingLimit = 100
+
+// GetOrders 获取未成交的永续合约订单，symbol 为空表示所有标的，status 为空表示所有未成交状态（live/partially_filled）
+// 接口单页最多返回 100 条，按 after 游标分页直到取完，避免遗漏的挂单被调用方当作已成交
 func (e *OKXExchange) GetOrders(ctx context.Context, symbol string, status string) ([]Order, error) {
-	if e.account == nil {
-		return nil, fmt.Errorf("account not connected")
+	if e.account == nil || e.account.AccessKey == "" || e.account.SecretKey == "" || e.account.Passphrase == "" {
+		return nil, fmt.Errorf("account information is missing, account: %+v ", e.account)
+	}
+
+	params := url.Values{}
+	params.Set("instType", "SWAP")
+	params.Set("limit", strconv.Itoa(okxOrdersPendingLimit))
+	if symbol != "" {
+		params.Set("instId", symbol)
+	}
+	if status != "" {
+		params.Set("state", status)
 	}
 
-	return nil, nil
+	orders := make([]Order, 0)
+	for {
+		result, err := e.sendRequest(ctx, "GET", fmt.Sprintf("%s?%s", okxUriUserOrdersPending, params.Encode()), nil)
+		if err != nil {
+			return nil, fmt.Errorf("okx get pending orders err: %w", err)
+		}
+		if result.Code != "0" {
+			return nil, fmt.Errorf("okx get pending orders error: %s", result.Msg)
+		}
+
+		var pendingOrders []okxPendingOrderResp
+		resultBytes, _ := json.Marshal(result.Data)
+		if err := json.Unmarshal(resultBytes, &pendingOrders); err != nil {
+			return nil, fmt.Errorf("okx get pending orders err: %w", err)
+		}
+
+		for _, pendingOrder := range pendingOrders {
+			size, _ := strconv.ParseFloat(pendingOrder.Sz, 64)
+			filled, _ := strconv.ParseFloat(pendingOrder.AccFillSz, 64)
+			orders = append(orders, Order{
+				ID:         pendingOrder.OrdId,
+				OrderID:    pendingOrder.ClOrdId,
+				Symbol:     pendingOrder.InstId,
+				Side:       pendingOrder.Side,
+				PosSide:    pendingOrder.PosSide,
+				MarginType: pendingOrder.TdMode,
+				Price:      pendingOrder.Px,
+				Size:       pendingOrder.Sz,
+				Type:       pendingOrder.OrdType,
+				Status:     pendingOrder.State,
+				Filled:     filled,
+				Remain:     size - filled,
+			})
+		}
+
+		// 返回数量不足一页说明已取完，否则以本页最后一个订单ID继续请求更早的订单
+		if len(pendingOrders) < okxOrdersPendingLimit {
+			break
+		}
+		params.Set("after", pendingOrders[len(pendingOrders)-1].OrdId)
+	}
+
+	return orders, nil
+}
+
+// GetOrder 按客户自定义订单ID获取永续合约订单，已成交、已撤销的订单同样返回，state 为 canceled、mmp_canceled 时统一为 canceled
+// 未成交即撤销的订单交易所仅保留一段时间，查询不到时返回 ErrOrderNotFound
+func (e *OKXExchange) GetOrder(ctx context.Context, symbol string, clientOrderID string) (*Order, error) {
+	if e.account == nil || e.account.AccessKey == "" || e.account.SecretKey == "" || e.account.Passphrase == "" {
+		return nil, fmt.Errorf("account information is missing, account: %+v ", e.account)
+	}
+
+	params := url.Values{}
+	params.Set("instId", symbol)
+	params.Set("clOrdId", clientOrderID)
+
+	result, err := e.sendRequest(ctx, "GET", fmt.Sprintf("%s?%s", okxUriUserTradeOrder, params.Encode()), nil)
+	if err != nil {
+		return nil, fmt.Errorf("okx get order err: %w", err)
+	}
+	if result.Code == okxCodeOrderNotExist {
+		return nil, fmt.Errorf("okx get order %s: %w", clientOrderID, ErrOrderNotFound)
+	}
+	if result.Code != "0" {
+		return nil, fmt.Errorf("okx get order error: %s, code:%s", result.Msg, result.Code)
+	}
+
+	var orderInfos []okxPendingOrderResp
+	resultBytes, _ := json.Marshal(result.Data)
+	if err := json.Unmarshal(resultBytes, &orderInfos); err != nil {
+		return nil, fmt.Errorf("okx get order err: %w", err)
+	}
+	if len(orderInfos) == 0 {
+		return nil, fmt.Errorf("okx get order %s: %w", clientOrderID, ErrOrderNotFound)
+	}
+
+	orderInfo := orderInfos[0]
+	state := orderInfo.State
+	if state == "mmp_canceled" {
+		state = OrderStatusCanceled
+	}
+	size, _ := strconv.ParseFloat(orderInfo.Sz, 64)
+	filled, _ := strconv.ParseFloat(orderInfo.AccFillSz, 64)
+	remain := size - filled
+	if state != OrderStatusLive && state != OrderStatusPartiallyFilled {
+		remain = 0
+	}
+
+	return &Order{
+		ID:         orderInfo.OrdId,
+		OrderID:    orderInfo.ClOrdId,
+		Symbol:     orderInfo.InstId,
+		Side:       orderInfo.Side,
+		PosSide:    orderInfo.PosSide,
+		MarginType: orderInfo.TdMode,
+		Price:      orderInfo.Px,
+		Size:       orderInfo.Sz,
+		Type:       orderInfo.OrdType,
+		Status:     state,
+		Filled:     filled,
+		Remain:     remain,
+	}, nil
 }
 
 // oxkOrderRequest 主订单结构体
@@ -583,6 +795,17 @@ func (e *OKXExchange) CreateOrder(ctx context.Context, order *Order) (*Order, er
 		return nil, fmt.Errorf("account information is missing, account: %+v ", e.account)
 	}
 
+	// post_only、ioc、fok 与限价单一样需要委托价格
+	switch order.Type {
+	case "market":
+	case "limit", "post_only", "ioc", "fok":
+		if order.Price == "" {
+			return nil, fmt.Errorf("okx %s order requires price", order.Type)
+		}
+	default:
+		return nil, fmt.Errorf("okx unsupported order type: %s", order.Type)
+	}
+
 	reqBody := oxkOrderRequest{
 		ClOrdID: order.OrderID,
 		InstID:  order.Symbol,
@@ -593,12 +816,10 @@ func (e *OKXExchange) CreateOrder(ctx context.Context, order *Order) (*Order, er
 	}
 
 	// 平仓时仅减仓（不做反向建仓）
-	if order.Side == "sell" {
-		reqBody.ReduceOnly = true
-	}
+	reqBody.ReduceOnly = order.ReduceOnly
 
 	// 按类型填充价格与数量
-	if strings.ToLower(order.Type) == "limit" {
+	if order.Type != "market" {
 		reqBody.Px = order.Price
 	}
 	// OKX合约下单数量字段为 sz，单位张。此处直接使用传入数量
@@ -662,9 +883,11 @@ func (e *OKXExchange) CancelOrder(ctx context.Context, order *Order) error {
 		return fmt.Errorf("account information is missing, account: %+v ", e.account)
 	}
 
+	// 未记录交易所订单ID时使用客户自定义订单ID撤单
 	reqBody := oxkCancelOrderRequest{
-		InstId: order.Symbol,
-		OrdId:  order.ID,
+		InstId:  order.Symbol,
+		OrdId:   order.ID,
+		ClOrdId: order.OrderID,
 	}
 
 	reqBodyByte, err := json.Marshal(reqBody)
@@ -724,11 +947,12 @@ func (e *OKXExchange) GetTicker(ctx context.Context, symbol string) (*Ticker, er
 
 	// 转换数据格式
 	ticker := &Ticker{
-		Symbol: tickerData[0].InstId,
-		Price:  tickerData[0].Last,
-		High:   tickerData[0].High24h,
-		Low:    tickerData[0].Low24h,
-		Volume: tickerData[0].VolCcy24h,
+		Symbol:    tickerData[0].InstId,
+		Price:     tickerData[0].Last,
+		High:      tickerData[0].High24h,
+		Low:       tickerData[0].Low24h,
+		Volume:    tickerData[0].VolCcy24h,
+		Timestamp: parseOkxMillis(tickerData[0].Ts),
 	}
 
 	return ticker, nil
@@ -771,11 +995,12 @@ func (e *OKXExchange) GetTickers(ctx context.Context) ([]Ticker, error) {
 	var tickers []Ticker
 	for _, tickerData := range tickerDataList {
 		ticker := Ticker{
-			Symbol: tickerData.InstId,
-			Price:  tickerData.Last,
-			High:   tickerData.High24h,
-			Low:    tickerData.Low24h,
-			Volume: tickerData.VolCcy24h,
+			Symbol:    tickerData.InstId,
+			Price:     tickerData.Last,
+			High:      tickerData.High24h,
+			Low:       tickerData.Low24h,
+			Volume:    tickerData.VolCcy24h,
+			Timestamp: parseOkxMillis(tickerData.Ts),
 		}
 
 		tickers = append(tickers, ticker)
@@ -784,6 +1009,214 @@ func (e *OKXExchange) GetTickers(ctx context.Context) ([]Ticker, error) {
 	return tickers, nil
 }
 
+type okxFundingRateData struct {
+	InstType        string `json:"instType"`        // 产品类型
+	InstId          string `json:"instId"`          // 产品ID
+	FundingRate     string `json:"fundingRate"`     // 资金费率
+	NextFundingRate string `json:"nextFundingRate"` // 下一期预测资金费率
+	FundingTime     string `json:"fundingTime"`     // 资金费时间，Unix时间戳的毫秒数格式
+	NextFundingTime string `json:"nextFundingTime"` // 下一期资金费时间，Unix时间戳的毫秒数格式
+	Ts              string `json:"ts"`              // 数据更新时间，Unix时间戳的毫秒数格式
+}
+
+type okxMarkPriceData struct {
+	InstType string `json:"instType"` // 产品类型
+	InstId   string `json:"instId"`   // 产品ID
+	MarkPx   string `json:"markPx"`   // 标记价格
+	Ts       string `json:"ts"`       // 数据更新时间，Unix时间戳的毫秒数格式
+}
+
+type okxIndexTickerData struct {
+	InstId string `json:"instId"` // 指数，如 BTC-USDT
+	IdxPx  string `json:"idxPx"`  // 最新指数价格
+	Ts     string `json:"ts"`     // 数据产生时间，Unix时间戳的毫秒数格式
+}
+
+type okxOpenInterestData struct {
+	InstType string `json:"instType"` // 产品类型
+	InstId   string `json:"instId"`   // 产品ID
+	Oi       string `json:"oi"`       // 持仓量，按张为单位
+	OiCcy    string `json:"oiCcy"`    // 持仓量，按币为单位
+	OiUsd    string `json:"oiUsd"`    // 持仓量，按美元为单位
+	Ts       string `json:"ts"`       // 数据返回时间，Unix时间戳的毫秒数格式
+}
+
+type okxOrderBookData struct {
+	Asks [][]string `json:"asks"` // 卖方深度 [价格, 数量, 已弃用, 订单数量]
+	Bids [][]string `json:"bids"` // 买方深度 [价格, 数量, 已弃用, 订单数量]
+	Ts   string     `json:"ts"`   // 深度产生的时间，Unix时间戳的毫秒数格式
+}
+
+// getPublicData 请求公共行情接口并将 data 解析到 out 中
+func (e *OKXExchange) getPublicData(ctx context.Context, uri string, params url.Values, out interface{}) error {
+	fullURL := fmt.Sprintf("%s?%s", uri, params.Encode())
+
+	// 发送请求（公共接口，不需要认证）
+	result, err := e.sendRequest(ctx, "GET", fullURL, nil)
+	if err != nil {
+		return err
+	}
+
+	if result.Code != "0" {
+		return fmt.Errorf("msg: %s, code: %s", result.Msg, result.Code)
+	}
+
+	resultBytes, err := json.Marshal(result.Data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result data: %w", err)
+	}
+
+	if err := json.Unmarshal(resultBytes, out); err != nil {
+		return fmt.Errorf("failed to unmarshal result data: %w", err)
+	}
+
+	return nil
+}
+
+// parseOkxMillis 解析OKX毫秒时间戳，无法解析时返回零值
+func parseOkxMillis(ts string) time.Time {
+	millis, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || millis == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis)
+}
+
+// GetFundingRate 获取永续合约当前资金费率
+// symbol: 产品ID，如 BTC-USDT-SWAP
+func (e *OKXExchange) GetFundingRate(ctx context.Context, symbol string) (*FundingRate, error) {
+	params := url.Values{}
+	params.Set("instId", symbol)
+
+	var data []okxFundingRateData
+	if err := e.getPublicData(ctx, okxPublicUriFundingRate, params, &data); err != nil {
+		return nil, fmt.Errorf("okx GetFundingRate error for %s: %w", symbol, err)
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("no funding rate data found for symbol: %s", symbol)
+	}
+
+	return &FundingRate{
+		Symbol:          data[0].InstId,
+		FundingRate:     data[0].FundingRate,
+		NextFundingRate: data[0].NextFundingRate,
+		FundingTime:     parseOkxMillis(data[0].FundingTime),
+		Timestamp:       parseOkxMillis(data[0].Ts),
+	}, nil
+}
+
+// GetMarkPrice 获取标记价格
+// symbol: 产品ID，如 BTC-USDT-SWAP
+func (e *OKXExchange) GetMarkPrice(ctx context.Context, symbol string) (*MarkPrice, error) {
+	params := url.Values{}
+	params.Set("instType", "SWAP")
+	params.Set("instId", symbol)
+
+	var data []okxMarkPriceData
+	if err := e.getPublicData(ctx, okxPublicUriMarkPrice, params, &data); err != nil {
+		return nil, fmt.Errorf("okx GetMarkPrice error for %s: %w", symbol, err)
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("no mark price data found for symbol: %s", symbol)
+	}
+
+	return &MarkPrice{
+		Symbol:    data[0].InstId,
+		MarkPrice: data[0].MarkPx,
+		Timestamp: parseOkxMillis(data[0].Ts),
+	}, nil
+}
+
+// GetIndexPrice 获取指数价格
+// symbol: 产品ID，如 BTC-USDT-SWAP，会转换为对应指数 BTC-USDT
+func (e *OKXExchange) GetIndexPrice(ctx context.Context, symbol string) (*IndexPrice, error) {
+	indexSymbol := strings.TrimSuffix(symbol, "-SWAP")
+
+	params := url.Values{}
+	params.Set("instId", indexSymbol)
+
+	var data []okxIndexTickerData
+	if err := e.getPublicData(ctx, okxUriMarketIndexTickers, params, &data); err != nil {
+		return nil, fmt.Errorf("okx GetIndexPrice error for %s: %w", indexSymbol, err)
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("no index price data found for symbol: %s", indexSymbol)
+	}
+
+	return &IndexPrice{
+		Symbol:     data[0].InstId,
+		IndexPrice: data[0].IdxPx,
+		Timestamp:  parseOkxMillis(data[0].Ts),
+	}, nil
+}
+
+// GetOpenInterest 获取永续合约持仓总量
+// symbol: 产品ID，如 BTC-USDT-SWAP
+func (e *OKXExchange) GetOpenInterest(ctx context.Context, symbol string) (*OpenInterest, error) {
+	params := url.Values{}
+	params.Set("instType", "SWAP")
+	params.Set("instId", symbol)
+
+	var data []okxOpenInterestData
+	if err := e.getPublicData(ctx, okxPublicUriOpenInterest, params, &data); err != nil {
+		return nil, fmt.Errorf("okx GetOpenInterest error for %s: %w", symbol, err)
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("no open interest data found for symbol: %s", symbol)
+	}
+
+	return &OpenInterest{
+		Symbol:          data[0].InstId,
+		OpenInterest:    data[0].OiCcy,
+		OpenInterestUsd: data[0].OiUsd,
+		Timestamp:       parseOkxMillis(data[0].Ts),
+	}, nil
+}
+
+// GetOrderBook 获取订单簿深度
+// symbol: 产品ID，如 BTC-USDT-SWAP
+// depth: 深度档位数量，最大为400
+func (e *OKXExchange) GetOrderBook(ctx context.Context, symbol string, depth int) (*OrderBook, error) {
+	params := url.Values{}
+	params.Set("instId", symbol)
+	params.Set("sz", strconv.Itoa(depth))
+
+	var data []okxOrderBookData
+	if err := e.getPublicData(ctx, okxUriMarketBooks, params, &data); err != nil {
+		return nil, fmt.Errorf("okx GetOrderBook error for %s: %w", symbol, err)
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("no order book data found for symbol: %s", symbol)
+	}
+
+	return &OrderBook{
+		Symbol:    symbol,
+		Bids:      convertOkxBookLevels(data[0].Bids),
+		Asks:      convertOkxBookLevels(data[0].Asks),
+		Timestamp: parseOkxMillis(data[0].Ts),
+	}, nil
+}
+
+// convertOkxBookLevels 转换OKX深度档位
+func convertOkxBookLevels(levels [][]string) []OrderBookLevel {
+	res := make([]OrderBookLevel, 0, len(levels))
+	for _, level := range levels {
+		if len(level) < 2 {
+			continue
+		}
+		res = append(res, OrderBookLevel{
+			Price: level[0],
+			Size:  level[1],
+		})
+	}
+	return res
+}
+
 type okxSymbol struct {
 	InstType          string   `json:"instType,omitempty"`          // 产品类型
 	InstId            string   `json:"instId,omitempty"`            // 产品ID，如 BTC-USDT
@@ -853,6 +1286,8 @@ func (e *OKXExchange) GetSymbols(ctx context.Context, symbol string) (*Symbol, e
 		Base:          okxSymbolInfos[0].BaseCcy,
 		Quote:         okxSymbolInfos[0].QuoteCcy,
 		MinSize:       okxSymbolInfos[0].MinSz,
+		LotSize:       okxSymbolInfos[0].LotSz,
+		TickSize:      okxSymbolInfos[0].TickSz,
 		ContractValue: okxSymbolInfos[0].CtVal,
 	}
 
@@ -896,6 +1331,8 @@ func (e *OKXExchange) GetAllSymbols(ctx context.Context, instType string) ([]Sym
 			Base:          okxSymbolInfo.BaseCcy,
 			Quote:         okxSymbolInfo.QuoteCcy,
 			MinSize:       okxSymbolInfo.MinSz,
+			LotSize:       okxSymbolInfo.LotSz,
+			TickSize:      okxSymbolInfo.TickSz,
 			ContractValue: okxSymbolInfo.CtVal,
 		}
 
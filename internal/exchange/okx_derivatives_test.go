@@ -0,0 +1,115 @@
+package exchange
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newOKXTestServer 创建模拟OKX公共接口的测试服务
+func newOKXTestServer(t *testing.T, responses map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			t.Errorf("未预期的请求路径: %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func TestOKXExchange_DerivativesMarketData(t *testing.T) {
+	server := newOKXTestServer(t, map[string]string{
+		okxPublicUriFundingRate:  `{"code":"0","msg":"","data":[{"instType":"SWAP","instId":"BTC-USDT-SWAP","fundingRate":"0.0001","nextFundingRate":"0.00012","fundingTime":"1700000000000","ts":"1699999990000"}]}`,
+		okxPublicUriMarkPrice:    `{"code":"0","msg":"","data":[{"instType":"SWAP","instId":"BTC-USDT-SWAP","markPx":"65000.5","ts":"1699999990000"}]}`,
+		okxUriMarketIndexTickers: `{"code":"0","msg":"","data":[{"instId":"BTC-USDT","idxPx":"64990.1","ts":"1699999990000"}]}`,
+		okxPublicUriOpenInterest: `{"code":"0","msg":"","data":[{"instType":"SWAP","instId":"BTC-USDT-SWAP","oi":"250000","oiCcy":"2500","oiUsd":"162500000","ts":"1699999990000"}]}`,
+	})
+	defer server.Close()
+
+	ex := NewOKXExchange(server.URL, "")
+	ctx := context.Background()
+
+	fundingRate, err := ex.GetFundingRate(ctx, "BTC-USDT-SWAP")
+	if err != nil {
+		t.Fatalf("获取资金费率失败: %v", err)
+	}
+	if fundingRate.FundingRate != "0.0001" || fundingRate.NextFundingRate != "0.00012" {
+		t.Errorf("资金费率解析错误: %+v", fundingRate)
+	}
+	if fundingRate.FundingTime.UnixMilli() != 1700000000000 {
+		t.Errorf("资金费时间解析错误: %v", fundingRate.FundingTime)
+	}
+
+	markPrice, err := ex.GetMarkPrice(ctx, "BTC-USDT-SWAP")
+	if err != nil {
+		t.Fatalf("获取标记价格失败: %v", err)
+	}
+	if markPrice.MarkPrice != "65000.5" {
+		t.Errorf("标记价格解析错误: %+v", markPrice)
+	}
+
+	indexPrice, err := ex.GetIndexPrice(ctx, "BTC-USDT-SWAP")
+	if err != nil {
+		t.Fatalf("获取指数价格失败: %v", err)
+	}
+	if indexPrice.Symbol != "BTC-USDT" || indexPrice.IndexPrice != "64990.1" {
+		t.Errorf("指数价格解析错误: %+v", indexPrice)
+	}
+
+	openInterest, err := ex.GetOpenInterest(ctx, "BTC-USDT-SWAP")
+	if err != nil {
+		t.Fatalf("获取持仓总量失败: %v", err)
+	}
+	if openInterest.OpenInterest != "2500" || openInterest.OpenInterestUsd != "162500000" {
+		t.Errorf("持仓总量解析错误: %+v", openInterest)
+	}
+}
+
+func TestOKXExchange_DerivativesMarketDataError(t *testing.T) {
+	server := newOKXTestServer(t, map[string]string{
+		okxPublicUriFundingRate: `{"code":"51001","msg":"Instrument ID does not exist","data":[]}`,
+		okxPublicUriMarkPrice:   `{"code":"0","msg":"","data":[]}`,
+	})
+	defer server.Close()
+
+	ex := NewOKXExchange(server.URL, "")
+	ctx := context.Background()
+
+	if _, err := ex.GetFundingRate(ctx, "UNKNOWN-USDT-SWAP"); err == nil {
+		t.Error("接口返回错误码时应返回错误")
+	}
+
+	if _, err := ex.GetMarkPrice(ctx, "UNKNOWN-USDT-SWAP"); err == nil {
+		t.Error("接口返回空数据时应返回错误")
+	}
+}
+
+func TestOKXExchange_GetOrderBook(t *testing.T) {
+	server := newOKXTestServer(t, map[string]string{
+		okxUriMarketBooks: `{"code":"0","msg":"","data":[{"asks":[["65001.1","12","0","3"],["65002","5","0","1"]],"bids":[["65000.9","8","0","2"]],"ts":"1699999990000"}]}`,
+	})
+	defer server.Close()
+
+	ex := NewOKXExchange(server.URL, "")
+
+	book, err := ex.GetOrderBook(context.Background(), "BTC-USDT-SWAP", 400)
+	if err != nil {
+		t.Fatalf("获取订单簿失败: %v", err)
+	}
+
+	if len(book.Asks) != 2 || len(book.Bids) != 1 {
+		t.Fatalf("订单簿档位数量错误: %+v", book)
+	}
+
+	if book.Asks[0].Price != "65001.1" || book.Asks[0].Size != "12" || book.Bids[0].Price != "65000.9" {
+		t.Errorf("订单簿档位解析错误: %+v", book)
+	}
+
+	if book.Timestamp.UnixMilli() != 1699999990000 {
+		t.Errorf("订单簿时间解析错误: %v", book.Timestamp)
+	}
+}
@@ -0,0 +1,161 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+func TestOKXExchange_CreateOrder(t *testing.T) {
+	var body map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != okxUriUserTradeOrder {
+			t.Errorf("未预期的请求路径: %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		body = make(map[string]interface{})
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Errorf("请求体解析失败: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"0","msg":"","data":[{"ordId":"100","sCode":"0"}]}`))
+	}))
+	defer server.Close()
+
+	ex := NewOKXExchange(server.URL, "")
+	ex.account = &model.FoxAccount{AccessKey: "key", SecretKey: "secret", Passphrase: "pass", TradeType: UserTradeTypeMock}
+
+	tests := []struct {
+		name       string
+		order      Order
+		wantPx     interface{}
+		wantReduce interface{}
+		wantErr    bool
+	}{
+		{name: "市价开空不只减仓", order: Order{Side: "sell", PosSide: "short", Type: "market", Size: "1"}},
+		{name: "post_only", order: Order{Side: "buy", PosSide: "long", Type: "post_only", Price: "65000", Size: "1"}, wantPx: "65000"},
+		{name: "只减仓", order: Order{Side: "sell", PosSide: "long", Type: "ioc", Price: "70000", Size: "1", ReduceOnly: true}, wantPx: "70000", wantReduce: true},
+		{name: "fok 缺少价格", order: Order{Side: "buy", PosSide: "long", Type: "fok", Size: "1"}, wantErr: true},
+		{name: "不支持的订单类型", order: Order{Side: "buy", PosSide: "long", Type: "stop", Size: "1"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body = nil
+			tt.order.Symbol = "BTC-USDT-SWAP"
+			tt.order.MarginType = "isolated"
+
+			result, err := ex.CreateOrder(context.Background(), &tt.order)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateOrder() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if body != nil {
+					t.Errorf("参数错误时不应发送请求: %v", body)
+				}
+				return
+			}
+
+			if result.ID != "100" {
+				t.Errorf("订单ID = %s, want 100", result.ID)
+			}
+			if body["ordType"] != tt.order.Type {
+				t.Errorf("ordType = %v, want %s", body["ordType"], tt.order.Type)
+			}
+			if body["px"] != tt.wantPx {
+				t.Errorf("px = %v, want %v", body["px"], tt.wantPx)
+			}
+			if body["reduceOnly"] != tt.wantReduce {
+				t.Errorf("reduceOnly = %v, want %v", body["reduceOnly"], tt.wantReduce)
+			}
+		})
+	}
+}
+
+func TestOKXExchange_GetOrdersPagination(t *testing.T) {
+	var afters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != okxUriUserOrdersPending {
+			t.Errorf("未预期的请求路径: %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		after := r.URL.Query().Get("after")
+		afters = append(afters, after)
+
+		// 第一页返回满页 100 条，第二页返回 1 条
+		count, start := 1, 1000
+		if after == "" {
+			count, start = okxOrdersPendingLimit, 2000
+		}
+		data := make([]okxPendingOrderResp, 0, count)
+		for i := 0; i < count; i++ {
+			id := strconv.Itoa(start - i)
+			data = append(data, okxPendingOrderResp{OrdId: id, ClOrdId: "FOX" + id, InstId: "BTC-USDT-SWAP", Sz: "2", AccFillSz: "0.5", State: "partially_filled"})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"code": "0", "msg": "", "data": data})
+	}))
+	defer server.Close()
+
+	ex := NewOKXExchange(server.URL, "")
+	ex.account = &model.FoxAccount{AccessKey: "key", SecretKey: "secret", Passphrase: "pass", TradeType: UserTradeTypeMock}
+
+	orders, err := ex.GetOrders(context.Background(), "BTC-USDT-SWAP", "")
+	if err != nil {
+		t.Fatalf("GetOrders() error = %v", err)
+	}
+
+	if len(orders) != okxOrdersPendingLimit+1 {
+		t.Fatalf("获取到 %d 个订单, want %d", len(orders), okxOrdersPendingLimit+1)
+	}
+	if len(afters) != 2 || afters[0] != "" || afters[1] != "1901" {
+		t.Errorf("分页游标 = %v, want [ 1901]", afters)
+	}
+	last := orders[len(orders)-1]
+	if last.OrderID != "FOX1000" || last.Filled != 0.5 || last.Remain != 1.5 {
+		t.Errorf("最后一个订单 = %+v", last)
+	}
+}
+
+func TestOKXExchange_GetOrder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != okxUriUserTradeOrder || r.Method != http.MethodGet {
+			t.Errorf("未预期的请求: %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("clOrdId") {
+		case "FOX1":
+			_, _ = w.Write([]byte(`{"code":"0","msg":"","data":[{"ordId":"100","clOrdId":"FOX1","instId":"BTC-USDT-SWAP","sz":"5","accFillSz":"2","state":"mmp_canceled"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"code":"51603","msg":"Order does not exist","data":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	ex := NewOKXExchange(server.URL, "")
+	ex.account = &model.FoxAccount{AccessKey: "key", SecretKey: "secret", Passphrase: "pass", TradeType: UserTradeTypeMock}
+
+	order, err := ex.GetOrder(context.Background(), "BTC-USDT-SWAP", "FOX1")
+	if err != nil {
+		t.Fatalf("GetOrder() error = %v", err)
+	}
+	if order.ID != "100" || order.Status != OrderStatusCanceled || order.Filled != 2 || order.Remain != 0 {
+		t.Errorf("GetOrder() = %+v, want canceled order with 2 filled", order)
+	}
+
+	if _, err := ex.GetOrder(context.Background(), "BTC-USDT-SWAP", "FOX2"); !errors.Is(err, ErrOrderNotFound) {
+		t.Errorf("GetOrder() error = %v, want ErrOrderNotFound", err)
+	}
+}
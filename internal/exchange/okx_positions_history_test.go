@@ -0,0 +1,80 @@
+package exchange
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+func TestOKXExchange_GetRealizedPnl(t *testing.T) {
+	since := time.UnixMilli(1700000000000)
+
+	// 第一页 100 条均在 since 之后，第二页出现早于 since 的记录后停止统计
+	firstPage := make([]string, 0, okxPositionsHistoryPageSize)
+	for i := 0; i < okxPositionsHistoryPageSize; i++ {
+		firstPage = append(firstPage, fmt.Sprintf(`{"instId":"BTC-USDT-SWAP","realizedPnl":"1","uTime":"%d"}`, 1700000900000-int64(i)))
+	}
+	secondPage := []string{
+		`{"instId":"ETH-USDT-SWAP","realizedPnl":"-150.5","uTime":"1700000000000"}`,
+		`{"instId":"ETH-USDT-SWAP","realizedPnl":"-999","uTime":"1699999999999"}`,
+	}
+
+	var afters []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != okxUriUserPositionsHistory {
+			t.Errorf("未预期的请求路径: %s", r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		if r.URL.Query().Get("instType") != "SWAP" {
+			t.Errorf("instType = %s, want SWAP", r.URL.Query().Get("instType"))
+		}
+
+		after := r.URL.Query().Get("after")
+		afters = append(afters, after)
+		page := firstPage
+		if after != "" {
+			page = secondPage
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"code":"0","msg":"","data":[` + strings.Join(page, ",") + `]}`))
+	}))
+	defer server.Close()
+
+	ex := NewOKXExchange(server.URL, "")
+	ex.account = &model.FoxAccount{AccessKey: "key", SecretKey: "secret", Passphrase: "pass", TradeType: UserTradeTypeMock}
+
+	pnl, err := ex.GetRealizedPnl(context.Background(), since)
+	if err != nil {
+		t.Fatalf("获取已实现盈亏失败: %v", err)
+	}
+	if pnl != "-50.5" {
+		t.Errorf("已实现盈亏 = %s, want -50.5", pnl)
+	}
+	if len(afters) != 2 || afters[0] != "" || afters[1] != "1700000899901" {
+		t.Errorf("分页参数错误: %v", afters)
+	}
+}
+
+func TestOKXExchange_GetRealizedPnlError(t *testing.T) {
+	server := newOKXTestServer(t, map[string]string{
+		okxUriUserPositionsHistory: `{"code":"50113","msg":"Invalid Sign","data":[]}`,
+	})
+	defer server.Close()
+
+	ex := NewOKXExchange(server.URL, "")
+	if _, err := ex.GetRealizedPnl(context.Background(), time.Now()); err == nil {
+		t.Error("未设置账户时应返回错误")
+	}
+
+	ex.account = &model.FoxAccount{AccessKey: "key", SecretKey: "secret", Passphrase: "pass"}
+	if _, err := ex.GetRealizedPnl(context.Background(), time.Now()); err == nil || !strings.Contains(err.Error(), "Invalid Sign") {
+		t.Errorf("错误响应处理不正确: %v", err)
+	}
+}
@@ -193,8 +193,8 @@ func (c *Client) SendCommand(command string, args []string, exchange, account st
 	return nil
 }
 
-// GetNews 获取新闻
-func (c *Client) GetNews(count int, source string) ([]news.NewsItem, error) {
+// GetNews 获取新闻，指定关键词或起始时间时检索新闻归档
+func (c *Client) GetNews(count int, source, keyword string, since time.Time) ([]news.NewsItem, error) {
 	// 确保 token 有效
 	if err := c.ensureValidToken(); err != nil {
 		return nil, fmt.Errorf("token 验证失败: %w", err)
@@ -203,11 +203,17 @@ func (c *Client) GetNews(count int, source string) ([]news.NewsItem, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	resp, err := c.client.GetNews(ctx, &pb.GetNewsRequest{
+	req := &pb.GetNewsRequest{
 		Count:       int32(count),
 		Source:      source,
+		Keyword:     keyword,
 		AccessToken: c.getAccessToken(),
-	})
+	}
+	if !since.IsZero() {
+		req.Since = since.Unix()
+	}
+
+	resp, err := c.client.GetNews(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get news: %w", err)
 	}
@@ -610,6 +616,47 @@ func (c *Client) UpdateProxyConfig(accountID int64, proxyURL string) (*ShowAccou
 	}, nil
 }
 
+// UpdateRiskRule 更新账户风控规则，settings 为空时仅查询当前规则
+func (c *Client) UpdateRiskRule(accountID int64, settings map[string]string) (*ShowRiskRuleItem, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("token 验证失败: %w", err)
+	}
+
+	if accountID <= 0 {
+		return nil, fmt.Errorf("account_id 是必填参数")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.UpdateRiskRule(ctx, &pb.UpdateRiskRuleRequest{
+		AccessToken: c.getAccessToken(),
+		AccountId:   accountID,
+		Settings:    settings,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update risk rule: %w", err)
+	}
+
+	if !resp.Success {
+		return nil, fmt.Errorf("update risk rule failed: %s", resp.Message)
+	}
+
+	if resp.Rule == nil {
+		return nil, fmt.Errorf("update risk rule response 缺少风控规则")
+	}
+
+	return &ShowRiskRuleItem{
+		MaxOrderNotional: resp.Rule.MaxOrderNotional,
+		MaxExposure:      resp.Rule.MaxExposure,
+		MaxLeverage:      resp.Rule.MaxLeverage,
+		MaxOpenPositions: resp.Rule.MaxOpenPositions,
+		MaxDailyLoss:     resp.Rule.MaxDailyLoss,
+		AllowSymbols:     resp.Rule.AllowSymbols,
+		DenySymbols:      resp.Rule.DenySymbols,
+	}, nil
+}
+
 // UpdateSymbol 更新标的杠杆配置
 func (c *Client) UpdateSymbol(accountID int64, exchangeName, symbol, margin string, leverage int64) error {
 	if err := c.ensureValidToken(); err != nil {
@@ -697,8 +744,23 @@ func (c *Client) UpdateAccount(exchangeName, targetAccount, tradeType, name, api
 	}, nil
 }
 
+// OpenOrderOptions 开仓订单选项
+type OpenOrderOptions struct {
+	OrderType       string   // 订单类型（market/limit/post_only/ioc/fok），为空时按是否指定限价表达式确定
+	PriceExpr       string   // 限价表达式（触发时求值），为空时提交市价单
+	ReduceOnly      bool     // 只减仓（仅平仓订单支持）
+	ExpireAt        int64    // 过期时间（Unix 秒），0 表示不过期
+	CloseStrategies []string // 开仓成交后激活的平仓策略，多个平仓策略互为 OCO
+	Algo            string   // 执行算法（twap/iceberg），为空时一次性提交
+	AlgoDuration    int64    // TWAP 执行时长（秒）
+	AlgoSlices      int64    // TWAP 拆分笔数
+	AlgoVisible     string   // 冰山单每笔可见数量百分比
+	Chase           bool     // 追价：未成交时按买一/卖一价撤单重下（仅限价单）
+	MaxSlippage     string   // 追价最大滑点百分比，为空时不限制
+}
+
 // OpenOrder 提交开仓订单
-func (c *Client) OpenOrder(accountID int64, exchangeName, symbol, posSide, margin, amount, amountType, strategy string) (string, error) {
+func (c *Client) OpenOrder(accountID int64, exchangeName, symbol, posSide, margin, amount, amountType, strategy string, options OpenOrderOptions) (string, error) {
 	if err := c.ensureValidToken(); err != nil {
 		return "", fmt.Errorf("token 验证失败: %w", err)
 	}
@@ -728,17 +790,27 @@ func (c *Client) OpenOrder(accountID int64, exchangeName, symbol, posSide, margi
 	defer cancel()
 
 	resp, err := c.client.OpenOrder(ctx, &pb.OpenOrderRequest{
-		AccessToken: c.getAccessToken(),
-		AccountId:   accountID,
-		Exchange:    exchangeName,
-		Symbol:      symbol,
-		PosSide:     posSide,
-		Margin:      margin,
-		Amount:      amount,
-		AmountType:  amountType,
-		Side:        side,
-		OrderType:   "market",
-		Strategy:    strategy,
+		AccessToken:     c.getAccessToken(),
+		AccountId:       accountID,
+		Exchange:        exchangeName,
+		Symbol:          symbol,
+		PosSide:         posSide,
+		Margin:          margin,
+		Amount:          amount,
+		AmountType:      amountType,
+		Side:            side,
+		OrderType:       options.OrderType,
+		Strategy:        strategy,
+		ExpireAt:        options.ExpireAt,
+		CloseStrategies: options.CloseStrategies,
+		PriceExpr:       options.PriceExpr,
+		Algo:            options.Algo,
+		AlgoDuration:    options.AlgoDuration,
+		AlgoSlices:      options.AlgoSlices,
+		AlgoVisible:     options.AlgoVisible,
+		Chase:           options.Chase,
+		MaxSlippage:     options.MaxSlippage,
+		ReduceOnly:      options.ReduceOnly,
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to open order: %w", err)
@@ -750,8 +822,18 @@ func (c *Client) OpenOrder(accountID int64, exchangeName, symbol, posSide, margi
 	return resp.Message, nil
 }
 
+// CloseOrderOptions 平仓订单选项
+type CloseOrderOptions struct {
+	OrderType   string // 订单类型（market/limit/post_only/ioc/fok），为空时按是否指定限价表达式确定
+	PriceExpr   string // 限价表达式（触发时求值），为空时提交市价单
+	ReduceOnly  bool   // 只减仓：全部平仓的限价单也以只减仓方式提交（部分平仓始终只减仓）
+	Chase       bool   // 追价：未成交时按买一/卖一价撤单重下（仅限价单）
+	MaxSlippage string // 追价最大滑点百分比，为空时不限制
+}
+
 // CloseOrder 提交平仓订单
-func (c *Client) CloseOrder(accountID int64, exchangeName, symbol, posSide, margin, strategy string) (string, error) {
+// amount 为空时平掉全部仓位，amountType 为空（标的数量）、USDT 或 percent（仓位百分比）
+func (c *Client) CloseOrder(accountID int64, exchangeName, symbol, posSide, margin, amount, amountType, strategy string, options CloseOrderOptions) (string, error) {
 	if err := c.ensureValidToken(); err != nil {
 		return "", fmt.Errorf("token 验证失败: %w", err)
 	}
@@ -780,6 +862,13 @@ func (c *Client) CloseOrder(accountID int64, exchangeName, symbol, posSide, marg
 		PosSide:     posSide,
 		Margin:      margin,
 		Strategy:    strategy,
+		Amount:      amount,
+		AmountType:  amountType,
+		OrderType:   options.OrderType,
+		PriceExpr:   options.PriceExpr,
+		ReduceOnly:  options.ReduceOnly,
+		Chase:       options.Chase,
+		MaxSlippage: options.MaxSlippage,
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to close order: %w", err)
@@ -891,7 +980,254 @@ func (c *Client) CancelOrder(accountID int64, exchangeName, symbol, side, posSid
 	if !resp.Success {
 		return "", fmt.Errorf("cancel order failed: %s", resp.Message)
 	}
-	
+
+	return resp.Message, nil
+}
+
+// KillSwitch 紧急停止或解除紧急停止，allAccounts 为 true 时作用于所有账户
+func (c *Client) KillSwitch(accountID int64, allAccounts, rearm bool) (*ShowKillSwitchResult, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("token 验证失败: %w", err)
+	}
+
+	if !allAccounts && accountID <= 0 {
+		return nil, fmt.Errorf("account_id 是必填参数")
+	}
+
+	// 需要撤销挂单并逐个平仓，超时时间适当放宽
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	resp, err := c.client.KillSwitch(ctx, &pb.KillSwitchRequest{
+		AccessToken: c.getAccessToken(),
+		AccountId:   accountID,
+		AllAccounts: allAccounts,
+		Rearm:       rearm,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute kill switch: %w", err)
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("kill switch failed: %s", resp.Message)
+	}
+
+	return &ShowKillSwitchResult{
+		Message:                 resp.Message,
+		CancelledOrders:         resp.CancelledOrders,
+		CancelledExchangeOrders: resp.CancelledExchangeOrders,
+		ClosedPositions:         resp.ClosedPositions,
+		Errors:                  resp.Errors,
+	}, nil
+}
+
+// PauseOrder 暂停或恢复策略订单
+func (c *Client) PauseOrder(accountID, orderID int64, resume bool) (string, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return "", fmt.Errorf("token 验证失败: %w", err)
+	}
+
+	if accountID <= 0 {
+		return "", fmt.Errorf("account_id 是必填参数")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.PauseOrder(ctx, &pb.PauseOrderRequest{
+		AccessToken: c.getAccessToken(),
+		AccountId:   accountID,
+		Id:          orderID,
+		Resume:      resume,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to pause order: %w", err)
+	}
+	if !resp.Success {
+		return "", fmt.Errorf("pause order failed: %s", resp.Message)
+	}
+
+	return resp.Message, nil
+}
+
+// GetOrderHistory 获取订单的委托变更历史（提交、追价改单等）
+func (c *Client) GetOrderHistory(accountID, orderID int64) ([]*ShowOrderHistoryItem, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("token 验证失败: %w", err)
+	}
+
+	if accountID <= 0 {
+		return nil, fmt.Errorf("account_id 是必填参数")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetOrderHistory(ctx, &pb.GetOrderHistoryRequest{
+		AccessToken: c.getAccessToken(),
+		AccountId:   accountID,
+		Id:          orderID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order history: %w", err)
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("get order history failed: %s", resp.Message)
+	}
+
+	var histories []*ShowOrderHistoryItem
+	for _, item := range resp.Histories {
+		histories = append(histories, &ShowOrderHistoryItem{
+			ID:            item.Id,
+			OrderID:       item.OrderId,
+			Action:        item.Action,
+			ClientOrderID: item.ClientOrderId,
+			Price:         item.Price,
+			Size:          item.Size,
+			Msg:           item.Msg,
+			CreatedAt:     item.CreatedAt,
+		})
+	}
+
+	return histories, nil
+}
+
+// CreateDcaPlan 创建定投计划，amountType 为空（标的数量）或 USDT
+func (c *Client) CreateDcaPlan(accountID int64, exchangeName, symbol, posSide, margin, amount, amountType, schedule, guard string) (string, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return "", fmt.Errorf("token 验证失败: %w", err)
+	}
+
+	if accountID <= 0 {
+		return "", fmt.Errorf("account_id 是必填参数")
+	}
+	if schedule == "" {
+		return "", fmt.Errorf("schedule 是必填参数")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.CreateDcaPlan(ctx, &pb.CreateDcaPlanRequest{
+		AccessToken: c.getAccessToken(),
+		AccountId:   accountID,
+		Exchange:    exchangeName,
+		Symbol:      symbol,
+		PosSide:     posSide,
+		Margin:      margin,
+		Amount:      amount,
+		AmountType:  amountType,
+		Schedule:    schedule,
+		Guard:       guard,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create dca plan: %w", err)
+	}
+	if !resp.Success {
+		return "", fmt.Errorf("create dca plan failed: %s", resp.Message)
+	}
+
+	return resp.Message, nil
+}
+
+// GetDcaPlans 获取定投计划列表
+func (c *Client) GetDcaPlans(accountID int64) ([]*ShowDcaPlanItem, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("token 验证失败: %w", err)
+	}
+
+	if accountID <= 0 {
+		return nil, fmt.Errorf("account_id 是必填参数")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.GetDcaPlans(ctx, &pb.GetDcaPlansRequest{
+		AccessToken: c.getAccessToken(),
+		AccountId:   accountID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dca plans: %w", err)
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("get dca plans failed: %s", resp.Message)
+	}
+
+	var plans []*ShowDcaPlanItem
+	for _, item := range resp.Plans {
+		plans = append(plans, &ShowDcaPlanItem{
+			ID:          item.Id,
+			AccountID:   item.AccountId,
+			Exchange:    item.Exchange,
+			Symbol:      item.Symbol,
+			PosSide:     item.PosSide,
+			MarginType:  item.MarginType,
+			Amount:      item.Amount,
+			AmountType:  item.AmountType,
+			Schedule:    item.Schedule,
+			Guard:       item.Guard,
+			Status:      item.Status,
+			NextRunAt:   item.NextRunAt,
+			LastRunAt:   item.LastRunAt,
+			Runs:        item.Runs,
+			Skips:       item.Skips,
+			LastOrderID: item.LastOrderId,
+			Msg:         item.Msg,
+			CreatedAt:   item.CreatedAt,
+		})
+	}
+
+	return plans, nil
+}
+
+// CancelDcaPlan 取消定投计划
+func (c *Client) CancelDcaPlan(accountID, planID int64) (string, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return "", fmt.Errorf("token 验证失败: %w", err)
+	}
+
+	if accountID <= 0 {
+		return "", fmt.Errorf("account_id 是必填参数")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.CancelDcaPlan(ctx, &pb.CancelDcaPlanRequest{
+		AccessToken: c.getAccessToken(),
+		AccountId:   accountID,
+		Id:          planID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to cancel dca plan: %w", err)
+	}
+	if !resp.Success {
+		return "", fmt.Errorf("cancel dca plan failed: %s", resp.Message)
+	}
+
+	return resp.Message, nil
+}
+
+// PauseEngine 暂停或恢复策略引擎
+func (c *Client) PauseEngine(resume bool) (string, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return "", fmt.Errorf("token 验证失败: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := c.client.PauseEngine(ctx, &pb.PauseEngineRequest{
+		AccessToken: c.getAccessToken(),
+		Resume:      resume,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to pause engine: %w", err)
+	}
+	if !resp.Success {
+		return "", fmt.Errorf("pause engine failed: %s", resp.Message)
+	}
+
 	return resp.Message, nil
 }
 
@@ -927,24 +1263,35 @@ func (c *Client) GetOrders(accountID int64, status []string) ([]*ShowOrderItem,
 	var orders []*ShowOrderItem
 	for _, item := range resp.Orders {
 		orders = append(orders, &ShowOrderItem{
-			ID:         item.Id,
-			Exchange:   item.Exchange,
-			AccountID:  item.AccountId,
-			Symbol:     item.Symbol,
-			Side:       item.Side,
-			PosSide:    item.PosSide,
-			MarginType: item.MarginType,
-			Price:      item.Price,
-			Size:       item.Size,
-			SizeType:   item.SizeType,
-			OrderType:  item.OrderType,
-			Strategy:   item.Strategy,
-			OrderID:    item.OrderId,
-			Type:       item.Type,
-			Status:     item.Status,
-			Msg:        item.Msg,
-			CreatedAt:  item.CreatedAt,
-			UpdatedAt:  item.UpdatedAt,
+			ID:           item.Id,
+			Exchange:     item.Exchange,
+			AccountID:    item.AccountId,
+			Symbol:       item.Symbol,
+			Side:         item.Side,
+			PosSide:      item.PosSide,
+			MarginType:   item.MarginType,
+			Price:        item.Price,
+			Size:         item.Size,
+			SizeType:     item.SizeType,
+			OrderType:    item.OrderType,
+			Strategy:     item.Strategy,
+			OrderID:      item.OrderId,
+			Type:         item.Type,
+			Status:       item.Status,
+			Msg:          item.Msg,
+			CreatedAt:    item.CreatedAt,
+			UpdatedAt:    item.UpdatedAt,
+			ExpireAt:     item.ExpireAt,
+			ParentID:     item.ParentId,
+			PriceExpr:    item.PriceExpr,
+			Algo:         item.Algo,
+			AlgoDuration: item.AlgoDuration,
+			AlgoSlices:   item.AlgoSlices,
+			AlgoVisible:  item.AlgoVisible,
+			AlgoTotal:    item.AlgoTotal,
+			AlgoFilled:   item.AlgoFilled,
+			Chase:        item.Chase,
+			MaxSlippage:  item.MaxSlippage,
 		})
 	}
 
@@ -56,22 +56,87 @@ type ShowAccountItem struct {
 
 // ShowOrderItem 订单展示项
 type ShowOrderItem struct {
-	ID         int64  `json:"id"`          // 订单ID
-	Exchange   string `json:"exchange"`    // 交易所
-	AccountID  int64  `json:"account_id"`  // 账户ID
-	Symbol     string `json:"symbol"`      // 交易对符号
-	Side       string `json:"side"`        // 买卖方向 (buy/sell)
-	PosSide    string `json:"pos_side"`    // 持仓方向 (long/short)
-	MarginType string `json:"margin_type"` // 保证金类型 (isolated/cross)
-	Price      string `json:"price"`       // 价格
-	Size       string `json:"size"`        // 数量
-	SizeType   string `json:"size_type"`   // 数量类型
-	OrderType  string `json:"order_type"`  // 订单类型 (limit/market)
-	Strategy   string `json:"strategy"`    // 策略名称
-	OrderID    string `json:"order_id"`    // 交易所订单ID
-	Type       string `json:"type"`        // 订单类型 (open/close)
-	Status     string `json:"status"`      // 订单状态
-	Msg        string `json:"msg"`         // 订单消息/描述
-	CreatedAt  int64  `json:"created_at"`  // 创建时间
-	UpdatedAt  int64  `json:"updated_at"`  // 更新时间
+	ID           int64  `json:"id"`            // 订单ID
+	Exchange     string `json:"exchange"`      // 交易所
+	AccountID    int64  `json:"account_id"`    // 账户ID
+	Symbol       string `json:"symbol"`        // 交易对符号
+	Side         string `json:"side"`          // 买卖方向 (buy/sell)
+	PosSide      string `json:"pos_side"`      // 持仓方向 (long/short)
+	MarginType   string `json:"margin_type"`   // 保证金类型 (isolated/cross)
+	Price        string `json:"price"`         // 价格
+	Size         string `json:"size"`          // 数量
+	SizeType     string `json:"size_type"`     // 数量类型
+	OrderType    string `json:"order_type"`    // 订单类型 (limit/market)
+	Strategy     string `json:"strategy"`      // 策略名称
+	OrderID      string `json:"order_id"`      // 交易所订单ID
+	Type         string `json:"type"`          // 订单类型 (open/close)
+	Status       string `json:"status"`        // 订单状态
+	Msg          string `json:"msg"`           // 订单消息/描述
+	CreatedAt    int64  `json:"created_at"`    // 创建时间
+	UpdatedAt    int64  `json:"updated_at"`    // 更新时间
+	ExpireAt     int64  `json:"expire_at"`     // 过期时间（0 表示不过期）
+	ParentID     int64  `json:"parent_id"`     // 父订单ID（开仓成交后激活的平仓订单）
+	PriceExpr    string `json:"price_expr"`    // 限价表达式（触发时求值）
+	Algo         string `json:"algo"`          // 执行算法（twap/iceberg）
+	AlgoDuration int64  `json:"algo_duration"` // TWAP 执行时长（秒）
+	AlgoSlices   int64  `json:"algo_slices"`   // TWAP 拆分笔数
+	AlgoVisible  string `json:"algo_visible"`  // 冰山单每笔可见数量百分比
+	AlgoTotal    string `json:"algo_total"`    // 算法订单总张数
+	AlgoFilled   string `json:"algo_filled"`   // 算法订单已成交张数
+	Chase        string `json:"chase"`         // 追价状态（active/stopped/done），为空表示不追价
+	MaxSlippage  string `json:"max_slippage"`  // 追价最大滑点百分比
+}
+
+// ShowOrderHistoryItem 订单委托变更历史展示项
+type ShowOrderHistoryItem struct {
+	ID            int64  `json:"id"`
+	OrderID       int64  `json:"order_id"`        // 订单ID
+	Action        string `json:"action"`          // 变更类型（submit/amend/stop/done/failed）
+	ClientOrderID string `json:"client_order_id"` // 交易所客户自定义订单ID
+	Price         string `json:"price"`           // 委托价格
+	Size          string `json:"size"`            // 委托数量
+	Msg           string `json:"msg"`             // 变更说明
+	CreatedAt     int64  `json:"created_at"`      // 记录时间
+}
+
+// ShowDcaPlanItem 定投计划展示项
+type ShowDcaPlanItem struct {
+	ID          int64  `json:"id"`
+	AccountID   int64  `json:"account_id"`
+	Exchange    string `json:"exchange"`
+	Symbol      string `json:"symbol"`
+	PosSide     string `json:"pos_side"`      // 持仓方向 (long/short)
+	MarginType  string `json:"margin_type"`   // 保证金模式 (isolated/cross)
+	Amount      string `json:"amount"`        // 每次定投数量
+	AmountType  string `json:"amount_type"`   // 数量类型（为空表示标的数量，USDT 表示金额）
+	Schedule    string `json:"schedule"`      // cron 表达式
+	Guard       string `json:"guard"`         // 执行条件
+	Status      string `json:"status"`        // 计划状态 (active/cancelled)
+	NextRunAt   int64  `json:"next_run_at"`   // 下次执行时间
+	LastRunAt   int64  `json:"last_run_at"`   // 上次执行时间（0 表示未执行）
+	Runs        int64  `json:"runs"`          // 已创建订单次数
+	Skips       int64  `json:"skips"`         // 已跳过次数
+	LastOrderID int64  `json:"last_order_id"` // 最近一次创建的订单ID
+	Msg         string `json:"msg"`           // 最近一次执行结果
+	CreatedAt   int64  `json:"created_at"`    // 创建时间
+}
+
+// ShowRiskRuleItem 风控规则展示项（0 或空值表示不限制）
+type ShowRiskRuleItem struct {
+	MaxOrderNotional string `json:"max_order_notional"` // 单笔订单最大名义价值（USDT）
+	MaxExposure      string `json:"max_exposure"`       // 持仓总名义价值上限（USDT）
+	MaxLeverage      int64  `json:"max_leverage"`       // 最大杠杆倍数
+	MaxOpenPositions int64  `json:"max_open_positions"` // 最大持仓数量
+	MaxDailyLoss     string `json:"max_daily_loss"`     // 当日最大已实现亏损（USDT）
+	AllowSymbols     string `json:"allow_symbols"`      // 允许交易的标的
+	DenySymbols      string `json:"deny_symbols"`       // 禁止交易的标的
+}
+
+// ShowKillSwitchResult 紧急停止结果展示项
+type ShowKillSwitchResult struct {
+	Message                 string   `json:"message"`
+	CancelledOrders         int64    `json:"cancelled_orders"`          // 取消的等待中策略订单数量
+	CancelledExchangeOrders int64    `json:"cancelled_exchange_orders"` // 撤销的交易所挂单数量
+	ClosedPositions         int64    `json:"closed_positions"`          // 平仓的仓位数量
+	Errors                  []string `json:"errors"`                    // 执行失败的操作
 }
@@ -150,13 +150,6 @@ func (s *Server) GetNews(ctx context.Context, req *pb.GetNewsRequest) (*pb.GetNe
 		}, nil
 	}
 
-	if s.engine == nil {
-		return &pb.GetNewsResponse{
-			Success: false,
-			Message: "引擎未初始化",
-		}, nil
-	}
-
 	// 设置默认值
 	count := int(req.Count)
 	if count <= 0 {
@@ -166,6 +159,19 @@ func (s *Server) GetNews(ctx context.Context, req *pb.GetNewsRequest) (*pb.GetNe
 		count = 100 // 限制最大数量
 	}
 
+	// 指定关键词或起始时间时检索新闻归档
+	if req.Keyword != "" || req.Since > 0 {
+		req.Count = int32(count)
+		return server.NewNewsServer().SearchNews(ctx, req)
+	}
+
+	if s.engine == nil {
+		return &pb.GetNewsResponse{
+			Success: false,
+			Message: "引擎未初始化",
+		}, nil
+	}
+
 	source := req.Source
 	if source == "" {
 		source = "blockbeats" // 默认使用 blockbeats
@@ -275,6 +281,19 @@ func (s *Server) UpdateProxyConfig(ctx context.Context, req *pb.UpdateProxyConfi
 	return server.NewAccountServer().UpdateProxyConfig(ctx, req)
 }
 
+// UpdateRiskRule 更新账户风控规则
+func (s *Server) UpdateRiskRule(ctx context.Context, req *pb.UpdateRiskRuleRequest) (*pb.UpdateRiskRuleResponse, error) {
+	if err := s.validateToken(req.AccessToken); err != nil {
+		log.Printf("Token 验证失败: %v", err)
+		return &pb.UpdateRiskRuleResponse{
+			Success: false,
+			Message: fmt.Sprintf("认证失败: %v", err),
+		}, nil
+	}
+
+	return server.NewRiskServer().UpdateRiskRule(ctx, req)
+}
+
 // UpdateSymbol 更新标的配置
 func (s *Server) UpdateSymbol(ctx context.Context, req *pb.UpdateSymbolRequest) (*pb.UpdateSymbolResponse, error) {
 	if err := s.validateToken(req.AccessToken); err != nil {
@@ -311,6 +330,14 @@ func (s *Server) OpenOrder(ctx context.Context, req *pb.OpenOrderRequest) (*pb.O
 		}, nil
 	}
 
+	// 紧急停止期间不接受新的开仓订单
+	if s.engine != nil && s.engine.Halted(req.AccountId) {
+		return &pb.OpenOrderResponse{
+			Success: false,
+			Message: "紧急停止生效中，请先使用 panic rearm 解除",
+		}, nil
+	}
+
 	return server.NewOrderServer().OpenOrder(ctx, req)
 }
 
@@ -433,6 +460,182 @@ func (s *Server) GetOrders(ctx context.Context, req *pb.GetOrdersRequest) (*pb.G
 	return server.NewOrderServer().GetOrders(ctx, req)
 }
 
+// KillSwitch 紧急停止或解除紧急停止
+func (s *Server) KillSwitch(ctx context.Context, req *pb.KillSwitchRequest) (*pb.KillSwitchResponse, error) {
+	if err := s.validateToken(req.AccessToken); err != nil {
+		log.Printf("Token 验证失败: %v", err)
+		return &pb.KillSwitchResponse{
+			Success: false,
+			Message: fmt.Sprintf("认证失败: %v", err),
+		}, nil
+	}
+
+	if s.engine == nil {
+		return &pb.KillSwitchResponse{
+			Success: false,
+			Message: "引擎未初始化",
+		}, nil
+	}
+
+	// 0 表示所有账户
+	accountID := req.AccountId
+	if req.AllAccounts {
+		accountID = 0
+	} else if accountID <= 0 {
+		return &pb.KillSwitchResponse{Success: false, Message: "account_id 是必填参数"}, nil
+	}
+
+	if req.Rearm {
+		if err := s.engine.Rearm(accountID); err != nil {
+			return &pb.KillSwitchResponse{
+				Success: false,
+				Message: fmt.Sprintf("解除紧急停止失败: %v", err),
+			}, nil
+		}
+
+		message := "紧急停止已解除，引擎恢复处理订单"
+		if s.engine.HaltedAll() {
+			message = "账户紧急停止已解除，但所有账户的紧急停止仍然生效"
+		}
+		return &pb.KillSwitchResponse{Success: true, Message: message}, nil
+	}
+
+	result, err := s.engine.KillSwitch(accountID)
+	if err != nil {
+		log.Printf("紧急停止失败: %v", err)
+		resp := &pb.KillSwitchResponse{
+			Success: false,
+			Message: fmt.Sprintf("紧急停止失败: %v", err),
+		}
+		if result != nil {
+			resp.CancelledOrders = result.CancelledOrders
+		}
+		return resp, nil
+	}
+
+	return &pb.KillSwitchResponse{
+		Success:                 true,
+		Message:                 "紧急停止已执行，引擎暂停处理订单，解除前不会提交新订单",
+		CancelledOrders:         result.CancelledOrders,
+		CancelledExchangeOrders: result.CancelledExchangeOrders,
+		ClosedPositions:         result.ClosedPositions,
+		Errors:                  result.Errors,
+	}, nil
+}
+
+// PauseOrder 暂停或恢复策略订单
+func (s *Server) PauseOrder(ctx context.Context, req *pb.PauseOrderRequest) (*pb.PauseOrderResponse, error) {
+	if err := s.validateToken(req.AccessToken); err != nil {
+		log.Printf("Token 验证失败: %v", err)
+		return &pb.PauseOrderResponse{
+			Success: false,
+			Message: fmt.Sprintf("认证失败: %v", err),
+		}, nil
+	}
+
+	return server.NewOrderServer().PauseOrder(ctx, req)
+}
+
+// GetOrderHistory 获取策略订单的委托历史
+func (s *Server) GetOrderHistory(ctx context.Context, req *pb.GetOrderHistoryRequest) (*pb.GetOrderHistoryResponse, error) {
+	if err := s.validateToken(req.AccessToken); err != nil {
+		log.Printf("Token 验证失败: %v", err)
+		return &pb.GetOrderHistoryResponse{
+			Success: false,
+			Message: fmt.Sprintf("认证失败: %v", err),
+		}, nil
+	}
+
+	return server.NewOrderServer().GetOrderHistory(ctx, req)
+}
+
+// CreateDcaPlan 创建定投计划
+func (s *Server) CreateDcaPlan(ctx context.Context, req *pb.CreateDcaPlanRequest) (*pb.CreateDcaPlanResponse, error) {
+	if err := s.validateToken(req.AccessToken); err != nil {
+		log.Printf("Token 验证失败: %v", err)
+		return &pb.CreateDcaPlanResponse{
+			Success: false,
+			Message: fmt.Sprintf("认证失败: %v", err),
+		}, nil
+	}
+
+	return server.NewDcaServer().CreateDcaPlan(ctx, req)
+}
+
+// GetDcaPlans 获取定投计划列表
+func (s *Server) GetDcaPlans(ctx context.Context, req *pb.GetDcaPlansRequest) (*pb.GetDcaPlansResponse, error) {
+	if err := s.validateToken(req.AccessToken); err != nil {
+		log.Printf("Token 验证失败: %v", err)
+		return &pb.GetDcaPlansResponse{
+			Success: false,
+			Message: fmt.Sprintf("认证失败: %v", err),
+		}, nil
+	}
+
+	return server.NewDcaServer().GetDcaPlans(ctx, req)
+}
+
+// CancelDcaPlan 取消定投计划
+func (s *Server) CancelDcaPlan(ctx context.Context, req *pb.CancelDcaPlanRequest) (*pb.CancelDcaPlanResponse, error) {
+	if err := s.validateToken(req.AccessToken); err != nil {
+		log.Printf("Token 验证失败: %v", err)
+		return &pb.CancelDcaPlanResponse{
+			Success: false,
+			Message: fmt.Sprintf("认证失败: %v", err),
+		}, nil
+	}
+
+	return server.NewDcaServer().CancelDcaPlan(ctx, req)
+}
+
+// PauseEngine 暂停或恢复策略引擎
+func (s *Server) PauseEngine(ctx context.Context, req *pb.PauseEngineRequest) (*pb.PauseEngineResponse, error) {
+	if err := s.validateToken(req.AccessToken); err != nil {
+		log.Printf("Token 验证失败: %v", err)
+		return &pb.PauseEngineResponse{
+			Success: false,
+			Message: fmt.Sprintf("认证失败: %v", err),
+		}, nil
+	}
+
+	if s.engine == nil {
+		return &pb.PauseEngineResponse{
+			Success: false,
+			Message: "引擎未初始化",
+		}, nil
+	}
+
+	message := "策略引擎已暂停，等待中的订单将不再处理"
+	if req.Resume {
+		if err := s.engine.Resume(); err != nil {
+			return &pb.PauseEngineResponse{
+				Success: false,
+				Message: fmt.Sprintf("恢复引擎失败: %v", err),
+				Paused:  s.engine.Paused(),
+			}, nil
+		}
+		message = "策略引擎已恢复处理订单"
+	} else if err := s.engine.Pause(); err != nil {
+		return &pb.PauseEngineResponse{
+			Success: false,
+			Message: fmt.Sprintf("暂停引擎已生效，但保存暂停状态失败，重启后将恢复处理订单: %v", err),
+			Paused:  s.engine.Paused(),
+		}, nil
+	}
+
+	maintenance := s.engine.InMaintenance()
+	if req.Resume && maintenance {
+		message = "策略引擎已恢复，但当前处于维护时间窗口，窗口结束后开始处理订单"
+	}
+
+	return &pb.PauseEngineResponse{
+		Success:     true,
+		Message:     message,
+		Paused:      s.engine.Paused(),
+		Maintenance: maintenance,
+	}, nil
+}
+
 // validateToken 验证 access token
 func (s *Server) validateToken(token string) error {
 	if token == "" {
@@ -2,8 +2,18 @@ package grpc
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/lemconn/foxflow/internal/config"
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/engine"
+	"github.com/lemconn/foxflow/internal/news"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/lemconn/foxflow/internal/repository"
 	pb "github.com/lemconn/foxflow/proto/generated"
 )
 
@@ -161,3 +171,615 @@ func TestNewServer(t *testing.T) {
 		t.Errorf("NewServer() port = %v, want %v", server.port, port)
 	}
 }
+
+func TestServer_GetNewsSearch(t *testing.T) {
+	// 使用临时数据库存放新闻归档
+	dbFile := filepath.Join(t.TempDir(), "foxflow-news.db")
+	if err := os.WriteFile(dbFile, nil, 0644); err != nil {
+		t.Fatalf("Failed to create db file: %v", err)
+	}
+	original := config.GlobalConfig
+	config.GlobalConfig = &config.Config{DBFile: dbFile}
+	defer func() { config.GlobalConfig = original }()
+
+	if err := database.InitDB(); err != nil {
+		t.Fatalf("Failed to init db: %v", err)
+	}
+
+	now := time.Now()
+	items := []news.NewsItem{
+		{ID: "1", Title: "SEC approves spot ETF", Content: "Spot bitcoin ETF approved", PublishedAt: now.Add(-2 * time.Hour), Tags: []string{"ETF", "SEC"}},
+		{ID: "2", Title: "比特币现货ETF资金流入创新高", Content: "单日净流入超过 10 亿美元", PublishedAt: now.Add(-30 * time.Minute)},
+		{ID: "3", Title: "Exchange hack", Content: "Hot wallet drained", PublishedAt: now.Add(-10 * time.Minute)},
+	}
+
+	added, err := repository.SaveNews("blockbeats", items)
+	if err != nil || added != 3 {
+		t.Fatalf("SaveNews() = %d, %v, want 3", added, err)
+	}
+
+	// 同一新闻源的相同新闻只归档一次
+	added, err = repository.SaveNews("blockbeats", items[:2])
+	if err != nil || added != 0 {
+		t.Errorf("SaveNews() duplicate = %d, %v, want 0", added, err)
+	}
+	if added, err = repository.SaveNews("coindesk", []news.NewsItem{{ID: "1", Title: "ETF inflows slow", PublishedAt: now.Add(-5 * time.Minute)}}); err != nil || added != 1 {
+		t.Errorf("SaveNews() other source = %d, %v, want 1", added, err)
+	}
+
+	server := NewServer(1259)
+	token, _, err := server.authManager.GenerateToken("foxflow")
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		source  string
+		keyword string
+		since   time.Time
+		wantIDs []string
+	}{
+		{name: "keyword across sources", keyword: "etf", wantIDs: []string{"1", "2", "1"}},
+		{name: "keyword with source", source: "blockbeats", keyword: "ETF", wantIDs: []string{"2", "1"}},
+		{name: "chinese keyword", keyword: "资金流入", wantIDs: []string{"2"}},
+		{name: "short keyword", keyword: "币", wantIDs: []string{"2"}},
+		{name: "multiple keywords", keyword: "spot approved", wantIDs: []string{"1"}},
+		{name: "keyword in content", keyword: "wallet", wantIDs: []string{"3"}},
+		{name: "since", source: "blockbeats", since: now.Add(-time.Hour), wantIDs: []string{"3", "2"}},
+		{name: "keyword and since", keyword: "ETF", since: now.Add(-time.Hour), wantIDs: []string{"1", "2"}},
+		{name: "no match", keyword: "halving", wantIDs: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &pb.GetNewsRequest{
+				Source:      tt.source,
+				Keyword:     tt.keyword,
+				AccessToken: token,
+			}
+			if !tt.since.IsZero() {
+				req.Since = tt.since.Unix()
+			}
+
+			resp, err := server.GetNews(context.Background(), req)
+			if err != nil || !resp.Success {
+				t.Fatalf("GetNews() error = %v, message = %s", err, resp.GetMessage())
+			}
+
+			var ids []string
+			for _, item := range resp.News {
+				ids = append(ids, item.Id)
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("GetNews() ids = %v, want %v", ids, tt.wantIDs)
+			}
+		})
+	}
+
+	// 归档保留标签并按数量截取
+	resp, err := server.GetNews(context.Background(), &pb.GetNewsRequest{Count: 1, Keyword: "SEC", AccessToken: token})
+	if err != nil || len(resp.News) != 1 || strings.Join(resp.News[0].Tags, ",") != "ETF,SEC" {
+		t.Errorf("GetNews() = %v, %v", resp.GetNews(), err)
+	}
+}
+
+// initTestDB 使用临时数据库初始化全局数据库连接
+func initTestDB(t *testing.T) {
+	t.Helper()
+
+	dbFile := filepath.Join(t.TempDir(), "foxflow.db")
+	if err := os.WriteFile(dbFile, nil, 0644); err != nil {
+		t.Fatalf("Failed to create db file: %v", err)
+	}
+	original := config.GlobalConfig
+	config.GlobalConfig = &config.Config{DBFile: dbFile}
+	t.Cleanup(func() { config.GlobalConfig = original })
+
+	if err := database.InitDB(); err != nil {
+		t.Fatalf("Failed to init db: %v", err)
+	}
+}
+
+func TestServer_UpdateRiskRule(t *testing.T) {
+	initTestDB(t)
+
+	server := NewServer(1259)
+	token, _, err := server.authManager.GenerateToken("foxflow")
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+
+	update := func(settings map[string]string) *pb.UpdateRiskRuleResponse {
+		resp, err := server.UpdateRiskRule(context.Background(), &pb.UpdateRiskRuleRequest{
+			AccessToken: token,
+			AccountId:   1,
+			Settings:    settings,
+		})
+		if err != nil {
+			t.Fatalf("UpdateRiskRule() error = %v", err)
+		}
+		return resp
+	}
+
+	// 未配置时返回空规则
+	resp := update(nil)
+	if !resp.Success || resp.Rule.MaxOrderNotional != "" || resp.Rule.MaxLeverage != 0 {
+		t.Errorf("UpdateRiskRule() empty = %+v", resp)
+	}
+
+	resp = update(map[string]string{
+		"max_order_notional": "1000.50",
+		"max_leverage":       "20",
+		"deny_symbols":       " doge, pepe ",
+	})
+	if !resp.Success {
+		t.Fatalf("UpdateRiskRule() message = %s", resp.Message)
+	}
+
+	// 未传递的规则保持不变，0 表示不限制
+	resp = update(map[string]string{"max_exposure": "5000", "max_leverage": "0"})
+	if !resp.Success {
+		t.Fatalf("UpdateRiskRule() message = %s", resp.Message)
+	}
+
+	rule, err := repository.GetRiskRule(1)
+	if err != nil || rule == nil {
+		t.Fatalf("GetRiskRule() = %v, %v", rule, err)
+	}
+	if rule.MaxOrderNotional != "1000.5" || rule.MaxExposure != "5000" || rule.MaxLeverage != 0 || rule.DenySymbols != "DOGE,PEPE" {
+		t.Errorf("GetRiskRule() = %+v", rule)
+	}
+
+	for _, settings := range []map[string]string{
+		{"max_daily_loss": "-1"},
+		{"max_open_positions": "abc"},
+		{"max_slippage": "1"},
+	} {
+		if resp := update(settings); resp.Success {
+			t.Errorf("UpdateRiskRule(%v) success, want failure", settings)
+		}
+	}
+
+	resp, err = server.UpdateRiskRule(context.Background(), &pb.UpdateRiskRuleRequest{AccessToken: "invalid", AccountId: 1})
+	if err != nil || resp.Success {
+		t.Errorf("UpdateRiskRule() with invalid token = %+v, %v", resp, err)
+	}
+}
+
+func TestServer_KillSwitch(t *testing.T) {
+	initTestDB(t)
+
+	// binance 账户不支持交易接口，紧急停止只处理数据库中的策略订单
+	accounts := []*model.FoxAccount{
+		{ID: 1, Name: "alice", Exchange: "binance", TradeType: "mock"},
+		{ID: 2, Name: "bob", Exchange: "binance", TradeType: "mock"},
+	}
+	for _, account := range accounts {
+		if err := database.Adapter().FoxAccount.Create(account); err != nil {
+			t.Fatalf("Failed to create account: %v", err)
+		}
+	}
+	createOrder := func(accountID int64, status string) {
+		t.Helper()
+		if err := database.Adapter().FoxOrder.Create(&model.FoxOrder{
+			AccountID:  accountID,
+			Exchange:   "binance",
+			Symbol:     "BTCUSDT",
+			Side:       "buy",
+			PosSide:    "long",
+			MarginType: "isolated",
+			OrderType:  "market",
+			Type:       "open",
+			Status:     status,
+		}); err != nil {
+			t.Fatalf("Failed to create order: %v", err)
+		}
+	}
+	createOrder(1, "waiting")
+	createOrder(1, "waiting")
+	createOrder(1, "opened")
+	createOrder(2, "waiting")
+
+	countWaiting := func(accountID int64) int64 {
+		t.Helper()
+		count, err := database.Adapter().FoxOrder.Where(
+			database.Adapter().FoxOrder.AccountID.Eq(accountID),
+			database.Adapter().FoxOrder.Status.Eq("waiting"),
+		).Count()
+		if err != nil {
+			t.Fatalf("Failed to count orders: %v", err)
+		}
+		return count
+	}
+
+	server := NewServer(1259)
+	token, _, err := server.authManager.GenerateToken("foxflow")
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+	engineInstance := engine.NewEngine()
+	server.SetEngine(engineInstance)
+
+	killSwitch := func(req *pb.KillSwitchRequest) *pb.KillSwitchResponse {
+		t.Helper()
+		req.AccessToken = token
+		resp, err := server.KillSwitch(context.Background(), req)
+		if err != nil || !resp.Success {
+			t.Fatalf("KillSwitch(%+v) error = %v, message = %s", req, err, resp.GetMessage())
+		}
+		return resp
+	}
+
+	// 单个账户：只取消该账户等待中的订单
+	resp := killSwitch(&pb.KillSwitchRequest{AccountId: 1})
+	if resp.CancelledOrders != 2 || len(resp.Errors) != 0 {
+		t.Errorf("KillSwitch() = %+v, want 2 cancelled orders", resp)
+	}
+	if countWaiting(1) != 0 || countWaiting(2) != 1 {
+		t.Errorf("waiting orders = %d, %d, want 0, 1", countWaiting(1), countWaiting(2))
+	}
+	if !engineInstance.Halted(1) || engineInstance.Halted(2) {
+		t.Errorf("Halted() = %v, %v, want true, false", engineInstance.Halted(1), engineInstance.Halted(2))
+	}
+
+	// 紧急停止期间拒绝新的开仓订单
+	openResp, err := server.OpenOrder(context.Background(), &pb.OpenOrderRequest{AccessToken: token, AccountId: 1})
+	if err != nil || openResp.Success || !strings.Contains(openResp.Message, "紧急停止") {
+		t.Errorf("OpenOrder() during kill switch = %+v, %v", openResp, err)
+	}
+
+	// 引擎重启后保持停止状态
+	restarted := engine.NewEngine()
+	if err := restarted.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if err := restarted.Stop(); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	if !restarted.Halted(1) || restarted.Halted(2) {
+		t.Errorf("restarted Halted() = %v, %v, want true, false", restarted.Halted(1), restarted.Halted(2))
+	}
+
+	killSwitch(&pb.KillSwitchRequest{AccountId: 1, Rearm: true})
+	if engineInstance.Halted(1) {
+		t.Error("Halted(1) after rearm = true, want false")
+	}
+
+	// 所有账户
+	resp = killSwitch(&pb.KillSwitchRequest{AllAccounts: true})
+	if resp.CancelledOrders != 1 || countWaiting(2) != 0 {
+		t.Errorf("KillSwitch(all) = %+v, waiting = %d", resp, countWaiting(2))
+	}
+	if !engineInstance.HaltedAll() || !engineInstance.Halted(2) {
+		t.Error("HaltedAll() = false, want true")
+	}
+
+	// 解除单个账户不影响全局停止
+	resp = killSwitch(&pb.KillSwitchRequest{AccountId: 2, Rearm: true})
+	if !engineInstance.Halted(2) || !strings.Contains(resp.Message, "所有账户") {
+		t.Errorf("KillSwitch(rearm 2) = %+v, Halted(2) = %v", resp, engineInstance.Halted(2))
+	}
+
+	killSwitch(&pb.KillSwitchRequest{AllAccounts: true, Rearm: true})
+	if engineInstance.HaltedAll() || engineInstance.Halted(1) || engineInstance.Halted(2) {
+		t.Error("Halted() after rearm all = true, want false")
+	}
+	if list, err := repository.KillSwitchList(); err != nil || len(list) != 0 {
+		t.Errorf("KillSwitchList() = %v, %v, want empty", list, err)
+	}
+
+	// 未指定账户
+	resp, err = server.KillSwitch(context.Background(), &pb.KillSwitchRequest{AccessToken: token})
+	if err != nil || resp.Success {
+		t.Errorf("KillSwitch() without account = %+v, %v", resp, err)
+	}
+}
+
+func TestServer_PauseOrder(t *testing.T) {
+	initTestDB(t)
+
+	order := &model.FoxOrder{
+		AccountID:     1,
+		Exchange:      "okx",
+		Symbol:        "BTC-USDT-SWAP",
+		Side:          "buy",
+		PosSide:       "long",
+		MarginType:    "isolated",
+		Size:          "100",
+		SizeType:      "USDT",
+		OrderType:     "market",
+		Type:          "open",
+		Status:        "waiting",
+		Strategy:      `hold(avg(kline.BTC.close, 5) > 100, "5m")`,
+		StrategyState: `{"hold":{}}`,
+	}
+	if err := database.Adapter().FoxOrder.Create(order); err != nil {
+		t.Fatalf("Failed to create order: %v", err)
+	}
+
+	server := NewServer(1260)
+	token, _, err := server.authManager.GenerateToken("foxflow")
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+
+	pauseOrder := func(accountID int64, resume bool) *pb.PauseOrderResponse {
+		t.Helper()
+		resp, err := server.PauseOrder(context.Background(), &pb.PauseOrderRequest{
+			AccessToken: token,
+			AccountId:   accountID,
+			Id:          order.ID,
+			Resume:      resume,
+		})
+		if err != nil {
+			t.Fatalf("PauseOrder() error = %v", err)
+		}
+		return resp
+	}
+	status := func() *model.FoxOrder {
+		t.Helper()
+		current, err := database.Adapter().FoxOrder.Where(database.Adapter().FoxOrder.ID.Eq(order.ID)).First()
+		if err != nil {
+			t.Fatalf("Failed to get order: %v", err)
+		}
+		return current
+	}
+
+	// 其他账户无法操作
+	if resp := pauseOrder(2, false); resp.Success {
+		t.Errorf("PauseOrder() other account = %+v, want failure", resp)
+	}
+
+	resp := pauseOrder(1, false)
+	if !resp.Success || resp.Order.Status != "paused" || status().Status != "paused" {
+		t.Fatalf("PauseOrder() = %+v, want paused", resp)
+	}
+	if resp := pauseOrder(1, false); resp.Success {
+		t.Errorf("PauseOrder() already paused = %+v, want failure", resp)
+	}
+
+	// 恢复后清空策略求值状态
+	resp = pauseOrder(1, true)
+	current := status()
+	if !resp.Success || current.Status != "waiting" || current.StrategyState != "" {
+		t.Errorf("PauseOrder(resume) = %+v, order = %+v", resp, current)
+	}
+	if resp := pauseOrder(1, true); resp.Success {
+		t.Errorf("PauseOrder(resume) not paused = %+v, want failure", resp)
+	}
+
+	// 已暂停的订单可以取消
+	pauseOrder(1, false)
+	cancelResp, err := server.CancelOrder(context.Background(), &pb.CancelOrderRequest{
+		AccessToken: token,
+		AccountId:   1,
+		Exchange:    "okx",
+		Symbol:      order.Symbol,
+		Side:        order.Side,
+		PosSide:     order.PosSide,
+		Amount:      order.Size,
+		AmountType:  order.SizeType,
+	})
+	if err != nil || !cancelResp.Success || status().Status != "cancelled" {
+		t.Errorf("CancelOrder() paused = %+v, %v", cancelResp, err)
+	}
+}
+
+func TestServer_PauseEngine(t *testing.T) {
+	initTestDB(t)
+
+	server := NewServer(1261)
+	token, _, err := server.authManager.GenerateToken("foxflow")
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+
+	pauseEngine := func(resume bool) *pb.PauseEngineResponse {
+		t.Helper()
+		resp, err := server.PauseEngine(context.Background(), &pb.PauseEngineRequest{AccessToken: token, Resume: resume})
+		if err != nil {
+			t.Fatalf("PauseEngine() error = %v", err)
+		}
+		return resp
+	}
+
+	if resp := pauseEngine(false); resp.Success {
+		t.Errorf("PauseEngine() without engine = %+v, want failure", resp)
+	}
+
+	engineInstance := engine.NewEngine()
+	server.SetEngine(engineInstance)
+
+	if resp := pauseEngine(false); !resp.Success || !resp.Paused || !engineInstance.Paused() {
+		t.Errorf("PauseEngine() = %+v, want paused", resp)
+	}
+
+	// 暂停状态持久化，重启引擎后仍保持暂停
+	restarted := engine.NewEngine()
+	if err := restarted.Start(); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if !restarted.Paused() {
+		t.Error("重启后的引擎应保持暂停")
+	}
+	if err := restarted.Stop(); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+
+	if resp := pauseEngine(true); !resp.Success || resp.Paused || engineInstance.Paused() {
+		t.Errorf("PauseEngine(resume) = %+v, want resumed", resp)
+	}
+	if paused, err := repository.EnginePaused(); err != nil || paused {
+		t.Errorf("EnginePaused() = %v, %v, want false after resume", paused, err)
+	}
+
+	// 维护时间窗口内自动暂停（跨越零点）
+	windows, err := config.ParseMaintenanceWindows("sat 23:30-01:00, daily 12:00-12:15")
+	if err != nil {
+		t.Fatalf("ParseMaintenanceWindows() error = %v", err)
+	}
+	engineInstance.SetMaintenanceWindows(windows)
+
+	tests := []struct {
+		now  time.Time
+		want bool
+	}{
+		{now: time.Date(2025, 1, 4, 23, 45, 0, 0, time.Local), want: true},  // 周六
+		{now: time.Date(2025, 1, 5, 0, 30, 0, 0, time.Local), want: true},   // 周日凌晨
+		{now: time.Date(2025, 1, 5, 1, 0, 0, 0, time.Local), want: false},   // 窗口结束
+		{now: time.Date(2025, 1, 5, 23, 45, 0, 0, time.Local), want: false}, // 周日
+		{now: time.Date(2025, 1, 7, 12, 10, 0, 0, time.Local), want: true},  // 每日窗口
+	}
+	for _, tt := range tests {
+		now := tt.now
+		engineInstance.SetClock(func() time.Time { return now })
+		if got := engineInstance.InMaintenance(); got != tt.want {
+			t.Errorf("InMaintenance() at %v = %v, want %v", tt.now, got, tt.want)
+		}
+	}
+
+	if resp := pauseEngine(true); !resp.Success || !resp.Maintenance || !strings.Contains(resp.Message, "维护时间窗口") {
+		t.Errorf("PauseEngine(resume) in maintenance = %+v", resp)
+	}
+
+	for _, value := range []string{"sun 25:00-01:00", "weekly 01:00-02:00", "mon 01:00", "daily 01:00-01:00"} {
+		if _, err := config.ParseMaintenanceWindows(value); err == nil {
+			t.Errorf("ParseMaintenanceWindows(%q) error = nil, want error", value)
+		}
+	}
+}
+
+func TestServer_OpenOrderExpireAt(t *testing.T) {
+	server := NewServer(1262)
+	token, _, err := server.authManager.GenerateToken("foxflow")
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+
+	resp, err := server.OpenOrder(context.Background(), &pb.OpenOrderRequest{
+		AccessToken: token,
+		AccountId:   1,
+		Exchange:    "okx",
+		Symbol:      "BTC-USDT-SWAP",
+		PosSide:     "long",
+		Margin:      "isolated",
+		Amount:      "100",
+		ExpireAt:    time.Now().Add(-time.Minute).Unix(),
+	})
+	if err != nil || resp.Success || !strings.Contains(resp.Message, "expire_at") {
+		t.Errorf("OpenOrder() with past expire_at = %+v, %v", resp, err)
+	}
+}
+
+func TestServer_GetOrderHistory(t *testing.T) {
+	initTestDB(t)
+
+	order := &model.FoxOrder{
+		AccountID:  1,
+		Exchange:   "okx",
+		Symbol:     "BTC-USDT-SWAP",
+		Side:       "buy",
+		PosSide:    "long",
+		MarginType: "isolated",
+		Size:       "100",
+		SizeType:   "USDT",
+		OrderType:  "limit",
+		Type:       "open",
+		Status:     "opened",
+		Chase:      "active",
+	}
+	if err := database.Adapter().FoxOrder.Create(order); err != nil {
+		t.Fatalf("Failed to create order: %v", err)
+	}
+	for _, history := range []*model.FoxOrderHistory{
+		{OrderID: order.ID, Action: "submit", ClientOrderID: "FOX1", Price: "100", Size: "5"},
+		{OrderID: order.ID, Action: "amend", ClientOrderID: "FOX2", Price: "100.1", Size: "3"},
+	} {
+		if err := database.Adapter().FoxOrderHistory.Create(history); err != nil {
+			t.Fatalf("Failed to create order history: %v", err)
+		}
+	}
+
+	server := NewServer(1263)
+	token, _, err := server.authManager.GenerateToken("foxflow")
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+
+	// 其他账户无法查看
+	resp, err := server.GetOrderHistory(context.Background(), &pb.GetOrderHistoryRequest{AccessToken: token, AccountId: 2, Id: order.ID})
+	if err != nil || resp.Success {
+		t.Errorf("GetOrderHistory() other account = %+v, %v, want failure", resp, err)
+	}
+
+	resp, err = server.GetOrderHistory(context.Background(), &pb.GetOrderHistoryRequest{AccessToken: token, AccountId: 1, Id: order.ID})
+	if err != nil || !resp.Success {
+		t.Fatalf("GetOrderHistory() = %+v, %v", resp, err)
+	}
+	if len(resp.Histories) != 2 || resp.Histories[0].Action != "submit" || resp.Histories[1].ClientOrderId != "FOX2" || resp.Histories[1].Price != "100.1" {
+		t.Errorf("GetOrderHistory() histories = %+v", resp.Histories)
+	}
+}
+
+func TestServer_DcaPlan(t *testing.T) {
+	initTestDB(t)
+
+	server := NewServer(1264)
+	token, _, err := server.authManager.GenerateToken("foxflow")
+	if err != nil {
+		t.Fatalf("Failed to generate token: %v", err)
+	}
+
+	// 参数校验在访问交易所之前完成
+	for _, req := range []*pb.CreateDcaPlanRequest{
+		{Schedule: "0 9 * *", Amount: "50"},
+		{Schedule: "0 0 30 2 *", Amount: "50"},
+		{Schedule: "0 9 * * MON", Amount: "-1"},
+		{Schedule: "0 9 * * MON", Amount: "50", AmountType: "percent"},
+		{Schedule: "0 9 * * MON", Amount: "50", Guard: "unknown_func(1) > 2"},
+		{Schedule: "0 9 * * MON", Amount: "50", Guard: "hold(market.okx.BTC.price > 60000, 3m)"},
+		{Schedule: "0 9 * * MON", Amount: "50", Guard: "market.okx.BTC.price > 0 and within(market.okx.BTC.price < 58000, 10m)"},
+	} {
+		req.AccessToken, req.AccountId, req.Exchange, req.Symbol, req.PosSide, req.Margin = token, 1, "okx", "BTC-USDT-SWAP", "long", "cross"
+		resp, err := server.CreateDcaPlan(context.Background(), req)
+		if err != nil || resp.Success {
+			t.Errorf("CreateDcaPlan(%s, %s, %s) = %+v, %v, want failure", req.Schedule, req.Amount, req.Guard, resp, err)
+		}
+	}
+
+	plan := &model.FoxDcaPlan{
+		AccountID:  1,
+		Exchange:   "okx",
+		Symbol:     "BTC-USDT-SWAP",
+		PosSide:    "long",
+		MarginType: "cross",
+		Amount:     "50",
+		AmountType: "USDT",
+		Schedule:   "0 9 * * MON",
+		Status:     "active",
+		NextRunAt:  time.Now().Add(time.Hour).Unix(),
+	}
+	if err := database.Adapter().FoxDcaPlan.Create(plan); err != nil {
+		t.Fatalf("Failed to create dca plan: %v", err)
+	}
+
+	listResp, err := server.GetDcaPlans(context.Background(), &pb.GetDcaPlansRequest{AccessToken: token, AccountId: 1})
+	if err != nil || !listResp.Success || len(listResp.Plans) != 1 || listResp.Plans[0].Schedule != "0 9 * * MON" {
+		t.Fatalf("GetDcaPlans() = %+v, %v", listResp, err)
+	}
+
+	// 其他账户无法取消
+	cancelResp, err := server.CancelDcaPlan(context.Background(), &pb.CancelDcaPlanRequest{AccessToken: token, AccountId: 2, Id: plan.ID})
+	if err != nil || cancelResp.Success {
+		t.Errorf("CancelDcaPlan() other account = %+v, %v, want failure", cancelResp, err)
+	}
+
+	cancelResp, err = server.CancelDcaPlan(context.Background(), &pb.CancelDcaPlanRequest{AccessToken: token, AccountId: 1, Id: plan.ID})
+	if err != nil || !cancelResp.Success || cancelResp.Plan.Status != "cancelled" {
+		t.Fatalf("CancelDcaPlan() = %+v, %v", cancelResp, err)
+	}
+	if resp, _ := server.CancelDcaPlan(context.Background(), &pb.CancelDcaPlanRequest{AccessToken: token, AccountId: 1, Id: plan.ID}); resp.Success {
+		t.Errorf("CancelDcaPlan() already cancelled = %+v, want failure", resp)
+	}
+}
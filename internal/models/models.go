@@ -69,24 +69,39 @@ func (FoxSymbol) TableName() string {
 
 // FoxOrder Order table
 type FoxOrder struct {
-	ID         uint      `gorm:"primaryKey" json:"id"`
-	Exchange   string    `gorm:"not null;default:'okx'" json:"exchange"`
-	AccountID  uint      `gorm:"not null;default:0" json:"account_id"`
-	Symbol     string    `gorm:"not null;default:''" json:"symbol"`
-	Side       string    `gorm:"not null;default:'';check:side IN ('buy', 'sell')" json:"side"`
-	PosSide    string    `gorm:"not null;default:'';check:pos_side IN ('long', 'short')" json:"pos_side"`
-	MarginType string    `gorm:"not null;default:'';check:margin_type IN ('isolated', 'cross')" json:"margin_type"`
-	Price      string    `gorm:"not null;default:0" json:"price"`
-	Size       string    `gorm:"not null;default:0" json:"size"`
-	SizeType   string    `gorm:"not null;default:''" json:"size_type"`
-	OrderType  string    `gorm:"not null;default:'limit';check:order_type IN ('limit', 'market')" json:"order_type"`
-	Strategy   string    `gorm:"not null;default:''" json:"strategy"`
-	OrderID    string    `gorm:"not null;default:''" json:"order_id"`
-	Type       string    `gorm:"not null;default:'open';check:type IN ('open', 'close')" json:"type"`
-	Status     string    `gorm:"not null;default:'waiting';check:status IN ('waiting', 'opened', 'closed', 'failed', 'cancelled')" json:"status"`
-	Msg        string    `gorm:"not null;default:''" json:"msg"` // 订单描述（引擎处理结果）
-	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime:milli" json:"created_at"`
-	UpdatedAt  time.Time `gorm:"column:updated_at;autoUpdateTime:milli" json:"updated_at"`
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	Exchange      string    `gorm:"not null;default:'okx'" json:"exchange"`
+	AccountID     uint      `gorm:"not null;default:0" json:"account_id"`
+	Symbol        string    `gorm:"not null;default:''" json:"symbol"`
+	Side          string    `gorm:"not null;default:'';check:side IN ('buy', 'sell')" json:"side"`
+	PosSide       string    `gorm:"not null;default:'';check:pos_side IN ('long', 'short')" json:"pos_side"`
+	MarginType    string    `gorm:"not null;default:'';check:margin_type IN ('isolated', 'cross')" json:"margin_type"`
+	Price         string    `gorm:"not null;default:0" json:"price"`
+	Size          string    `gorm:"not null;default:0" json:"size"`
+	SizeType      string    `gorm:"not null;default:''" json:"size_type"`
+	OrderType     string    `gorm:"not null;default:'limit';check:order_type IN ('limit', 'market', 'post_only', 'ioc', 'fok')" json:"order_type"`
+	Strategy      string    `gorm:"not null;default:''" json:"strategy"`
+	OrderID       string    `gorm:"not null;default:''" json:"order_id"`
+	Type          string    `gorm:"not null;default:'open';check:type IN ('open', 'close')" json:"type"`
+	Status        string    `gorm:"not null;default:'waiting';check:status IN ('pending', 'waiting', 'paused', 'executing', 'opened', 'closed', 'failed', 'cancelled', 'expired')" json:"status"`
+	Msg           string    `gorm:"not null;default:''" json:"msg"`                                                    // 订单描述（引擎处理结果）
+	StrategyState string    `gorm:"not null;default:''" json:"strategy_state"`                                         // 策略求值状态（JSON，供 hold/within 等跨周期函数使用）
+	ExpireAt      int64     `gorm:"not null;default:0" json:"expire_at"`                                               // 过期时间（Unix 秒，0 表示不过期）
+	ParentID      uint      `gorm:"not null;default:0;index" json:"parent_id"`                                         // 父订单ID（开仓订单成交后激活的平仓订单，同一父订单的平仓订单互为 OCO）
+	PriceExpr     string    `gorm:"not null;default:''" json:"price_expr"`                                             // 限价表达式（触发时求值，按价格精度取整后写入 price）
+	Algo          string    `gorm:"not null;default:'';check:algo IN ('', 'twap', 'iceberg')" json:"algo"`             // 执行算法（为空表示一次性提交）
+	AlgoDuration  int64     `gorm:"not null;default:0" json:"algo_duration"`                                           // TWAP 执行时长（秒）
+	AlgoSlices    int64     `gorm:"not null;default:0" json:"algo_slices"`                                             // TWAP 拆分笔数
+	AlgoVisible   string    `gorm:"not null;default:''" json:"algo_visible"`                                           // 冰山单每笔可见数量占总数量的百分比
+	AlgoTotal     string    `gorm:"not null;default:''" json:"algo_total"`                                             // 算法订单总张数（触发时计算）
+	AlgoFilled    string    `gorm:"not null;default:''" json:"algo_filled"`                                            // 算法订单已成交张数
+	Chase         string    `gorm:"not null;default:'';check:chase IN ('', 'active', 'stopped', 'done')" json:"chase"` // 追价状态（active 追价中，stopped 达到最大滑点，done 挂单已结束）
+	MaxSlippage   string    `gorm:"not null;default:''" json:"max_slippage"`                                           // 追价最大滑点（相对首次委托价格的百分比，为空表示不限制）
+	ChaseBase     string    `gorm:"not null;default:''" json:"chase_base"`                                             // 追价基准价格（首次委托价格）
+	ChaseFilled   string    `gorm:"not null;default:''" json:"chase_filled"`                                           // 追价撤单前各笔委托的累计成交张数（不含当前委托）
+	ReduceOnly    int       `gorm:"not null;default:0" json:"reduce_only"`                                             // 只减仓（1 是，仅平仓订单；部分平仓始终只减仓）
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime:milli" json:"created_at"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;autoUpdateTime:milli" json:"updated_at"`
 }
 
 func (FoxOrder) TableName() string {
@@ -108,6 +123,143 @@ func (FoxExchange) TableName() string {
 	return "fox_exchanges"
 }
 
+// FoxNews 新闻归档表（按新闻源和新闻 ID 去重）
+type FoxNews struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	Source      string    `gorm:"not null;default:'';uniqueIndex:idx_fox_news_source_news_id,priority:1" json:"source"`
+	NewsID      string    `gorm:"not null;default:'';uniqueIndex:idx_fox_news_source_news_id,priority:2" json:"news_id"`
+	Title       string    `gorm:"not null;default:''" json:"title"`
+	Content     string    `gorm:"not null;default:''" json:"content"`
+	URL         string    `gorm:"not null;default:''" json:"url"`
+	Tags        string    `gorm:"not null;default:''" json:"tags"` // 标签（逗号分隔）
+	ImageURL    string    `gorm:"not null;default:''" json:"image_url"`
+	PublishedAt time.Time `gorm:"not null;index" json:"published_at"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime:milli" json:"created_at"`
+}
+
+func (FoxNews) TableName() string {
+	return "fox_news"
+}
+
+// FoxRiskRule 账户风控规则表（每个账户一条，0 或空值表示不限制）
+type FoxRiskRule struct {
+	ID               uint      `gorm:"primaryKey" json:"id"`
+	AccountID        uint      `gorm:"not null;default:0;uniqueIndex" json:"account_id"`
+	MaxOrderNotional string    `gorm:"not null;default:''" json:"max_order_notional"` // 单笔订单最大名义价值（USDT）
+	MaxExposure      string    `gorm:"not null;default:''" json:"max_exposure"`       // 持仓总名义价值上限（USDT，含本次订单）
+	MaxLeverage      int       `gorm:"not null;default:0" json:"max_leverage"`        // 最大杠杆倍数
+	MaxOpenPositions int       `gorm:"not null;default:0" json:"max_open_positions"`  // 最大持仓数量（含本次订单）
+	MaxDailyLoss     string    `gorm:"not null;default:''" json:"max_daily_loss"`     // 当日最大已实现亏损（USDT）
+	AllowSymbols     string    `gorm:"not null;default:''" json:"allow_symbols"`      // 允许交易的标的（逗号分隔，为空表示不限制）
+	DenySymbols      string    `gorm:"not null;default:''" json:"deny_symbols"`       // 禁止交易的标的（逗号分隔）
+	CreatedAt        time.Time `gorm:"column:created_at;autoCreateTime:milli" json:"created_at"`
+	UpdatedAt        time.Time `gorm:"column:updated_at;autoUpdateTime:milli" json:"updated_at"`
+}
+
+func (FoxRiskRule) TableName() string {
+	return "fox_risk_rules"
+}
+
+// FoxKillSwitch 紧急停止记录表（存在记录时引擎不再处理对应账户的订单，直到重新启用）
+type FoxKillSwitch struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	AccountID uint      `gorm:"not null;default:0;uniqueIndex" json:"account_id"` // 0 表示所有账户
+	Reason    string    `gorm:"not null;default:''" json:"reason"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime:milli" json:"created_at"`
+}
+
+func (FoxKillSwitch) TableName() string {
+	return "fox_kill_switches"
+}
+
+// FoxAlgoSlice 算法订单拆分出的子订单表（TWAP 按时间提交，冰山单逐笔提交）
+type FoxAlgoSlice struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	OrderID       uint      `gorm:"not null;default:0;index" json:"order_id"` // 父订单ID（fox_orders.id）
+	ClientOrderID string    `gorm:"not null;default:''" json:"client_order_id"`
+	Seq           int       `gorm:"not null;default:0" json:"seq"`     // 子订单序号（从 1 开始）
+	Size          string    `gorm:"not null;default:''" json:"size"`   // 委托张数
+	Filled        string    `gorm:"not null;default:''" json:"filled"` // 成交张数
+	Status        string    `gorm:"not null;default:'scheduled';check:status IN ('scheduled', 'submitted', 'filled', 'cancelled', 'failed')" json:"status"`
+	ScheduledAt   int64     `gorm:"not null;default:0" json:"scheduled_at"` // 计划提交时间（Unix 秒，0 表示上一笔成交后提交）
+	Msg           string    `gorm:"not null;default:''" json:"msg"`
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime:milli" json:"created_at"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;autoUpdateTime:milli" json:"updated_at"`
+}
+
+func (FoxAlgoSlice) TableName() string {
+	return "fox_algo_slices"
+}
+
+// FoxOrderHistory 订单历史表，记录订单提交到交易所后的委托变更（提交、追价改单等）
+type FoxOrderHistory struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	OrderID       uint      `gorm:"not null;default:0;index" json:"order_id"` // 订单ID（fox_orders.id）
+	Action        string    `gorm:"not null;default:'';check:action IN ('submit', 'amend', 'stop', 'done', 'failed')" json:"action"`
+	ClientOrderID string    `gorm:"not null;default:''" json:"client_order_id"` // 交易所委托的客户自定义订单ID
+	Price         string    `gorm:"not null;default:''" json:"price"`
+	Size          string    `gorm:"not null;default:''" json:"size"`
+	Msg           string    `gorm:"not null;default:''" json:"msg"`
+	CreatedAt     time.Time `gorm:"column:created_at;autoCreateTime:milli" json:"created_at"`
+}
+
+func (FoxOrderHistory) TableName() string {
+	return "fox_order_histories"
+}
+
+// FoxDcaPlan 定投计划表（按 cron 表达式定期创建开仓订单，执行条件不满足时跳过本次定投）
+type FoxDcaPlan struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	AccountID   uint      `gorm:"not null;default:0;index" json:"account_id"`
+	Exchange    string    `gorm:"not null;default:'okx'" json:"exchange"`
+	Symbol      string    `gorm:"not null;default:''" json:"symbol"`
+	PosSide     string    `gorm:"not null;default:'';check:pos_side IN ('long', 'short')" json:"pos_side"`
+	MarginType  string    `gorm:"not null;default:'';check:margin_type IN ('isolated', 'cross')" json:"margin_type"`
+	Amount      string    `gorm:"not null;default:''" json:"amount"`      // 每次定投数量
+	AmountType  string    `gorm:"not null;default:''" json:"amount_type"` // 数量类型（为空表示标的数量，USDT 表示金额）
+	Schedule    string    `gorm:"not null;default:''" json:"schedule"`    // cron 表达式（分 时 日 月 周）
+	Guard       string    `gorm:"not null;default:''" json:"guard"`       // 执行条件（策略表达式，为空表示总是执行）
+	Status      string    `gorm:"not null;default:'active';check:status IN ('active', 'cancelled')" json:"status"`
+	NextRunAt   int64     `gorm:"not null;default:0;index" json:"next_run_at"` // 下次执行时间（Unix 秒）
+	LastRunAt   int64     `gorm:"not null;default:0" json:"last_run_at"`       // 上次执行时间（Unix 秒，含跳过）
+	Runs        int       `gorm:"not null;default:0" json:"runs"`              // 已创建订单次数
+	Skips       int       `gorm:"not null;default:0" json:"skips"`             // 已跳过次数
+	LastOrderID uint      `gorm:"not null;default:0" json:"last_order_id"`     // 最近一次创建的订单ID（fox_orders.id）
+	Msg         string    `gorm:"not null;default:''" json:"msg"`              // 最近一次执行结果
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime:milli" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime:milli" json:"updated_at"`
+}
+
+func (FoxDcaPlan) TableName() string {
+	return "fox_dca_plans"
+}
+
+// FoxEnginePause 引擎暂停记录表（存在记录时引擎不处理任何策略订单，重启后保持暂停，直到手动恢复）
+type FoxEnginePause struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	Reason    string    `gorm:"not null;default:''" json:"reason"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime:milli" json:"created_at"`
+}
+
+func (FoxEnginePause) TableName() string {
+	return "fox_engine_pauses"
+}
+
+// FoxNewsFeed RSS/Atom 新闻源表（与配置中的新闻源一同注册，名称相同时以数据库为准）
+type FoxNewsFeed struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	Name        string    `gorm:"not null;default:'';uniqueIndex" json:"name"` // DSL 中的数据源名，如 news.coindesk.title
+	DisplayName string    `gorm:"not null;default:''" json:"display_name"`     // 展示名称，为空时使用内置名称或 Name
+	URL         string    `gorm:"not null;default:''" json:"url"`              // 订阅地址
+	IsActive    int       `gorm:"not null;default:1" json:"is_active"`         // 是否启用
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime:milli" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime:milli" json:"updated_at"`
+}
+
+func (FoxNewsFeed) TableName() string {
+	return "fox_news_feeds"
+}
+
 // 初始化数据库表
 func InitDB(db *gorm.DB) error {
 	return db.AutoMigrate(
@@ -117,5 +269,13 @@ func InitDB(db *gorm.DB) error {
 		&FoxOrder{},
 		&FoxExchange{},
 		&FoxSymbol{},
+		&FoxNews{},
+		&FoxRiskRule{},
+		&FoxKillSwitch{},
+		&FoxAlgoSlice{},
+		&FoxOrderHistory{},
+		&FoxDcaPlan{},
+		&FoxEnginePause{},
+		&FoxNewsFeed{},
 	)
 }
@@ -204,6 +204,7 @@ func (b *BlockBeats) convertToNewsItem(item BlockBeatsNewsItem) NewsItem {
 		PublishedAt: publishedAt,
 		Tags:        item.TagList,
 		ImageURL:    item.ImgURL,
+		Symbols:     ParseSymbols(item.CryptoToken),
 	}
 }
 
@@ -0,0 +1,330 @@
+package news
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/config"
+)
+
+// FeedConfig RSS/Atom 新闻源配置
+type FeedConfig struct {
+	Name        string // 新闻源名称，即 DSL 中的数据源名，如 news.coindesk.title
+	DisplayName string // 新闻源展示名称，为空时使用 Name
+	URL         string // 订阅地址
+}
+
+// rssDocument RSS 2.0 文档结构
+type rssDocument struct {
+	XMLName xml.Name `xml:"rss"`
+	Channel struct {
+		Title string    `xml:"title"`
+		Items []rssItem `xml:"item"`
+	} `xml:"channel"`
+}
+
+// rssItem RSS 2.0 条目
+type rssItem struct {
+	GUID        string   `xml:"guid"`
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	Content     string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	PubDate     string   `xml:"pubDate"`
+	Date        string   `xml:"http://purl.org/dc/elements/1.1/ date"`
+	Categories  []string `xml:"category"`
+	Enclosure   struct {
+		URL  string `xml:"url,attr"`
+		Type string `xml:"type,attr"`
+	} `xml:"enclosure"`
+}
+
+// atomDocument Atom 文档结构
+type atomDocument struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Title   string      `xml:"title"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+// atomEntry Atom 条目
+type atomEntry struct {
+	ID        string `xml:"id"`
+	Title     string `xml:"title"`
+	Summary   string `xml:"summary"`
+	Content   string `xml:"content"`
+	Published string `xml:"published"`
+	Updated   string `xml:"updated"`
+	Links     []struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+		Type string `xml:"type,attr"`
+	} `xml:"link"`
+	Categories []struct {
+		Term string `xml:"term,attr"`
+	} `xml:"category"`
+}
+
+// feedTimeLayouts 订阅源中常见的时间格式
+var feedTimeLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC3339,
+	time.RFC3339Nano,
+	time.RFC822Z,
+	time.RFC822,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+}
+
+// feedDisplayNames 常见新闻源的展示名称
+var feedDisplayNames = map[string]string{
+	"coindesk":      "CoinDesk",
+	"cointelegraph": "Cointelegraph",
+	"decrypt":       "Decrypt",
+	"theblock":      "The Block",
+}
+
+// htmlTagPattern HTML 标签
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// Feed 通用 RSS/Atom 新闻源实现
+type Feed struct {
+	config     FeedConfig
+	httpClient *http.Client
+}
+
+// NewFeed 创建 RSS/Atom 新闻源
+func NewFeed(cfg FeedConfig) *Feed {
+	return &Feed{
+		config: cfg,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// ConfiguredFeeds 获取配置中的 RSS/Atom 新闻源，数据库中的新闻源由 repository.ListNewsFeeds 获取
+func ConfiguredFeeds() []FeedConfig {
+	if config.GlobalConfig == nil {
+		return nil
+	}
+
+	feeds := make([]FeedConfig, 0, len(config.GlobalConfig.NewsFeeds))
+	for _, feed := range config.GlobalConfig.NewsFeeds {
+		feeds = append(feeds, FeedConfig{Name: feed.Name, DisplayName: FeedDisplayName(feed.Name), URL: feed.URL})
+	}
+	return feeds
+}
+
+// FeedDisplayName 获取新闻源的默认展示名称，常见新闻源使用内置名称，其余使用新闻源名称
+func FeedDisplayName(name string) string {
+	if displayName, ok := feedDisplayNames[strings.ToLower(name)]; ok {
+		return displayName
+	}
+	return name
+}
+
+// GetName 获取新闻源名称
+func (f *Feed) GetName() string {
+	return f.config.Name
+}
+
+// GetDisplayName 获取新闻源展示名称
+func (f *Feed) GetDisplayName() string {
+	if f.config.DisplayName != "" {
+		return f.config.DisplayName
+	}
+	return f.config.Name
+}
+
+// GetNews 获取新闻列表，按发布时间从新到旧排列
+func (f *Feed) GetNews(ctx context.Context, count int) ([]NewsItem, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", f.config.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %w", err)
+	}
+	req.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/xml, text/xml")
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; foxflow)")
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("请求失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("订阅源返回状态码 %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("读取响应失败: %w", err)
+	}
+
+	items, err := f.parse(body)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].PublishedAt.After(items[j].PublishedAt)
+	})
+	if count > 0 && len(items) > count {
+		items = items[:count]
+	}
+
+	return items, nil
+}
+
+// IsHealthy 检查新闻源是否健康可用
+func (f *Feed) IsHealthy(ctx context.Context) bool {
+	_, err := f.GetNews(ctx, 1)
+	return err == nil
+}
+
+// parse 解析 RSS 2.0 或 Atom 文档
+func (f *Feed) parse(body []byte) ([]NewsItem, error) {
+	var rss rssDocument
+	if err := xml.Unmarshal(body, &rss); err == nil {
+		items := make([]NewsItem, 0, len(rss.Channel.Items))
+		for _, item := range rss.Channel.Items {
+			items = append(items, f.convertRSSItem(item))
+		}
+		return items, nil
+	}
+
+	var atom atomDocument
+	if err := xml.Unmarshal(body, &atom); err == nil {
+		items := make([]NewsItem, 0, len(atom.Entries))
+		for _, entry := range atom.Entries {
+			items = append(items, f.convertAtomEntry(entry))
+		}
+		return items, nil
+	}
+
+	return nil, fmt.Errorf("解析订阅源失败: 不是有效的 RSS 或 Atom 文档")
+}
+
+// convertRSSItem 将 RSS 条目转换为统一格式
+func (f *Feed) convertRSSItem(item rssItem) NewsItem {
+	content := item.Content
+	if content == "" {
+		content = item.Description
+	}
+
+	pubDate := item.PubDate
+	if pubDate == "" {
+		pubDate = item.Date
+	}
+
+	imageURL := ""
+	if strings.HasPrefix(item.Enclosure.Type, "image/") {
+		imageURL = item.Enclosure.URL
+	}
+
+	tags := make([]string, 0, len(item.Categories))
+	for _, category := range item.Categories {
+		if category = strings.TrimSpace(category); category != "" {
+			tags = append(tags, category)
+		}
+	}
+
+	publishedAt := parseFeedTime(pubDate)
+	return NewsItem{
+		ID:          feedItemID(strings.TrimSpace(item.GUID), strings.TrimSpace(item.Link), item.Title, publishedAt),
+		Title:       strings.TrimSpace(html.UnescapeString(item.Title)),
+		Content:     stripTags(content),
+		URL:         strings.TrimSpace(item.Link),
+		Source:      f.GetDisplayName(),
+		PublishedAt: publishedAt,
+		Tags:        tags,
+		ImageURL:    imageURL,
+	}
+}
+
+// convertAtomEntry 将 Atom 条目转换为统一格式
+func (f *Feed) convertAtomEntry(entry atomEntry) NewsItem {
+	content := entry.Content
+	if content == "" {
+		content = entry.Summary
+	}
+
+	published := entry.Published
+	if published == "" {
+		published = entry.Updated
+	}
+
+	link := ""
+	imageURL := ""
+	for _, l := range entry.Links {
+		switch {
+		case (l.Rel == "" || l.Rel == "alternate") && link == "":
+			link = l.Href
+		case l.Rel == "enclosure" && strings.HasPrefix(l.Type, "image/"):
+			imageURL = l.Href
+		}
+	}
+
+	tags := make([]string, 0, len(entry.Categories))
+	for _, category := range entry.Categories {
+		if term := strings.TrimSpace(category.Term); term != "" {
+			tags = append(tags, term)
+		}
+	}
+
+	publishedAt := parseFeedTime(published)
+	return NewsItem{
+		ID:          feedItemID(strings.TrimSpace(entry.ID), link, entry.Title, publishedAt),
+		Title:       strings.TrimSpace(html.UnescapeString(entry.Title)),
+		Content:     stripTags(content),
+		URL:         link,
+		Source:      f.GetDisplayName(),
+		PublishedAt: publishedAt,
+		Tags:        tags,
+		ImageURL:    imageURL,
+	}
+}
+
+// parseFeedTime 解析订阅源中的时间，无法解析时返回零值
+func parseFeedTime(value string) time.Time {
+	value = strings.TrimSpace(value)
+	for _, layout := range feedTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// feedItemID 生成条目唯一标识，依次使用 guid/id、链接，最后使用标题和时间的摘要
+func feedItemID(id, link, title string, publishedAt time.Time) string {
+	if id != "" {
+		return id
+	}
+	if link != "" {
+		return link
+	}
+
+	sum := sha1.Sum([]byte(title + "|" + publishedAt.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// stripTags 移除 HTML 标签并还原实体字符
+func stripTags(content string) string {
+	content = htmlTagPattern.ReplaceAllString(content, "")
+	content = html.UnescapeString(content)
+	return strings.TrimSpace(content)
+}
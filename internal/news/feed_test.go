@@ -0,0 +1,181 @@
+package news
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/config"
+)
+
+// newFeedTestServer 使用本地 XML 测试数据启动订阅源服务
+func newFeedTestServer(t *testing.T, fixture string) *httptest.Server {
+	t.Helper()
+
+	data, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatalf("读取订阅源测试数据失败: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write(data)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFeed_RSS(t *testing.T) {
+	server := newFeedTestServer(t, "testdata/rss.xml")
+	feed := NewFeed(FeedConfig{Name: "coindesk", DisplayName: "CoinDesk", URL: server.URL})
+
+	if feed.GetName() != "coindesk" || feed.GetDisplayName() != "CoinDesk" {
+		t.Errorf("新闻源名称错误: %s / %s", feed.GetName(), feed.GetDisplayName())
+	}
+
+	items, err := feed.GetNews(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("GetNews() error = %v", err)
+	}
+	if len(items) != 3 {
+		t.Fatalf("期望 3 条新闻，实际 %d", len(items))
+	}
+
+	// 按发布时间从新到旧排列
+	latest := items[0]
+	if latest.Title != "Bitcoin Miners Sell Reserves" {
+		t.Errorf("期望最新新闻为 Bitcoin Miners Sell Reserves，实际 %s", latest.Title)
+	}
+	if latest.Content != "Miners sold 2,000 BTC over the weekend." {
+		t.Errorf("期望优先使用 content:encoded 并清理 HTML，实际 %q", latest.Content)
+	}
+	if latest.ID != "https://www.coindesk.com/markets/2025/01/06/bitcoin-miners-sell" {
+		t.Errorf("缺少 guid 时期望使用链接作为 ID，实际 %s", latest.ID)
+	}
+	if !latest.PublishedAt.Equal(time.Date(2025, 1, 6, 16, 0, 0, 0, time.UTC)) {
+		t.Errorf("dc:date 解析错误: %v", latest.PublishedAt)
+	}
+
+	etf := items[1]
+	if etf.ID != "coindesk-1001" || etf.Title != "SEC Approves Spot Ether ETFs & Options" {
+		t.Errorf("RSS 条目解析错误: %+v", etf)
+	}
+	if etf.Content != "The SEC approved spot ether ETFs." {
+		t.Errorf("description 清理 HTML 错误: %q", etf.Content)
+	}
+	if len(etf.Tags) != 2 || etf.Tags[0] != "Policy" || etf.Tags[1] != "ETF" {
+		t.Errorf("标签解析错误: %v", etf.Tags)
+	}
+	if etf.ImageURL != "https://www.coindesk.com/images/etf.jpg" || etf.Source != "CoinDesk" {
+		t.Errorf("图片或来源解析错误: %s / %s", etf.ImageURL, etf.Source)
+	}
+	if !etf.PublishedAt.Equal(time.Date(2025, 1, 6, 14, 30, 0, 0, time.UTC)) {
+		t.Errorf("pubDate 解析错误: %v", etf.PublishedAt)
+	}
+
+	// 既没有 guid 也没有链接时生成稳定的 ID
+	recap := items[2]
+	if recap.ID == "" || recap.PublishedAt.IsZero() {
+		t.Errorf("期望生成 ID 并解析单数字日期，实际 %+v", recap)
+	}
+
+	limited, err := feed.GetNews(context.Background(), 1)
+	if err != nil || len(limited) != 1 {
+		t.Errorf("期望按数量截取新闻，实际 %d, %v", len(limited), err)
+	}
+}
+
+func TestFeed_Atom(t *testing.T) {
+	server := newFeedTestServer(t, "testdata/atom.xml")
+	feed := NewFeed(FeedConfig{Name: "cointelegraph", URL: server.URL})
+
+	if feed.GetDisplayName() != "cointelegraph" {
+		t.Errorf("未配置展示名称时期望使用名称，实际 %s", feed.GetDisplayName())
+	}
+
+	items, err := feed.GetNews(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("GetNews() error = %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("期望 2 条新闻，实际 %d", len(items))
+	}
+
+	hack := items[0]
+	if hack.ID != "tag:cointelegraph.com,2025:news/exchange-hack" || hack.URL != "https://cointelegraph.com/news/exchange-hack" {
+		t.Errorf("Atom 条目解析错误: %+v", hack)
+	}
+	if hack.Content != "Attackers drained the hot wallet." {
+		t.Errorf("summary 清理 HTML 错误: %q", hack.Content)
+	}
+	if len(hack.Tags) != 2 || hack.Tags[1] != "Hacks" || hack.ImageURL != "https://cointelegraph.com/images/hack.png" {
+		t.Errorf("标签或图片解析错误: %v / %s", hack.Tags, hack.ImageURL)
+	}
+	if !hack.PublishedAt.Equal(time.Date(2025, 1, 6, 11, 45, 0, 0, time.UTC)) {
+		t.Errorf("published 解析错误: %v", hack.PublishedAt)
+	}
+
+	// 缺少 published 时使用 updated
+	halving := items[1]
+	if !halving.PublishedAt.Equal(time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)) || halving.Content != "Less than 100 days to go." {
+		t.Errorf("Atom 条目解析错误: %+v", halving)
+	}
+}
+
+func TestFeed_Invalid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("<html><body>not a feed</body></html>"))
+	}))
+	defer server.Close()
+
+	feed := NewFeed(FeedConfig{Name: "broken", URL: server.URL})
+	if _, err := feed.GetNews(context.Background(), 10); err == nil {
+		t.Error("期望非订阅源文档报错")
+	}
+	if feed.IsHealthy(context.Background()) {
+		t.Error("期望非订阅源文档不健康")
+	}
+}
+
+func TestManager_RegisterFeeds(t *testing.T) {
+	rss := newFeedTestServer(t, "testdata/rss.xml")
+	atom := newFeedTestServer(t, "testdata/atom.xml")
+
+	feeds, err := config.ParseNewsFeeds("coindesk=" + rss.URL + ", cointelegraph=" + atom.URL)
+	if err != nil {
+		t.Fatalf("解析新闻源配置失败: %v", err)
+	}
+
+	original := config.GlobalConfig
+	config.GlobalConfig = &config.Config{NewsFeeds: feeds}
+	defer func() { config.GlobalConfig = original }()
+
+	manager := NewManager()
+	manager.RegisterFeeds(ConfiguredFeeds())
+
+	allNews, err := manager.GetNewsFromAllSources(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("GetNewsFromAllSources() error = %v", err)
+	}
+	if len(allNews["coindesk"]) != 3 || len(allNews["cointelegraph"]) != 2 {
+		t.Errorf("期望每个订阅源作为独立的数据源，实际 %d / %d", len(allNews["coindesk"]), len(allNews["cointelegraph"]))
+	}
+
+	// 配置中的常见新闻源使用内置展示名称
+	if source := allNews["coindesk"][0].Source; source != "CoinDesk" {
+		t.Errorf("期望新闻来源为 CoinDesk，实际 %s", source)
+	}
+	if name := FeedDisplayName("mynews"); name != "mynews" {
+		t.Errorf("期望未知新闻源使用名称作为展示名称，实际 %s", name)
+	}
+
+	if _, err := config.ParseNewsFeeds("coindesk"); err == nil {
+		t.Error("期望缺少订阅地址时报错")
+	}
+	if _, err := config.ParseNewsFeeds("a=http://x,a=http://y"); err == nil {
+		t.Error("期望重复名称报错")
+	}
+}
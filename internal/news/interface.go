@@ -15,6 +15,8 @@ type NewsItem struct {
 	PublishedAt time.Time `json:"published_at"` // 发布时间
 	Tags        []string  `json:"tags"`         // 标签列表
 	ImageURL    string    `json:"image_url"`    // 图片链接
+	Sentiment   float64   `json:"sentiment"`    // 情绪分值，范围 [-1, 1]，正数偏利好
+	Symbols     []string  `json:"symbols"`      // 新闻提及的币种代码，如 BTC、ETH
 }
 
 // NewsSource 新闻源接口规范
@@ -9,13 +9,37 @@ import (
 // Manager 新闻管理器实现
 type Manager struct {
 	sources map[string]NewsSource
+	scorer  *SentimentScorer
 	mutex   sync.RWMutex
 }
 
-// NewManager 创建新的新闻管理器
+// NewManager 创建新的新闻管理器，使用配置的情绪词典为新闻打分
 func NewManager() *Manager {
 	return &Manager{
 		sources: make(map[string]NewsSource),
+		scorer:  NewSentimentScorer(ConfiguredLexicon()),
+	}
+}
+
+// SetLexicon 替换情绪词典
+func (m *Manager) SetLexicon(lexicon *Lexicon) {
+	scorer := NewSentimentScorer(lexicon)
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.scorer = scorer
+}
+
+// analyze 为新闻计算情绪分值并提取提及的币种
+func (m *Manager) analyze(items []NewsItem) {
+	m.mutex.RLock()
+	scorer := m.scorer
+	m.mutex.RUnlock()
+
+	for i := range items {
+		items[i].Sentiment = scorer.Score(items[i].Title, items[i].Content)
+		items[i].Symbols = MergeSymbols(items[i].Symbols, ExtractSymbols(items[i].Title, items[i].Content))
 	}
 }
 
@@ -27,6 +51,13 @@ func (m *Manager) RegisterSource(source NewsSource) {
 	m.sources[source.GetName()] = source
 }
 
+// RegisterFeeds 注册 RSS/Atom 新闻源，每个订阅源以其名称作为 DSL 数据源名
+func (m *Manager) RegisterFeeds(feeds []FeedConfig) {
+	for _, feed := range feeds {
+		m.RegisterSource(NewFeed(feed))
+	}
+}
+
 // GetSource 根据名称获取新闻源
 func (m *Manager) GetSource(name string) (NewsSource, bool) {
 	m.mutex.RLock()
@@ -56,7 +87,13 @@ func (m *Manager) GetNewsFromSource(ctx context.Context, sourceName string, coun
 		return nil, fmt.Errorf("新闻源 '%s' 不存在", sourceName)
 	}
 
-	return source.GetNews(ctx, count)
+	items, err := source.GetNews(ctx, count)
+	if err != nil {
+		return nil, err
+	}
+
+	m.analyze(items)
+	return items, nil
 }
 
 // GetNewsFromAllSources 从所有新闻源获取新闻
@@ -78,6 +115,7 @@ func (m *Manager) GetNewsFromAllSources(ctx context.Context, count int) (map[str
 				fmt.Printf("从新闻源 '%s' 获取新闻失败: %v\n", sourceName, err)
 				return
 			}
+			m.analyze(news)
 
 			mutex.Lock()
 			results[sourceName] = news
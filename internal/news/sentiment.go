@@ -0,0 +1,271 @@
+package news
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/lemconn/foxflow/internal/config"
+)
+
+const (
+	// titleWeight 标题中情绪词的权重（相对内容）
+	titleWeight = 2.0
+
+	// negationWindow 英文否定词向前检查的单词数
+	negationWindow = 3
+)
+
+//go:embed lexicon.json
+var defaultLexiconData []byte
+
+// Lexicon 情绪词典，词条权重为正数，否定词会反转其后情绪词的方向
+type Lexicon struct {
+	Positive map[string]float64 `json:"positive"`
+	Negative map[string]float64 `json:"negative"`
+	Negators []string           `json:"negators"`
+}
+
+// lexiconTerm 归一化后的情绪词条
+type lexiconTerm struct {
+	text   string
+	weight float64 // 正面为正数，负面为负数
+	ascii  bool
+}
+
+// SentimentScorer 基于中英文词典的离线情绪打分器
+type SentimentScorer struct {
+	terms         []lexiconTerm
+	asciiNegators []string
+	cjkNegators   []string
+}
+
+// DefaultLexicon 内置的中英文情绪词典
+func DefaultLexicon() *Lexicon {
+	lexicon := &Lexicon{}
+	if err := json.Unmarshal(defaultLexiconData, lexicon); err != nil {
+		panic(fmt.Sprintf("invalid default sentiment lexicon: %v", err))
+	}
+	return lexicon
+}
+
+// LoadLexicon 读取自定义词典文件并合并到内置词典，权重为 0 的词条会从内置词典中移除
+func LoadLexicon(path string) (*Lexicon, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("读取情绪词典失败: %w", err)
+	}
+
+	custom := &Lexicon{}
+	if err := json.Unmarshal(data, custom); err != nil {
+		return nil, fmt.Errorf("解析情绪词典失败: %w", err)
+	}
+
+	lexicon := DefaultLexicon()
+	lexicon.Merge(custom)
+	return lexicon, nil
+}
+
+// ConfiguredLexicon 获取配置的情绪词典，未配置或加载失败时使用内置词典
+func ConfiguredLexicon() *Lexicon {
+	if config.GlobalConfig == nil || config.GlobalConfig.NewsSentimentLexicon == "" {
+		return DefaultLexicon()
+	}
+
+	lexicon, err := LoadLexicon(config.GlobalConfig.NewsSentimentLexicon)
+	if err != nil {
+		log.Printf("加载情绪词典失败，使用内置词典: %v", err)
+		return DefaultLexicon()
+	}
+	return lexicon
+}
+
+// Merge 合并词典，同一词条以 other 为准，权重为 0 表示移除
+func (l *Lexicon) Merge(other *Lexicon) {
+	merge := func(dst map[string]float64, src map[string]float64, opposite map[string]float64) map[string]float64 {
+		if dst == nil {
+			dst = make(map[string]float64)
+		}
+		for term, weight := range src {
+			term = strings.ToLower(strings.TrimSpace(term))
+			delete(opposite, term)
+			if weight == 0 {
+				delete(dst, term)
+				continue
+			}
+			dst[term] = weight
+		}
+		return dst
+	}
+
+	l.Positive = merge(l.Positive, other.Positive, l.Negative)
+	l.Negative = merge(l.Negative, other.Negative, l.Positive)
+	l.Negators = append(l.Negators, other.Negators...)
+}
+
+// NewSentimentScorer 使用词典创建情绪打分器
+func NewSentimentScorer(lexicon *Lexicon) *SentimentScorer {
+	scorer := &SentimentScorer{}
+
+	add := func(terms map[string]float64, sign float64) {
+		for term, weight := range terms {
+			term = strings.ToLower(strings.TrimSpace(term))
+			if term == "" || weight <= 0 {
+				continue
+			}
+			scorer.terms = append(scorer.terms, lexiconTerm{text: term, weight: sign * weight, ascii: isASCII(term)})
+		}
+	}
+	add(lexicon.Positive, 1)
+	add(lexicon.Negative, -1)
+
+	// 优先匹配较长的词条，如 "净流入" 优先于 "流入"
+	sort.Slice(scorer.terms, func(i, j int) bool {
+		if len(scorer.terms[i].text) != len(scorer.terms[j].text) {
+			return len(scorer.terms[i].text) > len(scorer.terms[j].text)
+		}
+		return scorer.terms[i].text < scorer.terms[j].text
+	})
+
+	for _, negator := range lexicon.Negators {
+		negator = strings.ToLower(strings.TrimSpace(negator))
+		if negator == "" {
+			continue
+		}
+		if isASCII(negator) {
+			scorer.asciiNegators = append(scorer.asciiNegators, negator)
+		} else {
+			scorer.cjkNegators = append(scorer.cjkNegators, negator)
+		}
+	}
+
+	return scorer
+}
+
+// Score 计算新闻情绪分值，范围 [-1, 1]，正数偏利好，负数偏利空，无情绪词时为 0
+// 标题中的情绪词权重为内容的两倍
+func (s *SentimentScorer) Score(title, content string) float64 {
+	titlePositive, titleNegative := s.sum(title)
+	contentPositive, contentNegative := s.sum(content)
+
+	positive := titleWeight*titlePositive + contentPositive
+	negative := titleWeight*titleNegative + contentNegative
+	if positive+negative == 0 {
+		return 0
+	}
+	return (positive - negative) / (positive + negative)
+}
+
+// sum 统计文本中正面和负面情绪词的权重之和
+func (s *SentimentScorer) sum(text string) (positive, negative float64) {
+	text = strings.ToLower(text)
+	if text == "" {
+		return 0, 0
+	}
+
+	covered := make([]bool, len(text))
+	for _, term := range s.terms {
+		for offset := 0; offset < len(text); {
+			index := strings.Index(text[offset:], term.text)
+			if index < 0 {
+				break
+			}
+			start := offset + index
+			end := start + len(term.text)
+			offset = start + 1
+
+			if term.ascii && !isWordBoundary(text, start, end) {
+				continue
+			}
+			if isCovered(covered, start, end) {
+				continue
+			}
+			for i := start; i < end; i++ {
+				covered[i] = true
+			}
+
+			weight := term.weight
+			if s.negated(text[:start], term.ascii) {
+				weight = -weight
+			}
+			if weight > 0 {
+				positive += weight
+			} else {
+				negative -= weight
+			}
+		}
+	}
+
+	return positive, negative
+}
+
+// negated 判断情绪词前是否有否定词
+// 中文否定词需紧邻情绪词（如 "未获批"），英文否定词在前 3 个单词内（如 "not yet approved"）
+func (s *SentimentScorer) negated(prefix string, ascii bool) bool {
+	for _, negator := range s.cjkNegators {
+		if strings.HasSuffix(prefix, negator) {
+			return true
+		}
+	}
+
+	if !ascii {
+		return false
+	}
+
+	words := strings.FieldsFunc(prefix, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+	if len(words) > negationWindow {
+		words = words[len(words)-negationWindow:]
+	}
+	window := " " + strings.Join(words, " ") + " "
+	for _, negator := range s.asciiNegators {
+		if strings.Contains(window, " "+negator+" ") {
+			return true
+		}
+	}
+	return false
+}
+
+// isWordBoundary 判断英文词条两端是否为单词边界，避免 "ban" 匹配 "bank"
+// 只有英文字母和数字视为单词字符，中英文混排（如 "比特币ETF获批"）时仍可匹配
+func isWordBoundary(text string, start, end int) bool {
+	if start > 0 && isASCIIWordChar(text[start-1]) {
+		return false
+	}
+	if end < len(text) && isASCIIWordChar(text[end]) {
+		return false
+	}
+	return true
+}
+
+// isASCIIWordChar 判断字节是否为英文字母或数字
+func isASCIIWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// isCovered 判断区间是否与已匹配的词条重叠
+func isCovered(covered []bool, start, end int) bool {
+	for i := start; i < end; i++ {
+		if covered[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// isASCII 判断字符串是否只包含 ASCII 字符
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,80 @@
+package news
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lemconn/foxflow/internal/config"
+)
+
+func TestSentimentScorer_Score(t *testing.T) {
+	scorer := NewSentimentScorer(DefaultLexicon())
+
+	tests := []struct {
+		name    string
+		title   string
+		content string
+		check   func(score float64) bool
+	}{
+		{name: "英文利好", title: "SEC approves spot bitcoin ETF", check: func(s float64) bool { return s == 1 }},
+		{name: "中文利好", title: "比特币现货ETF获批，资金净流入创新高", check: func(s float64) bool { return s == 1 }},
+		{name: "英文利空", title: "Exchange hacked, hot wallet drained", check: func(s float64) bool { return s == -1 }},
+		{name: "中文利空", title: "某交易所遭黑客攻击，用户资产被盗", check: func(s float64) bool { return s == -1 }},
+		{name: "英文否定", title: "SEC has not yet approved the ETF", check: func(s float64) bool { return s == -1 }},
+		{name: "中文否定", title: "以太坊ETF尚未获批", check: func(s float64) bool { return s == -1 }},
+		{name: "中文非否定", title: "比特币不断上涨", check: func(s float64) bool { return s == 1 }},
+		{name: "无情绪词", title: "Weekly market recap", content: "比特币本周行情回顾", check: func(s float64) bool { return s == 0 }},
+		{name: "单词边界", title: "Central bank publishes report", check: func(s float64) bool { return s == 0 }},
+		{name: "标题权重高于内容", title: "Bitcoin surges", content: "Miners sold reserves", check: func(s float64) bool { return s > 0.3 && s < 1 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := scorer.Score(tt.title, tt.content)
+			if !tt.check(score) {
+				t.Errorf("Score(%q, %q) = %v", tt.title, tt.content, score)
+			}
+		})
+	}
+}
+
+func TestLoadLexicon(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lexicon.json")
+	data := `{"positive": {"moon": 1, "Hack": 1}, "negative": {"surge": 0}, "negators": ["hardly"]}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("写入词典失败: %v", err)
+	}
+
+	lexicon, err := LoadLexicon(path)
+	if err != nil {
+		t.Fatalf("LoadLexicon() error = %v", err)
+	}
+	scorer := NewSentimentScorer(lexicon)
+
+	// 自定义词条与内置词典合并，同名词条以自定义为准
+	if score := scorer.Score("Token to the moon", ""); score != 1 {
+		t.Errorf("期望自定义正面词生效，实际 %v", score)
+	}
+	if score := scorer.Score("Protocol hack", ""); score != 1 {
+		t.Errorf("期望自定义词典覆盖内置词条方向，实际 %v", score)
+	}
+	if score := scorer.Score("Price hardly surges", ""); score != -1 {
+		t.Errorf("期望自定义否定词生效，实际 %v", score)
+	}
+	if score := scorer.Score("比特币大涨", ""); score != 1 {
+		t.Errorf("期望保留内置词条，实际 %v", score)
+	}
+
+	if _, err := LoadLexicon(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("期望词典文件不存在时报错")
+	}
+
+	// 配置的词典加载失败时回退到内置词典
+	original := config.GlobalConfig
+	config.GlobalConfig = &config.Config{NewsSentimentLexicon: path}
+	defer func() { config.GlobalConfig = original }()
+	if score := NewSentimentScorer(ConfiguredLexicon()).Score("to the moon", ""); score != 1 {
+		t.Errorf("期望使用配置的词典，实际 %v", score)
+	}
+}
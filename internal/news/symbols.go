@@ -0,0 +1,138 @@
+package news
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// symbolAliases 常见币种及其中英文名称，用于从新闻中识别提及的币种
+var symbolAliases = map[string][]string{
+	"BTC":  {"bitcoin", "比特币"},
+	"ETH":  {"ethereum", "ether", "以太坊", "以太币"},
+	"SOL":  {"solana"},
+	"BNB":  {"binance coin", "币安币"},
+	"XRP":  {"瑞波币"},
+	"DOGE": {"dogecoin", "狗狗币"},
+	"ADA":  {"cardano", "艾达币"},
+	"TRX":  {"tron", "波场"},
+	"TON":  {"toncoin"},
+	"AVAX": {},
+	"DOT":  {"polkadot", "波卡"},
+	"LINK": {"chainlink"},
+	"LTC":  {"litecoin", "莱特币"},
+	"BCH":  {"bitcoin cash", "比特币现金"},
+	"SHIB": {"shiba inu", "柴犬币"},
+	"PEPE": {},
+	"UNI":  {"uniswap"},
+	"SUI":  {},
+	"APT":  {"aptos"},
+	"ARB":  {"arbitrum"},
+	"OP":   {},
+	"NEAR": {"near protocol"},
+	"ATOM": {"cosmos"},
+	"FIL":  {"filecoin"},
+	"ETC":  {"ethereum classic", "以太经典"},
+	"USDT": {"tether", "泰达币"},
+	"USDC": {"usd coin"},
+}
+
+var (
+	// cashtagPattern $BTC 形式的币种标记
+	cashtagPattern = regexp.MustCompile(`\$([A-Za-z][A-Za-z0-9]{1,9})\b`)
+
+	// tickerPattern 大写的币种代码，如 BTC、ETH（仅识别已知币种，避免误识别 SEC、ETF 等缩写）
+	tickerPattern = regexp.MustCompile(`[A-Z][A-Z0-9]{1,9}`)
+)
+
+// ExtractSymbols 从新闻文本中提取提及的币种代码（大写、去重、按字母排序）
+// 支持 $BTC 标记、已知币种代码（如 BTC、BTC-USDT 中的 BTC）以及中英文名称（如 bitcoin、比特币）
+func ExtractSymbols(texts ...string) []string {
+	found := make(map[string]struct{})
+
+	for _, text := range texts {
+		for _, match := range cashtagPattern.FindAllStringSubmatch(text, -1) {
+			found[strings.ToUpper(match[1])] = struct{}{}
+		}
+
+		for _, loc := range tickerPattern.FindAllStringIndex(text, -1) {
+			if !isWordBoundary(text, loc[0], loc[1]) {
+				continue
+			}
+			if _, known := symbolAliases[text[loc[0]:loc[1]]]; known {
+				found[text[loc[0]:loc[1]]] = struct{}{}
+			}
+		}
+
+		lower := strings.ToLower(text)
+		for symbol, aliases := range symbolAliases {
+			for _, alias := range aliases {
+				if containsTerm(lower, alias) {
+					found[symbol] = struct{}{}
+					break
+				}
+			}
+		}
+	}
+
+	return sortedSymbols(found)
+}
+
+// ParseSymbols 解析以逗号、空格等分隔的币种列表，如 BlockBeats 的 crypto_token 字段
+func ParseSymbols(value string) []string {
+	found := make(map[string]struct{})
+	for _, symbol := range strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ';' || r == '|' || r == '/' || r == ' ' || r == '，'
+	}) {
+		symbol = strings.ToUpper(strings.TrimPrefix(strings.TrimSpace(symbol), "$"))
+		if symbol != "" {
+			found[symbol] = struct{}{}
+		}
+	}
+	return sortedSymbols(found)
+}
+
+// MergeSymbols 合并多个币种列表（去重、按字母排序）
+func MergeSymbols(lists ...[]string) []string {
+	found := make(map[string]struct{})
+	for _, list := range lists {
+		for _, symbol := range list {
+			found[strings.ToUpper(symbol)] = struct{}{}
+		}
+	}
+	return sortedSymbols(found)
+}
+
+// containsTerm 判断小写文本中是否包含词条，英文词条需满足单词边界
+func containsTerm(text, term string) bool {
+	if !isASCII(term) {
+		return strings.Contains(text, term)
+	}
+
+	for offset := 0; offset < len(text); {
+		index := strings.Index(text[offset:], term)
+		if index < 0 {
+			return false
+		}
+		start := offset + index
+		if isWordBoundary(text, start, start+len(term)) {
+			return true
+		}
+		offset = start + 1
+	}
+	return false
+}
+
+// sortedSymbols 将币种集合转换为有序列表
+func sortedSymbols(found map[string]struct{}) []string {
+	if len(found) == 0 {
+		return nil
+	}
+
+	symbols := make([]string, 0, len(found))
+	for symbol := range found {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
@@ -0,0 +1,79 @@
+package news
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestExtractSymbols(t *testing.T) {
+	tests := []struct {
+		texts []string
+		want  string
+	}{
+		{texts: []string{"SEC approves spot Bitcoin ETF"}, want: "BTC"},
+		{texts: []string{"比特币和以太坊同步上涨"}, want: "BTC,ETH"},
+		{texts: []string{"Whale buys $pepe and $SOL", "BTC-USDT perpetual"}, want: "BTC,PEPE,SOL,USDT"},
+		{texts: []string{"SEC delays ETF decision"}, want: ""},
+		{texts: []string{"Tether mints USDT on Tron"}, want: "TRX,USDT"},
+		{texts: []string{"electronic bank LINKED"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		got := strings.Join(ExtractSymbols(tt.texts...), ",")
+		if got != tt.want {
+			t.Errorf("ExtractSymbols(%q) = %q, want %q", tt.texts, got, tt.want)
+		}
+	}
+}
+
+func TestParseSymbols(t *testing.T) {
+	if got := strings.Join(ParseSymbols("btc, $ETH|sol，BTC"), ","); got != "BTC,ETH,SOL" {
+		t.Errorf("ParseSymbols() = %q", got)
+	}
+	if got := ParseSymbols(""); got != nil {
+		t.Errorf("ParseSymbols(\"\") = %v, want nil", got)
+	}
+}
+
+// staticNewsSource 返回固定新闻的新闻源
+type staticNewsSource struct {
+	items []NewsItem
+}
+
+func (s *staticNewsSource) GetName() string        { return "static" }
+func (s *staticNewsSource) GetDisplayName() string { return "Static" }
+func (s *staticNewsSource) IsHealthy(ctx context.Context) bool {
+	return true
+}
+func (s *staticNewsSource) GetNews(ctx context.Context, count int) ([]NewsItem, error) {
+	items := make([]NewsItem, len(s.items))
+	copy(items, s.items)
+	return items, nil
+}
+
+func TestManager_Analyze(t *testing.T) {
+	manager := NewManager()
+	manager.RegisterSource(&staticNewsSource{items: []NewsItem{
+		{ID: "1", Title: "以太坊ETF获批", Symbols: []string{"ETH", "LDO"}},
+		{ID: "2", Title: "Solana network outage, SOL plunges"},
+	}})
+
+	items, err := manager.GetNewsFromSource(context.Background(), "static", 10)
+	if err != nil {
+		t.Fatalf("GetNewsFromSource() error = %v", err)
+	}
+
+	// 新闻源提供的币种与文本中识别的币种合并
+	if items[0].Sentiment != 1 || strings.Join(items[0].Symbols, ",") != "ETH,LDO" {
+		t.Errorf("新闻分析错误: sentiment=%v symbols=%v", items[0].Sentiment, items[0].Symbols)
+	}
+	if items[1].Sentiment != -1 || strings.Join(items[1].Symbols, ",") != "SOL" {
+		t.Errorf("新闻分析错误: sentiment=%v symbols=%v", items[1].Sentiment, items[1].Symbols)
+	}
+
+	all, err := manager.GetNewsFromAllSources(context.Background(), 10)
+	if err != nil || all["static"][1].Sentiment != -1 {
+		t.Errorf("GetNewsFromAllSources() 未计算情绪分值: %v, %v", all, err)
+	}
+}
@@ -12,25 +12,40 @@ const TableNameFoxOrder = "fox_orders"
 
 // FoxOrder mapped from table <fox_orders>
 type FoxOrder struct {
-	ID         int64      `gorm:"column:id;type:integer;primaryKey" json:"id"`
-	Exchange   string     `gorm:"column:exchange;type:text;not null;default:okx" json:"exchange"`
-	AccountID  int64      `gorm:"column:account_id;type:integer;not null" json:"account_id"`
-	Symbol     string     `gorm:"column:symbol;type:text;not null" json:"symbol"`
-	Side       string     `gorm:"column:side;type:text;not null" json:"side"`
-	PosSide    string     `gorm:"column:pos_side;type:text;not null" json:"pos_side"`
-	MarginType string     `gorm:"column:margin_type;type:text;not null" json:"margin_type"`
-	Price      string     `gorm:"column:price;type:text;not null" json:"price"`
-	Size       string     `gorm:"column:size;type:text;not null" json:"size"`
-	SizeType   string     `gorm:"column:size_type;type:text;not null" json:"size_type"`
-	OrderType  string     `gorm:"column:order_type;type:text;not null;default:limit" json:"order_type"`
-	Strategy   string     `gorm:"column:strategy;type:text;not null" json:"strategy"`
-	OrderID    string     `gorm:"column:order_id;type:text;not null" json:"order_id"`
-	Type       string     `gorm:"column:type;type:text;not null;default:open" json:"type"`
-	Status     string     `gorm:"column:status;type:text;not null;default:waiting" json:"status"`
-	Msg        string     `gorm:"column:msg;type:text;not null" json:"msg"`
-	CreatedAt  time.Time  `gorm:"column:created_at;type:datetime" json:"created_at"`
-	UpdatedAt  time.Time  `gorm:"column:updated_at;type:datetime" json:"updated_at"`
-	Account    FoxAccount `gorm:"foreignKey:id;references:account_id" json:"account"`
+	ID            int64      `gorm:"column:id;type:integer;primaryKey" json:"id"`
+	Exchange      string     `gorm:"column:exchange;type:text;not null;default:okx" json:"exchange"`
+	AccountID     int64      `gorm:"column:account_id;type:integer;not null" json:"account_id"`
+	Symbol        string     `gorm:"column:symbol;type:text;not null" json:"symbol"`
+	Side          string     `gorm:"column:side;type:text;not null" json:"side"`
+	PosSide       string     `gorm:"column:pos_side;type:text;not null" json:"pos_side"`
+	MarginType    string     `gorm:"column:margin_type;type:text;not null" json:"margin_type"`
+	Price         string     `gorm:"column:price;type:text;not null" json:"price"`
+	Size          string     `gorm:"column:size;type:text;not null" json:"size"`
+	SizeType      string     `gorm:"column:size_type;type:text;not null" json:"size_type"`
+	OrderType     string     `gorm:"column:order_type;type:text;not null;default:limit" json:"order_type"`
+	Strategy      string     `gorm:"column:strategy;type:text;not null" json:"strategy"`
+	OrderID       string     `gorm:"column:order_id;type:text;not null" json:"order_id"`
+	Type          string     `gorm:"column:type;type:text;not null;default:open" json:"type"`
+	Status        string     `gorm:"column:status;type:text;not null;default:waiting" json:"status"`
+	Msg           string     `gorm:"column:msg;type:text;not null" json:"msg"`
+	StrategyState string     `gorm:"column:strategy_state;type:text;not null" json:"strategy_state"`
+	ExpireAt      int64      `gorm:"column:expire_at;type:integer;not null" json:"expire_at"`
+	ParentID      int64      `gorm:"column:parent_id;type:integer;not null" json:"parent_id"`
+	PriceExpr     string     `gorm:"column:price_expr;type:text;not null" json:"price_expr"`
+	Algo          string     `gorm:"column:algo;type:text;not null" json:"algo"`
+	AlgoDuration  int64      `gorm:"column:algo_duration;type:integer;not null" json:"algo_duration"`
+	AlgoSlices    int64      `gorm:"column:algo_slices;type:integer;not null" json:"algo_slices"`
+	AlgoVisible   string     `gorm:"column:algo_visible;type:text;not null" json:"algo_visible"`
+	AlgoTotal     string     `gorm:"column:algo_total;type:text;not null" json:"algo_total"`
+	AlgoFilled    string     `gorm:"column:algo_filled;type:text;not null" json:"algo_filled"`
+	Chase         string     `gorm:"column:chase;type:text;not null" json:"chase"`
+	MaxSlippage   string     `gorm:"column:max_slippage;type:text;not null" json:"max_slippage"`
+	ChaseBase     string     `gorm:"column:chase_base;type:text;not null" json:"chase_base"`
+	ChaseFilled   string     `gorm:"column:chase_filled;type:text;not null" json:"chase_filled"`
+	ReduceOnly    int64      `gorm:"column:reduce_only;type:integer;not null" json:"reduce_only"`
+	CreatedAt     time.Time  `gorm:"column:created_at;type:datetime" json:"created_at"`
+	UpdatedAt     time.Time  `gorm:"column:updated_at;type:datetime" json:"updated_at"`
+	Account       FoxAccount `gorm:"foreignKey:id;references:account_id" json:"account"`
 }
 
 // TableName FoxOrder's table name
@@ -44,6 +44,21 @@ func newFoxOrder(db *gorm.DB, opts ...gen.DOOption) foxOrder {
 	_foxOrder.Type = field.NewString(tableName, "type")
 	_foxOrder.Status = field.NewString(tableName, "status")
 	_foxOrder.Msg = field.NewString(tableName, "msg")
+	_foxOrder.StrategyState = field.NewString(tableName, "strategy_state")
+	_foxOrder.ExpireAt = field.NewInt64(tableName, "expire_at")
+	_foxOrder.ParentID = field.NewInt64(tableName, "parent_id")
+	_foxOrder.PriceExpr = field.NewString(tableName, "price_expr")
+	_foxOrder.Algo = field.NewString(tableName, "algo")
+	_foxOrder.AlgoDuration = field.NewInt64(tableName, "algo_duration")
+	_foxOrder.AlgoSlices = field.NewInt64(tableName, "algo_slices")
+	_foxOrder.AlgoVisible = field.NewString(tableName, "algo_visible")
+	_foxOrder.AlgoTotal = field.NewString(tableName, "algo_total")
+	_foxOrder.AlgoFilled = field.NewString(tableName, "algo_filled")
+	_foxOrder.Chase = field.NewString(tableName, "chase")
+	_foxOrder.MaxSlippage = field.NewString(tableName, "max_slippage")
+	_foxOrder.ChaseBase = field.NewString(tableName, "chase_base")
+	_foxOrder.ChaseFilled = field.NewString(tableName, "chase_filled")
+	_foxOrder.ReduceOnly = field.NewInt64(tableName, "reduce_only")
 	_foxOrder.CreatedAt = field.NewTime(tableName, "created_at")
 	_foxOrder.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_foxOrder.Account = foxOrderBelongsToAccount{
@@ -70,26 +85,41 @@ func newFoxOrder(db *gorm.DB, opts ...gen.DOOption) foxOrder {
 type foxOrder struct {
 	foxOrderDo
 
-	ALL        field.Asterisk
-	ID         field.Int64
-	Exchange   field.String
-	AccountID  field.Int64
-	Symbol     field.String
-	Side       field.String
-	PosSide    field.String
-	MarginType field.String
-	Price      field.String
-	Size       field.String
-	SizeType   field.String
-	OrderType  field.String
-	Strategy   field.String
-	OrderID    field.String
-	Type       field.String
-	Status     field.String
-	Msg        field.String
-	CreatedAt  field.Time
-	UpdatedAt  field.Time
-	Account    foxOrderBelongsToAccount
+	ALL           field.Asterisk
+	ID            field.Int64
+	Exchange      field.String
+	AccountID     field.Int64
+	Symbol        field.String
+	Side          field.String
+	PosSide       field.String
+	MarginType    field.String
+	Price         field.String
+	Size          field.String
+	SizeType      field.String
+	OrderType     field.String
+	Strategy      field.String
+	OrderID       field.String
+	Type          field.String
+	Status        field.String
+	Msg           field.String
+	StrategyState field.String
+	ExpireAt      field.Int64
+	ParentID      field.Int64
+	PriceExpr     field.String
+	Algo          field.String
+	AlgoDuration  field.Int64
+	AlgoSlices    field.Int64
+	AlgoVisible   field.String
+	AlgoTotal     field.String
+	AlgoFilled    field.String
+	Chase         field.String
+	MaxSlippage   field.String
+	ChaseBase     field.String
+	ChaseFilled   field.String
+	ReduceOnly    field.Int64
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
+	Account       foxOrderBelongsToAccount
 
 	fieldMap map[string]field.Expr
 }
@@ -122,6 +152,21 @@ func (f *foxOrder) updateTableName(table string) *foxOrder {
 	f.Type = field.NewString(table, "type")
 	f.Status = field.NewString(table, "status")
 	f.Msg = field.NewString(table, "msg")
+	f.StrategyState = field.NewString(table, "strategy_state")
+	f.ExpireAt = field.NewInt64(table, "expire_at")
+	f.ParentID = field.NewInt64(table, "parent_id")
+	f.PriceExpr = field.NewString(table, "price_expr")
+	f.Algo = field.NewString(table, "algo")
+	f.AlgoDuration = field.NewInt64(table, "algo_duration")
+	f.AlgoSlices = field.NewInt64(table, "algo_slices")
+	f.AlgoVisible = field.NewString(table, "algo_visible")
+	f.AlgoTotal = field.NewString(table, "algo_total")
+	f.AlgoFilled = field.NewString(table, "algo_filled")
+	f.Chase = field.NewString(table, "chase")
+	f.MaxSlippage = field.NewString(table, "max_slippage")
+	f.ChaseBase = field.NewString(table, "chase_base")
+	f.ChaseFilled = field.NewString(table, "chase_filled")
+	f.ReduceOnly = field.NewInt64(table, "reduce_only")
 	f.CreatedAt = field.NewTime(table, "created_at")
 	f.UpdatedAt = field.NewTime(table, "updated_at")
 
@@ -140,7 +185,7 @@ func (f *foxOrder) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (f *foxOrder) fillFieldMap() {
-	f.fieldMap = make(map[string]field.Expr, 19)
+	f.fieldMap = make(map[string]field.Expr, 34)
 	f.fieldMap["id"] = f.ID
 	f.fieldMap["exchange"] = f.Exchange
 	f.fieldMap["account_id"] = f.AccountID
@@ -157,6 +202,21 @@ func (f *foxOrder) fillFieldMap() {
 	f.fieldMap["type"] = f.Type
 	f.fieldMap["status"] = f.Status
 	f.fieldMap["msg"] = f.Msg
+	f.fieldMap["strategy_state"] = f.StrategyState
+	f.fieldMap["expire_at"] = f.ExpireAt
+	f.fieldMap["parent_id"] = f.ParentID
+	f.fieldMap["price_expr"] = f.PriceExpr
+	f.fieldMap["algo"] = f.Algo
+	f.fieldMap["algo_duration"] = f.AlgoDuration
+	f.fieldMap["algo_slices"] = f.AlgoSlices
+	f.fieldMap["algo_visible"] = f.AlgoVisible
+	f.fieldMap["algo_total"] = f.AlgoTotal
+	f.fieldMap["algo_filled"] = f.AlgoFilled
+	f.fieldMap["chase"] = f.Chase
+	f.fieldMap["max_slippage"] = f.MaxSlippage
+	f.fieldMap["chase_base"] = f.ChaseBase
+	f.fieldMap["chase_filled"] = f.ChaseFilled
+	f.fieldMap["reduce_only"] = f.ReduceOnly
 	f.fieldMap["created_at"] = f.CreatedAt
 	f.fieldMap["updated_at"] = f.UpdatedAt
 
@@ -16,23 +16,39 @@ import (
 )
 
 var (
-	Q              = new(Query)
-	FoxAccount     *foxAccount
-	FoxConfig      *foxConfig
-	FoxExchange    *foxExchange
-	FoxOrder       *foxOrder
-	FoxSymbol      *foxSymbol
-	FoxTradeConfig *foxTradeConfig
-	SqliteSequence *sqliteSequence
-	SystemInfo     *systemInfo
+	Q               = new(Query)
+	FoxAccount      *foxAccount
+	FoxAlgoSlice    *foxAlgoSlice
+	FoxConfig       *foxConfig
+	FoxDcaPlan      *foxDcaPlan
+	FoxEnginePause  *foxEnginePause
+	FoxExchange     *foxExchange
+	FoxKillSwitch   *foxKillSwitch
+	FoxNews         *foxNews
+	FoxNewsFeed     *foxNewsFeed
+	FoxOrder        *foxOrder
+	FoxOrderHistory *foxOrderHistory
+	FoxRiskRule     *foxRiskRule
+	FoxSymbol       *foxSymbol
+	FoxTradeConfig  *foxTradeConfig
+	SqliteSequence  *sqliteSequence
+	SystemInfo      *systemInfo
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	FoxAccount = &Q.FoxAccount
+	FoxAlgoSlice = &Q.FoxAlgoSlice
 	FoxConfig = &Q.FoxConfig
+	FoxDcaPlan = &Q.FoxDcaPlan
+	FoxEnginePause = &Q.FoxEnginePause
 	FoxExchange = &Q.FoxExchange
+	FoxKillSwitch = &Q.FoxKillSwitch
+	FoxNews = &Q.FoxNews
+	FoxNewsFeed = &Q.FoxNewsFeed
 	FoxOrder = &Q.FoxOrder
+	FoxOrderHistory = &Q.FoxOrderHistory
+	FoxRiskRule = &Q.FoxRiskRule
 	FoxSymbol = &Q.FoxSymbol
 	FoxTradeConfig = &Q.FoxTradeConfig
 	SqliteSequence = &Q.SqliteSequence
@@ -41,44 +57,68 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:             db,
-		FoxAccount:     newFoxAccount(db, opts...),
-		FoxConfig:      newFoxConfig(db, opts...),
-		FoxExchange:    newFoxExchange(db, opts...),
-		FoxOrder:       newFoxOrder(db, opts...),
-		FoxSymbol:      newFoxSymbol(db, opts...),
-		FoxTradeConfig: newFoxTradeConfig(db, opts...),
-		SqliteSequence: newSqliteSequence(db, opts...),
-		SystemInfo:     newSystemInfo(db, opts...),
+		db:              db,
+		FoxAccount:      newFoxAccount(db, opts...),
+		FoxAlgoSlice:    newFoxAlgoSlice(db, opts...),
+		FoxConfig:       newFoxConfig(db, opts...),
+		FoxDcaPlan:      newFoxDcaPlan(db, opts...),
+		FoxEnginePause:  newFoxEnginePause(db, opts...),
+		FoxExchange:     newFoxExchange(db, opts...),
+		FoxKillSwitch:   newFoxKillSwitch(db, opts...),
+		FoxNews:         newFoxNews(db, opts...),
+		FoxNewsFeed:     newFoxNewsFeed(db, opts...),
+		FoxOrder:        newFoxOrder(db, opts...),
+		FoxOrderHistory: newFoxOrderHistory(db, opts...),
+		FoxRiskRule:     newFoxRiskRule(db, opts...),
+		FoxSymbol:       newFoxSymbol(db, opts...),
+		FoxTradeConfig:  newFoxTradeConfig(db, opts...),
+		SqliteSequence:  newSqliteSequence(db, opts...),
+		SystemInfo:      newSystemInfo(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	FoxAccount     foxAccount
-	FoxConfig      foxConfig
-	FoxExchange    foxExchange
-	FoxOrder       foxOrder
-	FoxSymbol      foxSymbol
-	FoxTradeConfig foxTradeConfig
-	SqliteSequence sqliteSequence
-	SystemInfo     systemInfo
+	FoxAccount      foxAccount
+	FoxAlgoSlice    foxAlgoSlice
+	FoxConfig       foxConfig
+	FoxDcaPlan      foxDcaPlan
+	FoxEnginePause  foxEnginePause
+	FoxExchange     foxExchange
+	FoxKillSwitch   foxKillSwitch
+	FoxNews         foxNews
+	FoxNewsFeed     foxNewsFeed
+	FoxOrder        foxOrder
+	FoxOrderHistory foxOrderHistory
+	FoxRiskRule     foxRiskRule
+	FoxSymbol       foxSymbol
+	FoxTradeConfig  foxTradeConfig
+	SqliteSequence  sqliteSequence
+	SystemInfo      systemInfo
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:             db,
-		FoxAccount:     q.FoxAccount.clone(db),
-		FoxConfig:      q.FoxConfig.clone(db),
-		FoxExchange:    q.FoxExchange.clone(db),
-		FoxOrder:       q.FoxOrder.clone(db),
-		FoxSymbol:      q.FoxSymbol.clone(db),
-		FoxTradeConfig: q.FoxTradeConfig.clone(db),
-		SqliteSequence: q.SqliteSequence.clone(db),
-		SystemInfo:     q.SystemInfo.clone(db),
+		db:              db,
+		FoxAccount:      q.FoxAccount.clone(db),
+		FoxAlgoSlice:    q.FoxAlgoSlice.clone(db),
+		FoxConfig:       q.FoxConfig.clone(db),
+		FoxDcaPlan:      q.FoxDcaPlan.clone(db),
+		FoxEnginePause:  q.FoxEnginePause.clone(db),
+		FoxExchange:     q.FoxExchange.clone(db),
+		FoxKillSwitch:   q.FoxKillSwitch.clone(db),
+		FoxNews:         q.FoxNews.clone(db),
+		FoxNewsFeed:     q.FoxNewsFeed.clone(db),
+		FoxOrder:        q.FoxOrder.clone(db),
+		FoxOrderHistory: q.FoxOrderHistory.clone(db),
+		FoxRiskRule:     q.FoxRiskRule.clone(db),
+		FoxSymbol:       q.FoxSymbol.clone(db),
+		FoxTradeConfig:  q.FoxTradeConfig.clone(db),
+		SqliteSequence:  q.SqliteSequence.clone(db),
+		SystemInfo:      q.SystemInfo.clone(db),
 	}
 }
 
@@ -92,39 +132,63 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:             db,
-		FoxAccount:     q.FoxAccount.replaceDB(db),
-		FoxConfig:      q.FoxConfig.replaceDB(db),
-		FoxExchange:    q.FoxExchange.replaceDB(db),
-		FoxOrder:       q.FoxOrder.replaceDB(db),
-		FoxSymbol:      q.FoxSymbol.replaceDB(db),
-		FoxTradeConfig: q.FoxTradeConfig.replaceDB(db),
-		SqliteSequence: q.SqliteSequence.replaceDB(db),
-		SystemInfo:     q.SystemInfo.replaceDB(db),
+		db:              db,
+		FoxAccount:      q.FoxAccount.replaceDB(db),
+		FoxAlgoSlice:    q.FoxAlgoSlice.replaceDB(db),
+		FoxConfig:       q.FoxConfig.replaceDB(db),
+		FoxDcaPlan:      q.FoxDcaPlan.replaceDB(db),
+		FoxEnginePause:  q.FoxEnginePause.replaceDB(db),
+		FoxExchange:     q.FoxExchange.replaceDB(db),
+		FoxKillSwitch:   q.FoxKillSwitch.replaceDB(db),
+		FoxNews:         q.FoxNews.replaceDB(db),
+		FoxNewsFeed:     q.FoxNewsFeed.replaceDB(db),
+		FoxOrder:        q.FoxOrder.replaceDB(db),
+		FoxOrderHistory: q.FoxOrderHistory.replaceDB(db),
+		FoxRiskRule:     q.FoxRiskRule.replaceDB(db),
+		FoxSymbol:       q.FoxSymbol.replaceDB(db),
+		FoxTradeConfig:  q.FoxTradeConfig.replaceDB(db),
+		SqliteSequence:  q.SqliteSequence.replaceDB(db),
+		SystemInfo:      q.SystemInfo.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	FoxAccount     IFoxAccountDo
-	FoxConfig      IFoxConfigDo
-	FoxExchange    IFoxExchangeDo
-	FoxOrder       IFoxOrderDo
-	FoxSymbol      IFoxSymbolDo
-	FoxTradeConfig IFoxTradeConfigDo
-	SqliteSequence ISqliteSequenceDo
-	SystemInfo     ISystemInfoDo
+	FoxAccount      IFoxAccountDo
+	FoxAlgoSlice    IFoxAlgoSliceDo
+	FoxConfig       IFoxConfigDo
+	FoxDcaPlan      IFoxDcaPlanDo
+	FoxEnginePause  IFoxEnginePauseDo
+	FoxExchange     IFoxExchangeDo
+	FoxKillSwitch   IFoxKillSwitchDo
+	FoxNews         IFoxNewsDo
+	FoxNewsFeed     IFoxNewsFeedDo
+	FoxOrder        IFoxOrderDo
+	FoxOrderHistory IFoxOrderHistoryDo
+	FoxRiskRule     IFoxRiskRuleDo
+	FoxSymbol       IFoxSymbolDo
+	FoxTradeConfig  IFoxTradeConfigDo
+	SqliteSequence  ISqliteSequenceDo
+	SystemInfo      ISystemInfoDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		FoxAccount:     q.FoxAccount.WithContext(ctx),
-		FoxConfig:      q.FoxConfig.WithContext(ctx),
-		FoxExchange:    q.FoxExchange.WithContext(ctx),
-		FoxOrder:       q.FoxOrder.WithContext(ctx),
-		FoxSymbol:      q.FoxSymbol.WithContext(ctx),
-		FoxTradeConfig: q.FoxTradeConfig.WithContext(ctx),
-		SqliteSequence: q.SqliteSequence.WithContext(ctx),
-		SystemInfo:     q.SystemInfo.WithContext(ctx),
+		FoxAccount:      q.FoxAccount.WithContext(ctx),
+		FoxAlgoSlice:    q.FoxAlgoSlice.WithContext(ctx),
+		FoxConfig:       q.FoxConfig.WithContext(ctx),
+		FoxDcaPlan:      q.FoxDcaPlan.WithContext(ctx),
+		FoxEnginePause:  q.FoxEnginePause.WithContext(ctx),
+		FoxExchange:     q.FoxExchange.WithContext(ctx),
+		FoxKillSwitch:   q.FoxKillSwitch.WithContext(ctx),
+		FoxNews:         q.FoxNews.WithContext(ctx),
+		FoxNewsFeed:     q.FoxNewsFeed.WithContext(ctx),
+		FoxOrder:        q.FoxOrder.WithContext(ctx),
+		FoxOrderHistory: q.FoxOrderHistory.WithContext(ctx),
+		FoxRiskRule:     q.FoxRiskRule.WithContext(ctx),
+		FoxSymbol:       q.FoxSymbol.WithContext(ctx),
+		FoxTradeConfig:  q.FoxTradeConfig.WithContext(ctx),
+		SqliteSequence:  q.SqliteSequence.WithContext(ctx),
+		SystemInfo:      q.SystemInfo.WithContext(ctx),
 	}
 }
 
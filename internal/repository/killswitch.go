@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/lemconn/foxflow/internal/pkg/dao/query"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ActivateKillSwitch 启用紧急停止，并在同一事务中取消等待中及已暂停的策略订单，返回取消的订单数量
+// accountID 为 0 表示所有账户
+func ActivateKillSwitch(accountID int64, reason string) (int64, error) {
+	var cancelled int64
+	err := database.Adapter().Transaction(func(tx *query.Query) error {
+		if err := tx.FoxKillSwitch.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "account_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"reason"}),
+		}).Create(&model.FoxKillSwitch{AccountID: accountID, Reason: reason}); err != nil {
+			return err
+		}
+
+		orders := tx.FoxOrder.Where(tx.FoxOrder.Status.In("pending", "waiting", "paused", "executing"))
+		if accountID > 0 {
+			orders = orders.Where(tx.FoxOrder.AccountID.Eq(accountID))
+		}
+
+		// 执行中算法订单的子订单一并取消（交易所挂单由紧急停止统一撤销）
+		executing := tx.FoxOrder.Select(tx.FoxOrder.ID).Where(tx.FoxOrder.Status.Eq("executing"))
+		if accountID > 0 {
+			executing = executing.Where(tx.FoxOrder.AccountID.Eq(accountID))
+		}
+		if _, err := tx.FoxAlgoSlice.Where(
+			tx.FoxAlgoSlice.Status.In("scheduled", "submitted"),
+			tx.FoxAlgoSlice.Columns(tx.FoxAlgoSlice.OrderID).In(executing),
+		).UpdateSimple(tx.FoxAlgoSlice.Status.Value("cancelled"), tx.FoxAlgoSlice.Msg.Value(reason)); err != nil {
+			return err
+		}
+
+		info, err := orders.UpdateSimple(tx.FoxOrder.Status.Value("cancelled"), tx.FoxOrder.Msg.Value(reason))
+		if err != nil {
+			return err
+		}
+		cancelled = info.RowsAffected
+		return nil
+	})
+	return cancelled, err
+}
+
+// KillSwitchList 获取所有生效中的紧急停止记录
+func KillSwitchList() ([]*model.FoxKillSwitch, error) {
+	list, err := database.Adapter().FoxKillSwitch.Find()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// DeleteKillSwitch 解除紧急停止，accountID 为 0 表示解除所有记录（包括各账户的记录）
+func DeleteKillSwitch(accountID int64) error {
+	q := database.Adapter().FoxKillSwitch
+	if accountID == 0 {
+		_, err := q.Where(q.ID.Gt(0)).Delete()
+		return err
+	}
+
+	_, err := q.Where(q.AccountID.Eq(accountID)).Delete()
+	return err
+}
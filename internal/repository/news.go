@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/news"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ftsMinTermLength trigram 分词可检索的最短关键词长度（字符数）
+const ftsMinTermLength = 3
+
+// NewsFilter 新闻归档检索条件
+type NewsFilter struct {
+	Source  string    // 新闻源名称，为空表示所有新闻源
+	Keyword string    // 关键词，多个关键词以空格分隔，需同时匹配标题或内容
+	Since   time.Time // 仅返回该时间之后发布的新闻
+	Limit   int       // 返回数量上限
+}
+
+// SaveNews 归档新闻源的新闻，按新闻源+新闻 ID 去重，返回新增数量
+func SaveNews(source string, items []news.NewsItem) (int, error) {
+	if len(items) == 0 {
+		return 0, nil
+	}
+	if database.Adapter() == nil {
+		return 0, errors.New("database is not initialized")
+	}
+
+	records := make([]*model.FoxNews, 0, len(items))
+	for _, item := range items {
+		newsID := item.ID
+		if newsID == "" {
+			newsID = item.Title + "|" + item.PublishedAt.UTC().Format(time.RFC3339)
+		}
+		records = append(records, &model.FoxNews{
+			Source:      source,
+			NewsID:      newsID,
+			Title:       item.Title,
+			Content:     item.Content,
+			URL:         item.URL,
+			Tags:        strings.Join(item.Tags, ","),
+			ImageURL:    item.ImageURL,
+			PublishedAt: item.PublishedAt.UTC(),
+		})
+	}
+
+	result := database.Adapter().FoxNews.UnderlyingDB().
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&records)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+// SearchNews 检索归档新闻，按发布时间从新到旧排列
+// 全文检索可用且关键词长度满足 trigram 分词要求时使用 FTS5，否则回退到 LIKE 匹配
+func SearchNews(filter NewsFilter) ([]*model.FoxNews, error) {
+	if database.Adapter() == nil {
+		return nil, errors.New("database is not initialized")
+	}
+
+	q := database.Adapter().FoxNews
+	tx := q.Order(q.PublishedAt.Desc(), q.ID.Desc())
+
+	if filter.Source != "" {
+		tx = tx.Where(q.Source.Eq(filter.Source))
+	}
+	if !filter.Since.IsZero() {
+		tx = tx.Where(q.PublishedAt.Gte(filter.Since.UTC()))
+	}
+
+	terms := strings.Fields(filter.Keyword)
+	if len(terms) > 0 {
+		if database.NewsFTSEnabled() && ftsSearchable(terms) {
+			tx = tx.Where(field.NewUnsafeFieldRaw(
+				"id IN (SELECT rowid FROM fox_news_fts WHERE fox_news_fts MATCH ?)", ftsQuery(terms),
+			))
+		} else {
+			for _, term := range terms {
+				pattern := "%" + term + "%"
+				tx = tx.Where(q.Where(q.Title.Like(pattern)).Or(q.Content.Like(pattern)))
+			}
+		}
+	}
+
+	if filter.Limit > 0 {
+		tx = tx.Limit(filter.Limit)
+	}
+
+	list, err := tx.Find()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// ftsSearchable 所有关键词是否都能被 trigram 分词检索
+func ftsSearchable(terms []string) bool {
+	for _, term := range terms {
+		if utf8.RuneCountInString(term) < ftsMinTermLength {
+			return false
+		}
+	}
+	return true
+}
+
+// ftsQuery 将关键词转换为 FTS5 短语查询，避免关键词中的特殊字符被解析为查询语法
+func ftsQuery(terms []string) string {
+	phrases := make([]string, 0, len(terms))
+	for _, term := range terms {
+		phrases = append(phrases, `"`+strings.ReplaceAll(term, `"`, `""`)+`"`)
+	}
+	return strings.Join(phrases, " AND ")
+}
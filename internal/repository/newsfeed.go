@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/news"
+)
+
+// ListNewsFeeds 获取数据库中启用的 RSS/Atom 新闻源，未设置展示名称时使用默认展示名称
+func ListNewsFeeds() ([]news.FeedConfig, error) {
+	if database.Adapter() == nil {
+		return nil, errors.New("database is not initialized")
+	}
+
+	q := database.Adapter().FoxNewsFeed
+	records, err := q.Where(q.IsActive.Eq(1)).Order(q.ID).Find()
+	if err != nil {
+		return nil, err
+	}
+
+	feeds := make([]news.FeedConfig, 0, len(records))
+	for _, record := range records {
+		displayName := record.DisplayName
+		if displayName == "" {
+			displayName = news.FeedDisplayName(record.Name)
+		}
+		feeds = append(feeds, news.FeedConfig{Name: record.Name, DisplayName: displayName, URL: record.URL})
+	}
+	return feeds, nil
+}
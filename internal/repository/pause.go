@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+)
+
+// SaveEnginePause 记录引擎暂停，已存在记录时不重复写入
+func SaveEnginePause(reason string) error {
+	q := database.Adapter().FoxEnginePause
+	count, err := q.Count()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	return q.Create(&model.FoxEnginePause{Reason: reason})
+}
+
+// DeleteEnginePause 删除引擎暂停记录
+func DeleteEnginePause() error {
+	q := database.Adapter().FoxEnginePause
+	_, err := q.Where(q.ID.Gt(0)).Delete()
+	return err
+}
+
+// EnginePaused 判断是否存在引擎暂停记录
+func EnginePaused() (bool, error) {
+	count, err := database.Adapter().FoxEnginePause.Count()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"gorm.io/gorm"
+)
+
+// GetRiskRule 获取账户风控规则，未配置时返回 nil
+func GetRiskRule(accountID int64) (*model.FoxRiskRule, error) {
+	rule, err := database.Adapter().FoxRiskRule.Where(
+		database.Adapter().FoxRiskRule.AccountID.Eq(accountID),
+	).First()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// SaveRiskRule 保存账户风控规则
+func SaveRiskRule(rule *model.FoxRiskRule) error {
+	return database.Adapter().FoxRiskRule.Save(rule)
+}
@@ -0,0 +1,209 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/database"
+	"github.com/lemconn/foxflow/internal/engine/schedule"
+	"github.com/lemconn/foxflow/internal/engine/syntax"
+	"github.com/lemconn/foxflow/internal/exchange"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	pb "github.com/lemconn/foxflow/proto/generated"
+	"github.com/shopspring/decimal"
+)
+
+type DcaServer struct{}
+
+func NewDcaServer() *DcaServer {
+	return &DcaServer{}
+}
+
+// CreateDcaPlan 创建定投计划，到期时由引擎创建市价开仓订单
+func (s *DcaServer) CreateDcaPlan(ctx context.Context, req *pb.CreateDcaPlanRequest) (*pb.CreateDcaPlanResponse, error) {
+	if req.AccountId <= 0 {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: "account_id 是必填参数"}, nil
+	}
+	if req.Exchange == "" || req.Symbol == "" {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: "exchange 和 symbol 均为必填参数"}, nil
+	}
+	if req.PosSide != "long" && req.PosSide != "short" {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: "pos_side 只能为 long 或 short"}, nil
+	}
+	if req.Margin != "isolated" && req.Margin != "cross" {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: "margin 只能为 isolated 或 cross"}, nil
+	}
+	if req.AmountType != "" && req.AmountType != "USDT" {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: "amount_type 只能为空或 USDT"}, nil
+	}
+
+	amount, err := decimal.NewFromString(req.Amount)
+	if err != nil || !amount.IsPositive() {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: fmt.Sprintf("amount 必须为正数: %s", req.Amount)}, nil
+	}
+
+	cron, err := schedule.Parse(req.Schedule)
+	if err != nil {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: err.Error()}, nil
+	}
+	next := cron.Next(time.Now())
+	if next.IsZero() {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: fmt.Sprintf("cron 表达式没有可执行的时间: %s", req.Schedule)}, nil
+	}
+
+	guard := strings.TrimSpace(req.Guard)
+	if guard != "" {
+		if err := validateDcaGuard(guard); err != nil {
+			return &pb.CreateDcaPlanResponse{Success: false, Message: fmt.Sprintf("执行条件无效: %v", err)}, nil
+		}
+	}
+
+	account, err := database.Adapter().FoxAccount.Where(
+		database.Adapter().FoxAccount.ID.Eq(req.AccountId),
+	).Preload(database.Adapter().FoxAccount.Config).First()
+	if err != nil {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: fmt.Sprintf("获取账户失败: %v", err)}, nil
+	}
+	if account.Exchange != req.Exchange {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: "账户所属交易所与请求不一致"}, nil
+	}
+
+	exchangeClient, err := exchange.GetManager().GetExchange(req.Exchange)
+	if err != nil {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: fmt.Sprintf("获取交易所客户端失败: %v", err)}, nil
+	}
+	if err := exchangeClient.SetAccount(ctx, account); err != nil {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: fmt.Sprintf("设置账户失败: %v", err)}, nil
+	}
+
+	symbol := strings.ToUpper(req.Symbol)
+	found := false
+	for _, symbolInfo := range NewSymbolServer().getSymbolList(ctx, req.Exchange, exchangeClient) {
+		if symbolInfo.Name == symbol {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: fmt.Sprintf("交易对 %s 不存在", req.Symbol)}, nil
+	}
+
+	plan := &model.FoxDcaPlan{
+		AccountID:  req.AccountId,
+		Exchange:   req.Exchange,
+		Symbol:     symbol,
+		PosSide:    req.PosSide,
+		MarginType: req.Margin,
+		Amount:     amount.String(),
+		AmountType: req.AmountType,
+		Schedule:   cron.String(),
+		Guard:      guard,
+		Status:     "active",
+		NextRunAt:  next.Unix(),
+	}
+	if err := database.Adapter().FoxDcaPlan.Create(plan); err != nil {
+		return &pb.CreateDcaPlanResponse{Success: false, Message: fmt.Sprintf("创建定投计划失败: %v", err)}, nil
+	}
+
+	return &pb.CreateDcaPlanResponse{
+		Success: true,
+		Message: fmt.Sprintf("定投计划 %d 创建成功，下次执行时间: %s", plan.ID, next.Format("2006-01-02 15:04")),
+		Plan:    buildPBDcaPlanItem(plan),
+	}, nil
+}
+
+// GetDcaPlans 获取账户的定投计划列表
+func (s *DcaServer) GetDcaPlans(ctx context.Context, req *pb.GetDcaPlansRequest) (*pb.GetDcaPlansResponse, error) {
+	if req.AccountId <= 0 {
+		return &pb.GetDcaPlansResponse{Success: false, Message: "account_id 是必填参数"}, nil
+	}
+
+	q := database.Adapter().FoxDcaPlan
+	plans, err := q.Where(q.AccountID.Eq(req.AccountId)).Order(q.ID).Find()
+	if err != nil {
+		return &pb.GetDcaPlansResponse{Success: false, Message: fmt.Sprintf("查询定投计划失败: %v", err)}, nil
+	}
+
+	items := make([]*pb.DcaPlanItem, 0, len(plans))
+	for _, plan := range plans {
+		items = append(items, buildPBDcaPlanItem(plan))
+	}
+
+	return &pb.GetDcaPlansResponse{
+		Success: true,
+		Message: "获取定投计划成功",
+		Plans:   items,
+	}, nil
+}
+
+// CancelDcaPlan 取消定投计划，已创建的订单不受影响
+func (s *DcaServer) CancelDcaPlan(ctx context.Context, req *pb.CancelDcaPlanRequest) (*pb.CancelDcaPlanResponse, error) {
+	if req.AccountId <= 0 {
+		return &pb.CancelDcaPlanResponse{Success: false, Message: "account_id 是必填参数"}, nil
+	}
+
+	q := database.Adapter().FoxDcaPlan
+	plan, err := q.Where(q.ID.Eq(req.Id), q.AccountID.Eq(req.AccountId)).First()
+	if err != nil {
+		return &pb.CancelDcaPlanResponse{Success: false, Message: fmt.Sprintf("定投计划 %d 不存在", req.Id)}, nil
+	}
+	if plan.Status != "active" {
+		return &pb.CancelDcaPlanResponse{Success: false, Message: fmt.Sprintf("定投计划 %d 已取消", req.Id)}, nil
+	}
+
+	plan.Status = "cancelled"
+	plan.Msg = "定投计划已手动取消"
+	if err := q.Save(plan); err != nil {
+		return &pb.CancelDcaPlanResponse{Success: false, Message: fmt.Sprintf("取消定投计划失败: %v", err)}, nil
+	}
+
+	return &pb.CancelDcaPlanResponse{
+		Success: true,
+		Message: fmt.Sprintf("定投计划 %d 已取消", plan.ID),
+		Plan:    buildPBDcaPlanItem(plan),
+	}, nil
+}
+
+// statefulGuardFuncs 依赖跨周期求值状态的函数，定投执行条件每次执行独立求值，使用这些函数时条件永远无法按预期满足
+var statefulGuardFuncs = []string{"hold", "within"}
+
+// validateDcaGuard 校验定投执行条件，拒绝依赖跨周期状态的函数
+func validateDcaGuard(guard string) error {
+	if err := validateStrategy(guard); err != nil {
+		return err
+	}
+
+	node, err := syntax.NewEngine().Parse(guard)
+	if err != nil {
+		return fmt.Errorf("解析策略失败: %w", err)
+	}
+	if name, ok := node.HasFuncCall(statefulGuardFuncs...); ok {
+		return fmt.Errorf("定投执行条件仅在到期时求值一次，不支持依赖连续求值的 %s()", name)
+	}
+	return nil
+}
+
+func buildPBDcaPlanItem(plan *model.FoxDcaPlan) *pb.DcaPlanItem {
+	return &pb.DcaPlanItem{
+		Id:          plan.ID,
+		AccountId:   plan.AccountID,
+		Exchange:    plan.Exchange,
+		Symbol:      plan.Symbol,
+		PosSide:     plan.PosSide,
+		MarginType:  plan.MarginType,
+		Amount:      plan.Amount,
+		AmountType:  plan.AmountType,
+		Schedule:    plan.Schedule,
+		Guard:       plan.Guard,
+		Status:      plan.Status,
+		NextRunAt:   plan.NextRunAt,
+		LastRunAt:   plan.LastRunAt,
+		Runs:        plan.Runs,
+		Skips:       plan.Skips,
+		LastOrderId: plan.LastOrderID,
+		Msg:         plan.Msg,
+		CreatedAt:   plan.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/lemconn/foxflow/internal/repository"
+	pb "github.com/lemconn/foxflow/proto/generated"
+)
+
+type NewsServer struct{}
+
+func NewNewsServer() *NewsServer {
+	return &NewsServer{}
+}
+
+// SearchNews 按关键词和起始时间检索新闻归档
+func (s *NewsServer) SearchNews(ctx context.Context, req *pb.GetNewsRequest) (*pb.GetNewsResponse, error) {
+	filter := repository.NewsFilter{
+		Source:  req.Source,
+		Keyword: strings.TrimSpace(req.Keyword),
+		Limit:   int(req.Count),
+	}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+
+	list, err := repository.SearchNews(filter)
+	if err != nil {
+		log.Printf("检索新闻归档失败: %v", err)
+		return &pb.GetNewsResponse{
+			Success: false,
+			Message: fmt.Sprintf("检索新闻归档失败: %v", err),
+		}, nil
+	}
+
+	var pbNews []*pb.NewsItem
+	for _, item := range list {
+		var tags []string
+		if item.Tags != "" {
+			tags = strings.Split(item.Tags, ",")
+		}
+		pbNews = append(pbNews, &pb.NewsItem{
+			Id:          item.NewsID,
+			Title:       item.Title,
+			Content:     item.Content,
+			Url:         item.URL,
+			Source:      item.Source,
+			PublishedAt: item.PublishedAt.Unix(),
+			Tags:        tags,
+			ImageUrl:    item.ImageURL,
+		})
+	}
+
+	return &pb.GetNewsResponse{
+		Success: true,
+		Message: fmt.Sprintf("成功检索 %d 条新闻", len(pbNews)),
+		News:    pbNews,
+	}, nil
+}
@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/lemconn/foxflow/internal/config"
 	"github.com/lemconn/foxflow/internal/database"
 	"github.com/lemconn/foxflow/internal/engine/syntax"
 	"github.com/lemconn/foxflow/internal/exchange"
 	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/lemconn/foxflow/internal/pkg/dao/query"
 	pb "github.com/lemconn/foxflow/proto/generated"
 	"github.com/shopspring/decimal"
+	"gorm.io/gen/field"
 )
 
 type OrderServer struct{}
@@ -57,24 +61,35 @@ func (s *OrderServer) GetOrders(ctx context.Context, req *pb.GetOrdersRequest) (
 	var pbOrders []*pb.OrderItem
 	for _, order := range orders {
 		pbOrders = append(pbOrders, &pb.OrderItem{
-			Id:         order.ID,
-			Exchange:   order.Exchange,
-			AccountId:  order.AccountID,
-			Symbol:     order.Symbol,
-			Side:       order.Side,
-			PosSide:    order.PosSide,
-			MarginType: order.MarginType,
-			Price:      order.Price,
-			Size:       order.Size,
-			SizeType:   order.SizeType,
-			OrderType:  order.OrderType,
-			Strategy:   order.Strategy,
-			OrderId:    order.OrderID,
-			Type:       order.Type,
-			Status:     order.Status,
-			Msg:        order.Msg,
-			CreatedAt:  order.CreatedAt.Unix(),
-			UpdatedAt:  order.UpdatedAt.Unix(),
+			Id:           order.ID,
+			Exchange:     order.Exchange,
+			AccountId:    order.AccountID,
+			Symbol:       order.Symbol,
+			Side:         order.Side,
+			PosSide:      order.PosSide,
+			MarginType:   order.MarginType,
+			Price:        order.Price,
+			Size:         order.Size,
+			SizeType:     order.SizeType,
+			OrderType:    order.OrderType,
+			Strategy:     order.Strategy,
+			OrderId:      order.OrderID,
+			Type:         order.Type,
+			Status:       order.Status,
+			Msg:          order.Msg,
+			CreatedAt:    order.CreatedAt.Unix(),
+			UpdatedAt:    order.UpdatedAt.Unix(),
+			ExpireAt:     order.ExpireAt,
+			ParentId:     order.ParentID,
+			PriceExpr:    order.PriceExpr,
+			Algo:         order.Algo,
+			AlgoDuration: order.AlgoDuration,
+			AlgoSlices:   order.AlgoSlices,
+			AlgoVisible:  order.AlgoVisible,
+			AlgoTotal:    order.AlgoTotal,
+			AlgoFilled:   order.AlgoFilled,
+			Chase:        order.Chase,
+			MaxSlippage:  order.MaxSlippage,
 		})
 	}
 
@@ -105,6 +120,9 @@ func (s *OrderServer) OpenOrder(ctx context.Context, req *pb.OpenOrderRequest) (
 	if req.Amount == "" {
 		return &pb.OpenOrderResponse{Success: false, Message: "amount 是必填参数"}, nil
 	}
+	if req.ExpireAt > 0 && req.ExpireAt <= time.Now().Unix() {
+		return &pb.OpenOrderResponse{Success: false, Message: "expire_at 必须晚于当前时间"}, nil
+	}
 
 	account, err := database.Adapter().FoxAccount.Where(
 		database.Adapter().FoxAccount.ID.Eq(req.AccountId),
@@ -211,23 +229,93 @@ func (s *OrderServer) OpenOrder(ctx context.Context, req *pb.OpenOrderRequest) (
 		}
 	}
 
+	// 指定限价表达式时提交限价单，价格在策略触发时求值
+	priceExpr := strings.TrimSpace(req.PriceExpr)
+	orderType, err := resolveOrderType(req.OrderType, priceExpr)
+	if err != nil {
+		return &pb.OpenOrderResponse{Success: false, Message: err.Error()}, nil
+	}
+	if req.ReduceOnly {
+		return &pb.OpenOrderResponse{Success: false, Message: "reduce_only 仅适用于平仓订单"}, nil
+	}
+	if err := validateAlgo(req, orderType); err != nil {
+		return &pb.OpenOrderResponse{Success: false, Message: err.Error()}, nil
+	}
+	chase, err := validateChase(req.Chase, req.MaxSlippage, orderType)
+	if err != nil {
+		return &pb.OpenOrderResponse{Success: false, Message: err.Error()}, nil
+	}
+	if chase != "" && req.Algo != "" {
+		return &pb.OpenOrderResponse{Success: false, Message: "算法订单不支持追价"}, nil
+	}
+
 	order := &model.FoxOrder{
-		OrderID:    exchangeClient.GetClientOrderId(ctx),
-		Exchange:   req.Exchange,
-		AccountID:  req.AccountId,
-		Symbol:     req.Symbol,
-		PosSide:    req.PosSide,
-		MarginType: req.Margin,
-		Size:       amountDecimal.String(),
-		SizeType:   req.AmountType,
-		Side:       side,
-		OrderType:  "market",
-		Strategy:   strategy,
-		Type:       "open",
-		Status:     "waiting",
+		OrderID:      exchangeClient.GetClientOrderId(ctx),
+		Exchange:     req.Exchange,
+		AccountID:    req.AccountId,
+		Symbol:       req.Symbol,
+		PosSide:      req.PosSide,
+		MarginType:   req.Margin,
+		Size:         amountDecimal.String(),
+		SizeType:     req.AmountType,
+		Side:         side,
+		OrderType:    orderType,
+		Strategy:     strategy,
+		Type:         "open",
+		Status:       "waiting",
+		ExpireAt:     req.ExpireAt,
+		PriceExpr:    priceExpr,
+		Algo:         req.Algo,
+		AlgoDuration: req.AlgoDuration,
+		AlgoSlices:   req.AlgoSlices,
+		AlgoVisible:  req.AlgoVisible,
+		Chase:        chase,
+		MaxSlippage:  req.MaxSlippage,
+	}
+
+	// 开仓成交后激活的平仓订单，创建时为 pending 状态，同一开仓订单的平仓订单互为 OCO
+	closeOrders := make([]*model.FoxOrder, 0, len(req.CloseStrategies))
+	for i, closeStrategy := range req.CloseStrategies {
+		closeStrategy = strings.TrimSpace(closeStrategy)
+		if err := validateStrategy(closeStrategy); err != nil {
+			return &pb.OpenOrderResponse{
+				Success: false,
+				Message: fmt.Sprintf("第 %d 个平仓策略无效: %v", i+1, err),
+			}, nil
+		}
+
+		closeSide := "sell"
+		if req.PosSide == "short" {
+			closeSide = "buy"
+		}
+		closeOrders = append(closeOrders, &model.FoxOrder{
+			OrderID:    exchangeClient.GetClientOrderId(ctx),
+			Exchange:   req.Exchange,
+			AccountID:  req.AccountId,
+			Symbol:     req.Symbol,
+			PosSide:    req.PosSide,
+			MarginType: req.Margin,
+			Side:       closeSide,
+			OrderType:  "market",
+			Strategy:   closeStrategy,
+			Type:       "close",
+			Status:     "pending",
+		})
 	}
 
-	if err := database.Adapter().FoxOrder.Create(order); err != nil {
+	err = database.Adapter().Transaction(func(tx *query.Query) error {
+		if err := tx.FoxOrder.Create(order); err != nil {
+			return err
+		}
+		for _, closeOrder := range closeOrders {
+			closeOrder.ParentID = order.ID
+		}
+		if len(closeOrders) == 0 {
+			return nil
+		}
+		return tx.FoxOrder.Create(closeOrders...)
+	})
+	if err != nil {
 		return &pb.OpenOrderResponse{
 			Success: false,
 			Message: fmt.Sprintf("创建订单失败: %v", err),
@@ -235,29 +323,54 @@ func (s *OrderServer) OpenOrder(ctx context.Context, req *pb.OpenOrderRequest) (
 	}
 
 	pbOrder := &pb.OrderItem{
-		Id:         order.ID,
-		Exchange:   order.Exchange,
-		AccountId:  order.AccountID,
-		Symbol:     order.Symbol,
-		Side:       order.Side,
-		PosSide:    order.PosSide,
-		MarginType: order.MarginType,
-		Price:      order.Price,
-		Size:       order.Size,
-		SizeType:   order.SizeType,
-		OrderType:  order.OrderType,
-		Strategy:   order.Strategy,
-		OrderId:    order.OrderID,
-		Type:       order.Type,
-		Status:     order.Status,
-		Msg:        order.Msg,
-		CreatedAt:  order.CreatedAt.Unix(),
-		UpdatedAt:  order.UpdatedAt.Unix(),
+		Id:           order.ID,
+		Exchange:     order.Exchange,
+		AccountId:    order.AccountID,
+		Symbol:       order.Symbol,
+		Side:         order.Side,
+		PosSide:      order.PosSide,
+		MarginType:   order.MarginType,
+		Price:        order.Price,
+		Size:         order.Size,
+		SizeType:     order.SizeType,
+		OrderType:    order.OrderType,
+		Strategy:     order.Strategy,
+		OrderId:      order.OrderID,
+		Type:         order.Type,
+		Status:       order.Status,
+		Msg:          order.Msg,
+		CreatedAt:    order.CreatedAt.Unix(),
+		UpdatedAt:    order.UpdatedAt.Unix(),
+		ExpireAt:     order.ExpireAt,
+		ParentId:     order.ParentID,
+		PriceExpr:    order.PriceExpr,
+		Algo:         order.Algo,
+		AlgoDuration: order.AlgoDuration,
+		AlgoSlices:   order.AlgoSlices,
+		AlgoVisible:  order.AlgoVisible,
+		AlgoTotal:    order.AlgoTotal,
+		AlgoFilled:   order.AlgoFilled,
+		Chase:        order.Chase,
+		MaxSlippage:  order.MaxSlippage,
+	}
+
+	message := fmt.Sprintf("策略订单已创建，订单号: %s", order.OrderID)
+	switch order.Algo {
+	case "twap":
+		message += fmt.Sprintf("，触发后按 TWAP 拆分为 %d 笔在 %s 内提交", order.AlgoSlices, time.Duration(order.AlgoDuration)*time.Second)
+	case "iceberg":
+		message += fmt.Sprintf("，触发后按冰山单每次挂出 %s%% 数量", order.AlgoVisible)
+	}
+	if len(closeOrders) > 0 {
+		message += fmt.Sprintf("，开仓成交后激活 %d 个平仓策略", len(closeOrders))
+		if len(closeOrders) > 1 {
+			message += "（任一平仓策略触发后取消其余平仓策略）"
+		}
 	}
 
 	return &pb.OpenOrderResponse{
 		Success: true,
-		Message: fmt.Sprintf("策略订单已创建，订单号: %s", order.OrderID),
+		Message: message,
 		Order:   pbOrder,
 	}, nil
 }
@@ -280,6 +393,28 @@ func (s *OrderServer) CloseOrder(ctx context.Context, req *pb.CloseOrderRequest)
 		return &pb.CloseOrderResponse{Success: false, Message: "margin 只能为 isolated 或 cross"}, nil
 	}
 
+	// 指定数量时为部分平仓，未指定时平掉全部仓位
+	size, sizeType := "", ""
+	if req.Amount != "" {
+		amountDecimal, err := decimal.NewFromString(req.Amount)
+		if err != nil {
+			return &pb.CloseOrderResponse{Success: false, Message: fmt.Sprintf("amount 解析失败: %v", err)}, nil
+		}
+		if !amountDecimal.IsPositive() {
+			return &pb.CloseOrderResponse{Success: false, Message: "amount 必须大于 0"}, nil
+		}
+		switch req.AmountType {
+		case "", "USDT":
+		case "percent":
+			if amountDecimal.GreaterThan(decimal.NewFromInt(100)) {
+				return &pb.CloseOrderResponse{Success: false, Message: "平仓百分比不能大于 100%"}, nil
+			}
+		default:
+			return &pb.CloseOrderResponse{Success: false, Message: "amount_type 只能为空、USDT 或 percent"}, nil
+		}
+		size, sizeType = amountDecimal.String(), req.AmountType
+	}
+
 	account, err := database.Adapter().FoxAccount.Where(
 		database.Adapter().FoxAccount.ID.Eq(req.AccountId),
 	).Preload(database.Adapter().FoxAccount.Config).First()
@@ -345,23 +480,44 @@ func (s *OrderServer) CloseOrder(ctx context.Context, req *pb.CloseOrderRequest)
 		}
 	}
 
+	// 部分平仓始终以只减仓订单提交；全部平仓的限价单指定 reduce_only 时只减仓，避免单向持仓模式下反向开仓
+	reduceOnly := int64(0)
+	if req.ReduceOnly {
+		reduceOnly = 1
+	}
+	priceExpr := strings.TrimSpace(req.PriceExpr)
+	orderType, err := resolveOrderType(req.OrderType, priceExpr)
+	if err != nil {
+		return &pb.CloseOrderResponse{Success: false, Message: err.Error()}, nil
+	}
+	chase, err := validateChase(req.Chase, req.MaxSlippage, orderType)
+	if err != nil {
+		return &pb.CloseOrderResponse{Success: false, Message: err.Error()}, nil
+	}
+
 	side := "sell"
 	if req.PosSide == "short" {
 		side = "buy"
 	}
 
 	order := &model.FoxOrder{
-		OrderID:    exchangeClient.GetClientOrderId(ctx),
-		Exchange:   req.Exchange,
-		AccountID:  req.AccountId,
-		Symbol:     req.Symbol,
-		PosSide:    req.PosSide,
-		MarginType: req.Margin,
-		Side:       side,
-		OrderType:  "market",
-		Strategy:   strategy,
-		Type:       "close",
-		Status:     "waiting",
+		OrderID:     exchangeClient.GetClientOrderId(ctx),
+		Exchange:    req.Exchange,
+		AccountID:   req.AccountId,
+		Symbol:      req.Symbol,
+		PosSide:     req.PosSide,
+		MarginType:  req.Margin,
+		Size:        size,
+		SizeType:    sizeType,
+		Side:        side,
+		OrderType:   orderType,
+		Strategy:    strategy,
+		Type:        "close",
+		Status:      "waiting",
+		PriceExpr:   priceExpr,
+		Chase:       chase,
+		MaxSlippage: req.MaxSlippage,
+		ReduceOnly:  reduceOnly,
 	}
 
 	if err := database.Adapter().FoxOrder.Create(order); err != nil {
@@ -372,24 +528,35 @@ func (s *OrderServer) CloseOrder(ctx context.Context, req *pb.CloseOrderRequest)
 	}
 
 	pbOrder := &pb.OrderItem{
-		Id:         order.ID,
-		Exchange:   order.Exchange,
-		AccountId:  order.AccountID,
-		Symbol:     order.Symbol,
-		Side:       order.Side,
-		PosSide:    order.PosSide,
-		MarginType: order.MarginType,
-		Price:      order.Price,
-		Size:       order.Size,
-		SizeType:   order.SizeType,
-		OrderType:  order.OrderType,
-		Strategy:   order.Strategy,
-		OrderId:    order.OrderID,
-		Type:       order.Type,
-		Status:     order.Status,
-		Msg:        order.Msg,
-		CreatedAt:  order.CreatedAt.Unix(),
-		UpdatedAt:  order.UpdatedAt.Unix(),
+		Id:           order.ID,
+		Exchange:     order.Exchange,
+		AccountId:    order.AccountID,
+		Symbol:       order.Symbol,
+		Side:         order.Side,
+		PosSide:      order.PosSide,
+		MarginType:   order.MarginType,
+		Price:        order.Price,
+		Size:         order.Size,
+		SizeType:     order.SizeType,
+		OrderType:    order.OrderType,
+		Strategy:     order.Strategy,
+		OrderId:      order.OrderID,
+		Type:         order.Type,
+		Status:       order.Status,
+		Msg:          order.Msg,
+		CreatedAt:    order.CreatedAt.Unix(),
+		UpdatedAt:    order.UpdatedAt.Unix(),
+		ExpireAt:     order.ExpireAt,
+		ParentId:     order.ParentID,
+		PriceExpr:    order.PriceExpr,
+		Algo:         order.Algo,
+		AlgoDuration: order.AlgoDuration,
+		AlgoSlices:   order.AlgoSlices,
+		AlgoVisible:  order.AlgoVisible,
+		AlgoTotal:    order.AlgoTotal,
+		AlgoFilled:   order.AlgoFilled,
+		Chase:        order.Chase,
+		MaxSlippage:  order.MaxSlippage,
 	}
 
 	return &pb.CloseOrderResponse{
@@ -422,7 +589,7 @@ func (s *OrderServer) CancelOrder(ctx context.Context, req *pb.CancelOrderReques
 		database.Adapter().FoxOrder.PosSide.Eq(req.PosSide),
 		database.Adapter().FoxOrder.Size.Eq(req.Amount),
 		database.Adapter().FoxOrder.SizeType.Eq(req.AmountType),
-		database.Adapter().FoxOrder.Status.Eq("waiting"),
+		database.Adapter().FoxOrder.Status.In("pending", "waiting", "paused", "executing"),
 	).First()
 	if err != nil {
 		return &pb.CancelOrderResponse{
@@ -431,36 +598,334 @@ func (s *OrderServer) CancelOrder(ctx context.Context, req *pb.CancelOrderReques
 		}, nil
 	}
 
+	// 取消开仓订单时一并取消尚未激活的平仓订单及尚未提交的算法子订单（已提交的子订单由引擎撤销）
+	executing := order.Status == "executing"
+	if executing {
+		order.Msg = fmt.Sprintf("算法订单已取消（已成交 %s/%s 张）", order.AlgoFilled, order.AlgoTotal)
+	}
 	order.Status = "cancelled"
-	if err := database.Adapter().FoxOrder.Save(order); err != nil {
+	err = database.Adapter().Transaction(func(tx *query.Query) error {
+		if err := tx.FoxOrder.Save(order); err != nil {
+			return err
+		}
+		_, err := tx.FoxOrder.Where(
+			tx.FoxOrder.ParentID.Eq(order.ID),
+			tx.FoxOrder.Status.Eq("pending"),
+		).UpdateSimple(
+			tx.FoxOrder.Status.Value("cancelled"),
+			tx.FoxOrder.Msg.Value("开仓订单已取消，平仓订单已取消"),
+		)
+		if err != nil {
+			return err
+		}
+		_, err = tx.FoxAlgoSlice.Where(
+			tx.FoxAlgoSlice.OrderID.Eq(order.ID),
+			tx.FoxAlgoSlice.Status.Eq("scheduled"),
+		).UpdateSimple(
+			tx.FoxAlgoSlice.Status.Value("cancelled"),
+			tx.FoxAlgoSlice.Msg.Value("算法订单已取消"),
+		)
+		return err
+	})
+	if err != nil {
 		return &pb.CancelOrderResponse{
 			Success: false,
 			Message: fmt.Sprintf("更新订单失败: %v", err),
 		}, nil
 	}
 
+	message := fmt.Sprintf("订单（%s:%s:%s:%s）取消成功", req.Symbol, req.Side, req.PosSide, req.Amount)
+	if executing {
+		message += "，已提交的算法子订单将由引擎撤销"
+	}
+
 	return &pb.CancelOrderResponse{
 		Success: true,
-		Message: fmt.Sprintf("订单（%s:%s:%s:%s）取消成功", req.Symbol, req.Side, req.PosSide, req.Amount),
+		Message: message,
 		Order: &pb.OrderItem{
-			Id:         order.ID,
-			Exchange:   order.Exchange,
-			AccountId:  order.AccountID,
-			Symbol:     order.Symbol,
-			Side:       order.Side,
-			PosSide:    order.PosSide,
-			MarginType: order.MarginType,
-			Price:      order.Price,
-			Size:       order.Size,
-			SizeType:   order.SizeType,
-			OrderType:  order.OrderType,
-			Strategy:   order.Strategy,
-			OrderId:    order.OrderID,
-			Type:       order.Type,
-			Status:     order.Status,
-			Msg:        order.Msg,
-			CreatedAt:  order.CreatedAt.Unix(),
-			UpdatedAt:  order.UpdatedAt.Unix(),
+			Id:           order.ID,
+			Exchange:     order.Exchange,
+			AccountId:    order.AccountID,
+			Symbol:       order.Symbol,
+			Side:         order.Side,
+			PosSide:      order.PosSide,
+			MarginType:   order.MarginType,
+			Price:        order.Price,
+			Size:         order.Size,
+			SizeType:     order.SizeType,
+			OrderType:    order.OrderType,
+			Strategy:     order.Strategy,
+			OrderId:      order.OrderID,
+			Type:         order.Type,
+			Status:       order.Status,
+			Msg:          order.Msg,
+			CreatedAt:    order.CreatedAt.Unix(),
+			UpdatedAt:    order.UpdatedAt.Unix(),
+			ExpireAt:     order.ExpireAt,
+			ParentId:     order.ParentID,
+			PriceExpr:    order.PriceExpr,
+			Algo:         order.Algo,
+			AlgoDuration: order.AlgoDuration,
+			AlgoSlices:   order.AlgoSlices,
+			AlgoVisible:  order.AlgoVisible,
+			AlgoTotal:    order.AlgoTotal,
+			AlgoFilled:   order.AlgoFilled,
+			Chase:        order.Chase,
+			MaxSlippage:  order.MaxSlippage,
 		},
 	}, nil
 }
+
+// PauseOrder 暂停或恢复策略订单
+// 暂停的订单不会被引擎处理；恢复时清空策略求值状态，hold 等跨周期函数重新开始计算
+func (s *OrderServer) PauseOrder(ctx context.Context, req *pb.PauseOrderRequest) (*pb.PauseOrderResponse, error) {
+	if req.AccountId <= 0 {
+		return &pb.PauseOrderResponse{Success: false, Message: "account_id 是必填参数"}, nil
+	}
+	if req.Id <= 0 {
+		return &pb.PauseOrderResponse{Success: false, Message: "id 是必填参数"}, nil
+	}
+
+	from, to, action := "waiting", "paused", "暂停"
+	if req.Resume {
+		from, to, action = "paused", "waiting", "恢复"
+	}
+
+	q := database.Adapter().FoxOrder
+	order, err := q.Where(q.ID.Eq(req.Id), q.AccountID.Eq(req.AccountId)).First()
+	if err != nil {
+		return &pb.PauseOrderResponse{
+			Success: false,
+			Message: fmt.Sprintf("查询订单失败: %v", err),
+		}, nil
+	}
+	if order.Status != from {
+		return &pb.PauseOrderResponse{
+			Success: false,
+			Message: fmt.Sprintf("订单 %d 当前状态为 %s，无法%s", order.ID, order.Status, action),
+		}, nil
+	}
+
+	// 按状态条件更新，避免覆盖引擎同时处理的结果
+	updates := []field.AssignExpr{q.Status.Value(to)}
+	if req.Resume {
+		updates = append(updates, q.StrategyState.Value(""))
+	}
+	info, err := q.Where(q.ID.Eq(order.ID), q.Status.Eq(from)).UpdateSimple(updates...)
+	if err != nil {
+		return &pb.PauseOrderResponse{
+			Success: false,
+			Message: fmt.Sprintf("更新订单失败: %v", err),
+		}, nil
+	}
+	if info.RowsAffected == 0 {
+		return &pb.PauseOrderResponse{
+			Success: false,
+			Message: fmt.Sprintf("订单 %d 状态已变化，请重新查看订单", order.ID),
+		}, nil
+	}
+
+	order.Status = to
+	if req.Resume {
+		order.StrategyState = ""
+	}
+
+	return &pb.PauseOrderResponse{
+		Success: true,
+		Message: fmt.Sprintf("订单 %d（%s:%s:%s）%s成功", order.ID, order.Symbol, order.Side, order.PosSide, action),
+		Order:   buildPBOrderItem(order),
+	}, nil
+}
+
+// GetOrderHistory 获取策略订单的委托历史
+func (s *OrderServer) GetOrderHistory(ctx context.Context, req *pb.GetOrderHistoryRequest) (*pb.GetOrderHistoryResponse, error) {
+	if req.AccountId <= 0 {
+		return &pb.GetOrderHistoryResponse{Success: false, Message: "account_id 是必填参数"}, nil
+	}
+	if req.Id <= 0 {
+		return &pb.GetOrderHistoryResponse{Success: false, Message: "id 是必填参数"}, nil
+	}
+
+	q := database.Adapter().FoxOrder
+	order, err := q.Where(q.ID.Eq(req.Id), q.AccountID.Eq(req.AccountId)).First()
+	if err != nil {
+		return &pb.GetOrderHistoryResponse{
+			Success: false,
+			Message: fmt.Sprintf("查询订单失败: %v", err),
+		}, nil
+	}
+
+	hq := database.Adapter().FoxOrderHistory
+	histories, err := hq.Where(hq.OrderID.Eq(order.ID)).Order(hq.ID).Find()
+	if err != nil {
+		return &pb.GetOrderHistoryResponse{
+			Success: false,
+			Message: fmt.Sprintf("获取订单历史失败: %v", err),
+		}, nil
+	}
+
+	items := make([]*pb.OrderHistoryItem, 0, len(histories))
+	for _, history := range histories {
+		items = append(items, &pb.OrderHistoryItem{
+			Id:            history.ID,
+			OrderId:       history.OrderID,
+			Action:        history.Action,
+			ClientOrderId: history.ClientOrderID,
+			Price:         history.Price,
+			Size:          history.Size,
+			Msg:           history.Msg,
+			CreatedAt:     history.CreatedAt.Unix(),
+		})
+	}
+
+	return &pb.GetOrderHistoryResponse{
+		Success:   true,
+		Message:   fmt.Sprintf("成功获取 %d 条订单历史", len(items)),
+		Histories: items,
+	}, nil
+}
+
+// orderTypes 支持的订单类型，除 market 外均需指定限价表达式
+var orderTypes = []string{"market", "limit", "post_only", "ioc", "fok"}
+
+// resolveOrderType 校验订单类型与限价表达式的组合，未指定订单类型时按是否指定限价表达式确定
+func resolveOrderType(orderType, priceExpr string) (string, error) {
+	if orderType == "" {
+		orderType = "market"
+		if priceExpr != "" {
+			orderType = "limit"
+		}
+	}
+
+	if !slices.Contains(orderTypes, orderType) {
+		return "", fmt.Errorf("order_type 只能为 %s", strings.Join(orderTypes, "、"))
+	}
+	if orderType == "market" && priceExpr != "" {
+		return "", fmt.Errorf("市价单不能指定限价表达式")
+	}
+	if orderType != "market" && priceExpr == "" {
+		return "", fmt.Errorf("%s 订单需指定限价表达式", orderType)
+	}
+
+	if priceExpr != "" {
+		if err := validateStrategy(priceExpr); err != nil {
+			return "", fmt.Errorf("限价表达式无效: %w", err)
+		}
+	}
+	return orderType, nil
+}
+
+// maxAlgoSlices TWAP 最多拆分的笔数
+const maxAlgoSlices = 100
+
+// validateAlgo 校验执行算法参数：TWAP 按时长拆分市价单，冰山单按可见比例逐笔挂出限价单
+func validateAlgo(req *pb.OpenOrderRequest, orderType string) error {
+	switch req.Algo {
+	case "":
+		if req.AlgoDuration != 0 || req.AlgoSlices != 0 || req.AlgoVisible != "" {
+			return fmt.Errorf("duration、slices、visible 仅适用于算法订单（algo=twap 或 algo=iceberg）")
+		}
+	case "twap":
+		if orderType != "market" {
+			return fmt.Errorf("TWAP 算法订单只支持市价单")
+		}
+		if req.AlgoVisible != "" {
+			return fmt.Errorf("visible 仅适用于冰山单")
+		}
+		if req.AlgoSlices < 2 || req.AlgoSlices > maxAlgoSlices {
+			return fmt.Errorf("slices 必须在 2 到 %d 之间", maxAlgoSlices)
+		}
+		if req.AlgoDuration < req.AlgoSlices {
+			return fmt.Errorf("duration 过短，每笔间隔至少 1 秒")
+		}
+	case "iceberg":
+		if orderType != "limit" {
+			return fmt.Errorf("冰山单需通过 limit= 指定限价")
+		}
+		if req.AlgoDuration != 0 || req.AlgoSlices != 0 {
+			return fmt.Errorf("duration、slices 仅适用于 TWAP 算法订单")
+		}
+		visible, err := decimal.NewFromString(req.AlgoVisible)
+		if err != nil || !visible.IsPositive() || visible.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+			return fmt.Errorf("visible 必须为大于 0 且小于 100 的百分比")
+		}
+	default:
+		return fmt.Errorf("algo 只能为 twap 或 iceberg")
+	}
+	return nil
+}
+
+// chaseOrderTypes 支持追价的订单类型（提交后挂在订单簿上等待成交）
+var chaseOrderTypes = []string{"limit", "post_only"}
+
+// validateChase 校验追价参数，返回订单的初始追价状态（不追价时为空）
+func validateChase(chase bool, maxSlippage, orderType string) (string, error) {
+	if !chase {
+		if maxSlippage != "" {
+			return "", fmt.Errorf("max_slippage 仅适用于追价订单（chase=true）")
+		}
+		return "", nil
+	}
+
+	if !slices.Contains(chaseOrderTypes, orderType) {
+		return "", fmt.Errorf("追价仅支持 %s 订单", strings.Join(chaseOrderTypes, "、"))
+	}
+	if maxSlippage != "" {
+		slippage, err := decimal.NewFromString(maxSlippage)
+		if err != nil || !slippage.IsPositive() || slippage.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+			return "", fmt.Errorf("max_slippage 必须为大于 0 且小于 100 的百分比")
+		}
+	}
+	return "active", nil
+}
+
+// validateStrategy 解析并校验策略表达式
+func validateStrategy(strategy string) error {
+	if strategy == "" {
+		return fmt.Errorf("策略不能为空")
+	}
+
+	engineClient := syntax.NewEngine()
+	node, err := engineClient.Parse(strategy)
+	if err != nil {
+		return fmt.Errorf("解析策略失败: %w", err)
+	}
+	if err := engineClient.GetEvaluator().Validate(node); err != nil {
+		return fmt.Errorf("策略校验失败: %w", err)
+	}
+	return nil
+}
+
+func buildPBOrderItem(order *model.FoxOrder) *pb.OrderItem {
+	return &pb.OrderItem{
+		Id:           order.ID,
+		Exchange:     order.Exchange,
+		AccountId:    order.AccountID,
+		Symbol:       order.Symbol,
+		Side:         order.Side,
+		PosSide:      order.PosSide,
+		MarginType:   order.MarginType,
+		Price:        order.Price,
+		Size:         order.Size,
+		SizeType:     order.SizeType,
+		OrderType:    order.OrderType,
+		Strategy:     order.Strategy,
+		OrderId:      order.OrderID,
+		Type:         order.Type,
+		Status:       order.Status,
+		Msg:          order.Msg,
+		CreatedAt:    order.CreatedAt.Unix(),
+		UpdatedAt:    order.UpdatedAt.Unix(),
+		ExpireAt:     order.ExpireAt,
+		ParentId:     order.ParentID,
+		PriceExpr:    order.PriceExpr,
+		Algo:         order.Algo,
+		AlgoDuration: order.AlgoDuration,
+		AlgoSlices:   order.AlgoSlices,
+		AlgoVisible:  order.AlgoVisible,
+		AlgoTotal:    order.AlgoTotal,
+		AlgoFilled:   order.AlgoFilled,
+		Chase:        order.Chase,
+		MaxSlippage:  order.MaxSlippage,
+	}
+}
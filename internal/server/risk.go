@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lemconn/foxflow/internal/engine/risk"
+	"github.com/lemconn/foxflow/internal/pkg/dao/model"
+	"github.com/lemconn/foxflow/internal/repository"
+	pb "github.com/lemconn/foxflow/proto/generated"
+	"github.com/shopspring/decimal"
+)
+
+type RiskServer struct{}
+
+func NewRiskServer() *RiskServer {
+	return &RiskServer{}
+}
+
+// UpdateRiskRule 更新账户风控规则，未传递的规则保持不变
+func (s *RiskServer) UpdateRiskRule(ctx context.Context, req *pb.UpdateRiskRuleRequest) (*pb.UpdateRiskRuleResponse, error) {
+	if req.AccountId <= 0 {
+		return &pb.UpdateRiskRuleResponse{Success: false, Message: "account_id 是必填参数"}, nil
+	}
+
+	rule, err := repository.GetRiskRule(req.AccountId)
+	if err != nil {
+		return &pb.UpdateRiskRuleResponse{
+			Success: false,
+			Message: fmt.Sprintf("查询风控规则失败: %v", err),
+		}, nil
+	}
+	if rule == nil {
+		rule = &model.FoxRiskRule{AccountID: req.AccountId}
+	}
+
+	if len(req.Settings) == 0 {
+		return &pb.UpdateRiskRuleResponse{
+			Success: true,
+			Message: "当前风控规则",
+			Rule:    buildPBRiskRuleItem(rule),
+		}, nil
+	}
+
+	// 按名称排序，保证多个参数错误时提示稳定
+	keys := make([]string, 0, len(req.Settings))
+	for key := range req.Settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := applyRiskSetting(rule, key, strings.TrimSpace(req.Settings[key])); err != nil {
+			return &pb.UpdateRiskRuleResponse{Success: false, Message: err.Error()}, nil
+		}
+	}
+
+	if _, err := risk.RulesFromConfig(rule); err != nil {
+		return &pb.UpdateRiskRuleResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	if err := repository.SaveRiskRule(rule); err != nil {
+		return &pb.UpdateRiskRuleResponse{
+			Success: false,
+			Message: fmt.Sprintf("保存风控规则失败: %v", err),
+		}, nil
+	}
+
+	return &pb.UpdateRiskRuleResponse{
+		Success: true,
+		Message: "风控规则更新成功",
+		Rule:    buildPBRiskRuleItem(rule),
+	}, nil
+}
+
+// applyRiskSetting 将单个风控参数写入规则，空值或 0 表示不限制
+func applyRiskSetting(rule *model.FoxRiskRule, key, value string) error {
+	switch key {
+	case "max_order_notional", "max_exposure", "max_daily_loss":
+		amount, err := parseRiskAmount(key, value)
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "max_order_notional":
+			rule.MaxOrderNotional = amount
+		case "max_exposure":
+			rule.MaxExposure = amount
+		default:
+			rule.MaxDailyLoss = amount
+		}
+	case "max_leverage", "max_open_positions":
+		var count int64
+		if value != "" {
+			parsed, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || parsed < 0 {
+				return fmt.Errorf("%s 必须为非负整数: %s", key, value)
+			}
+			count = parsed
+		}
+		if key == "max_leverage" {
+			rule.MaxLeverage = count
+		} else {
+			rule.MaxOpenPositions = count
+		}
+	case "allow_symbols":
+		rule.AllowSymbols = strings.Join(risk.ParseSymbols(value), ",")
+	case "deny_symbols":
+		rule.DenySymbols = strings.Join(risk.ParseSymbols(value), ",")
+	default:
+		return fmt.Errorf("未知的风控规则: %s", key)
+	}
+
+	return nil
+}
+
+// parseRiskAmount 解析金额类风控参数，0 统一存储为空值
+func parseRiskAmount(key, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	amount, err := decimal.NewFromString(value)
+	if err != nil || amount.IsNegative() {
+		return "", fmt.Errorf("%s 必须为非负数: %s", key, value)
+	}
+	if amount.IsZero() {
+		return "", nil
+	}
+	return amount.String(), nil
+}
+
+func buildPBRiskRuleItem(rule *model.FoxRiskRule) *pb.RiskRuleItem {
+	return &pb.RiskRuleItem{
+		MaxOrderNotional: rule.MaxOrderNotional,
+		MaxExposure:      rule.MaxExposure,
+		MaxLeverage:      rule.MaxLeverage,
+		MaxOpenPositions: rule.MaxOpenPositions,
+		MaxDailyLoss:     rule.MaxDailyLoss,
+		AllowSymbols:     rule.AllowSymbols,
+		DenySymbols:      rule.DenySymbols,
+	}
+}